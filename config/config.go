@@ -31,6 +31,69 @@ type Config struct {
 		SecretKey string `mapstructure:"JWT_SECRET_KEY"`
 	}
 
+	Attendance struct {
+		ShiftStart    string `mapstructure:"ATTENDANCE_SHIFT_START"`
+		MinWorkHours  int    `mapstructure:"ATTENDANCE_MIN_WORK_HOURS"`
+		MaxShiftHours int    `mapstructure:"ATTENDANCE_MAX_SHIFT_HOURS"`
+		Timezone      string `mapstructure:"ATTENDANCE_TIMEZONE"`
+	}
+
+	Overtime struct {
+		RestDayMaxHours          int    `mapstructure:"OVERTIME_REST_DAY_MAX_HOURS"`
+		PublicHolidayMaxHours    int    `mapstructure:"OVERTIME_PUBLIC_HOLIDAY_MAX_HOURS"`
+		RegularRatePercent       int    `mapstructure:"OVERTIME_REGULAR_RATE_PERCENT"`
+		RestDayRatePercent       int    `mapstructure:"OVERTIME_REST_DAY_RATE_PERCENT"`
+		PublicHolidayRatePercent int    `mapstructure:"OVERTIME_PUBLIC_HOLIDAY_RATE_PERCENT"`
+		RoundingMinutes          int    `mapstructure:"OVERTIME_ROUNDING_MINUTES"`
+		RoundingMode             string `mapstructure:"OVERTIME_ROUNDING_MODE"`
+		DailyLimitHours          int    `mapstructure:"OVERTIME_DAILY_LIMIT_HOURS"`
+		WeeklyLimitHours         int    `mapstructure:"OVERTIME_WEEKLY_LIMIT_HOURS"`
+		PeriodLimitHours         int    `mapstructure:"OVERTIME_PERIOD_LIMIT_HOURS"`
+	}
+
+	BlobStore struct {
+		Dir string `mapstructure:"BLOB_STORE_DIR"`
+	}
+
+	Reimbursement struct {
+		ReceiptMaxSizeMB       int     `mapstructure:"REIMBURSEMENT_RECEIPT_MAX_SIZE_MB"`
+		ReceiptMaxFiles        int     `mapstructure:"REIMBURSEMENT_RECEIPT_MAX_FILES"`
+		DuplicateWindowDays    int     `mapstructure:"REIMBURSEMENT_DUPLICATE_WINDOW_DAYS"`
+		DuplicateMinSimilarity float64 `mapstructure:"REIMBURSEMENT_DUPLICATE_MIN_SIMILARITY"`
+	}
+
+	ExchangeRate struct {
+		ProviderURL string `mapstructure:"EXCHANGE_RATE_PROVIDER_URL"`
+		MaxAgeDays  int    `mapstructure:"EXCHANGE_RATE_MAX_AGE_DAYS"`
+	}
+
+	Company struct {
+		Name    string `mapstructure:"COMPANY_NAME"`
+		Address string `mapstructure:"COMPANY_ADDRESS"`
+	}
+
+	Payslip struct {
+		PasswordRule string `mapstructure:"PAYSLIP_PASSWORD_RULE"`
+	}
+
+	SMTP struct {
+		Host                 string `mapstructure:"SMTP_HOST"`
+		Port                 int    `mapstructure:"SMTP_PORT"`
+		Username             string `mapstructure:"SMTP_USERNAME"`
+		Password             string `mapstructure:"SMTP_PASSWORD"`
+		From                 string `mapstructure:"SMTP_FROM"`
+		TLS                  string `mapstructure:"SMTP_TLS"`
+		MaxAttempts          int    `mapstructure:"SMTP_MAX_ATTEMPTS"`
+		RetryIntervalMinutes int    `mapstructure:"SMTP_RETRY_INTERVAL_MINUTES"`
+		PollIntervalSeconds  int    `mapstructure:"SMTP_POLL_INTERVAL_SECONDS"`
+	}
+
+	Disbursement struct {
+		BankCode      string `mapstructure:"DISBURSEMENT_BANK_CODE"`
+		AccountNumber string `mapstructure:"DISBURSEMENT_ACCOUNT_NUMBER"`
+		CompanyCode   string `mapstructure:"DISBURSEMENT_COMPANY_CODE"`
+	}
+
 }
 
 func NewConfig() (*Config, error) {
@@ -62,5 +125,50 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	err = viper.Unmarshal(&config.Attendance)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.Overtime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.BlobStore)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.Reimbursement)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.ExchangeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.Company)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.Payslip)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.SMTP)
+	if err != nil {
+		return nil, err
+	}
+
+	err = viper.Unmarshal(&config.Disbursement)
+	if err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
\ No newline at end of file
@@ -1,41 +1,61 @@
 package app
 
 import (
+	"context"
 	"log"
+	"strings"
 	"time"
 
 	v1 "payslip-generation-system/internal/controller/http/v1"
 	grace "payslip-generation-system/internal/grace"
 
 	"payslip-generation-system/internal/app/middleware"
+	"payslip-generation-system/internal/blobstore"
+	"payslip-generation-system/internal/disbursement"
+	"payslip-generation-system/internal/exchangerate"
 	"payslip-generation-system/internal/httpclient"
+	"payslip-generation-system/internal/mailer"
+	"payslip-generation-system/internal/payslipdoc"
 
 	"payslip-generation-system/internal/postgres"
 
 	"payslip-generation-system/config"
 
 	// common
+	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/payslip"
+	"payslip-generation-system/internal/entity/reimbursement"
 
 	// services
 	adminsvc "payslip-generation-system/internal/services/admin"
 	audsvc "payslip-generation-system/internal/services/audit"
 	authsvc "payslip-generation-system/internal/services/auth"
 	empsvc "payslip-generation-system/internal/services/employee"
+	payslipsvc "payslip-generation-system/internal/services/payslip"
 	pingsvc "payslip-generation-system/internal/services/ping"
 
 	// repositories
 	attrepo "payslip-generation-system/internal/repositories/attendance"
+	bankrepo "payslip-generation-system/internal/repositories/bankaccount"
 	audrepo "payslip-generation-system/internal/repositories/audit"
+	journalrepo "payslip-generation-system/internal/repositories/journal"
 	ovtrepo "payslip-generation-system/internal/repositories/overtime"
+	paymentrepo "payslip-generation-system/internal/repositories/payment"
 	payrepo "payslip-generation-system/internal/repositories/payslip"
 	pingrepo "payslip-generation-system/internal/repositories/ping"
 	reimbursrepo "payslip-generation-system/internal/repositories/reimbursement"
+	schedrepo "payslip-generation-system/internal/repositories/schedule"
 	userrepo "payslip-generation-system/internal/repositories/user"
 )
 
 type appHttp struct {
 	middleware   middleware.HttpMdwProvider
 	v1Controller v1.V1Controller
+
+	// payslipService emails the queued payslips every deliveryInterval, 0 when email delivery is off
+	payslipService   payslipsvc.PayslipServiceProvider
+	deliveryInterval time.Duration
 }
 
 // RegisterHandlers registers the http handlers
@@ -61,13 +81,71 @@ func NewAppHTTP(
 	reimbursementRepo := reimbursrepo.NewReimbursementRepository(database)
 	payslipRepo := payrepo.NewPayslipRepository(database)
 	auditRepo := audrepo.NewAuditRepository(database)
+	scheduleRepo := schedrepo.NewScheduleRepository(database)
+	bankAccountRepo := bankrepo.NewBankAccountRepository(database)
+	paymentRepo := paymentrepo.NewPaymentRepository(database)
+	journalRepo := journalrepo.NewJournalRepository(database)
+
+	clockPolicy, err := newClockPolicy(config)
+	if err != nil {
+		log.Fatalf("error init attendance clock policy %s", err.Error())
+	}
+	overtimePolicy := newOvertimePolicy(config)
+	receiptPolicy := newReceiptPolicy(config)
+
+	blobStoreDir := config.BlobStore.Dir
+	if blobStoreDir == "" {
+		blobStoreDir = "./storage"
+	}
+	blobStore, err := blobstore.NewLocalStore(blobStoreDir)
+	if err != nil {
+		log.Fatalf("error init blob store %s", err.Error())
+	}
+
+	// without a provider foreign currency claims only use the rates admins add by hand
+	var rateProvider exchangerate.Provider
+	if config.ExchangeRate.ProviderURL != "" {
+		rateProvider = exchangerate.NewHTTPProvider(SetupHttpClient(config), config.ExchangeRate.ProviderURL)
+	}
+	ratePolicy := reimbursement.ExchangeRatePolicy{MaxAgeDays: config.ExchangeRate.MaxAgeDays}
+	if ratePolicy.MaxAgeDays <= 0 {
+		ratePolicy.MaxAgeDays = 7
+	}
+
+	duplicatePolicy := newDuplicatePolicy(config)
+
+	passwordRule, err := payslipdoc.ParsePasswordRule(config.Payslip.PasswordRule)
+	if err != nil {
+		log.Fatalf("error init payslip password rule %s", err.Error())
+	}
+
+	// without an SMTP server payslips are still queued, they are sent once one is configured
+	var sender mailer.Sender
+	if config.SMTP.Host != "" {
+		sender, err = mailer.NewSMTPSender(mailer.SMTPConfig{
+			Host:     config.SMTP.Host,
+			Port:     config.SMTP.Port,
+			Username: config.SMTP.Username,
+			Password: config.SMTP.Password,
+			From:     config.SMTP.From,
+			TLS:      config.SMTP.TLS,
+		})
+		if err != nil {
+			log.Fatalf("error init smtp sender %s", err.Error())
+		}
+	}
+	deliveryPolicy, deliveryInterval := newDeliveryPolicy(config)
+	if sender == nil {
+		deliveryInterval = 0
+	}
 
 	// init repositories
 	pingService := pingsvc.NewPingService(pingRepo)
 	authService:= authsvc.NewAuthService(userRepo, []byte(config.JWT.SecretKey))
 	auditService:= audsvc.NewAuditService(auditRepo)
-	adminService := adminsvc.NewAdminService(attendanceRepo, payslipRepo, reimbursementRepo, overtimeRepo, userRepo, auditService)
-	employeeService := empsvc.NewEmployeeService(attendanceRepo, overtimeRepo, reimbursementRepo, payslipRepo, auditService)
+	adminService := adminsvc.NewAdminService(attendanceRepo, payslipRepo, reimbursementRepo, overtimeRepo, userRepo, scheduleRepo, auditService, clockPolicy, overtimePolicy, rateProvider, bankAccountRepo)
+	payslipService := payslipsvc.NewPayslipService(payslipRepo, attendanceRepo, userRepo, bankAccountRepo, paymentRepo, journalRepo, auditService, newCompany(config), passwordRule, sender, deliveryPolicy, newOriginator(config))
+	employeeService := empsvc.NewEmployeeService(attendanceRepo, overtimeRepo, reimbursementRepo, payslipRepo, scheduleRepo, userRepo, auditService, clockPolicy, overtimePolicy, blobStore, receiptPolicy, rateProvider, ratePolicy, duplicatePolicy, bankAccountRepo)
 
 	// init controllers
 	v1Controller := v1.NewV1Controller(
@@ -75,6 +153,7 @@ func NewAppHTTP(
 		authService,
 		adminService,
 		employeeService,
+		payslipService,
 	)
 
 	middleware := middleware.NewMiddleWare(
@@ -82,13 +161,21 @@ func NewAppHTTP(
 	) 
 
 	return &appHttp{
-		middleware:   middleware,
-		v1Controller: v1Controller,
+		middleware:       middleware,
+		v1Controller:     v1Controller,
+		payslipService:   payslipService,
+		deliveryInterval: deliveryInterval,
 	}
 }
 
 // Run runs the http app
 func (a *appHttp) Run(config *config.Config) {
+	if a.deliveryInterval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go a.runPayslipDelivery(ctx)
+	}
+
 	// run http server
 	grace.Serve(
 		config.Port,
@@ -96,6 +183,111 @@ func (a *appHttp) Run(config *config.Config) {
 	)
 }
 
+// runPayslipDelivery emails the queued payslips until ctx is done, a failed run is tried again on the next tick
+func (a *appHttp) runPayslipDelivery(ctx context.Context) {
+	ticker := time.NewTicker(a.deliveryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := a.payslipService.DeliverQueuedPayslips(ctx)
+			if err != nil {
+				log.Printf("error delivering payslips %s", err.Error())
+			}
+			if sent > 0 {
+				log.Printf("delivered %d payslips", sent)
+			}
+		}
+	}
+}
+
+// newClockPolicy builds the attendance clock-in / clock-out rules from config
+func newClockPolicy(cfg *config.Config) (attendance.ClockPolicy, error) {
+	location := time.Local
+	if cfg.Attendance.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Attendance.Timezone)
+		if err != nil {
+			return attendance.ClockPolicy{}, err
+		}
+		location = loc
+	}
+
+	shiftStart := "09:00"
+	if cfg.Attendance.ShiftStart != "" {
+		shiftStart = cfg.Attendance.ShiftStart
+	}
+	parsedShiftStart, err := time.Parse("15:04", shiftStart)
+	if err != nil {
+		return attendance.ClockPolicy{}, err
+	}
+
+	maxShiftHours := 16
+	if cfg.Attendance.MaxShiftHours > 0 {
+		maxShiftHours = cfg.Attendance.MaxShiftHours
+	}
+
+	return attendance.ClockPolicy{
+		ShiftStart:       time.Duration(parsedShiftStart.Hour())*time.Hour + time.Duration(parsedShiftStart.Minute())*time.Minute,
+		MinWorkDuration:  time.Duration(cfg.Attendance.MinWorkHours) * time.Hour,
+		MaxShiftDuration: time.Duration(maxShiftHours) * time.Hour,
+		Location:         location,
+	}, nil
+}
+
+// newOvertimePolicy builds the per type overtime limits, pay rates and rounding from config,
+// regular overtime defaults to the statutory 4 hours a day and 18 hours a week
+// and durations round down to 15 minutes by default
+func newOvertimePolicy(cfg *config.Config) overtime.Policy {
+	orDefault := func(value, fallback int) int {
+		if value > 0 {
+			return value
+		}
+		return fallback
+	}
+
+	roundingMode := strings.ToLower(cfg.Overtime.RoundingMode)
+	if roundingMode != overtime.RoundNearest && roundingMode != overtime.RoundUp {
+		roundingMode = overtime.RoundDown
+	}
+
+	return overtime.Policy{
+		MaxHours: map[string]int{
+			overtime.TypeRegular:       orDefault(cfg.Overtime.DailyLimitHours, 4),
+			overtime.TypeRestDay:       orDefault(cfg.Overtime.RestDayMaxHours, 12),
+			overtime.TypePublicHoliday: orDefault(cfg.Overtime.PublicHolidayMaxHours, 12),
+		},
+		RatePercent: map[string]int{
+			overtime.TypeRegular:       orDefault(cfg.Overtime.RegularRatePercent, 100),
+			overtime.TypeRestDay:       orDefault(cfg.Overtime.RestDayRatePercent, 100),
+			overtime.TypePublicHoliday: orDefault(cfg.Overtime.PublicHolidayRatePercent, 100),
+		},
+		RoundingMinutes:  orDefault(cfg.Overtime.RoundingMinutes, 15),
+		RoundingMode:     roundingMode,
+		WeeklyLimitHours: orDefault(cfg.Overtime.WeeklyLimitHours, 18),
+		PeriodLimitHours: cfg.Overtime.PeriodLimitHours,
+	}
+}
+
+// newReceiptPolicy builds the reimbursement receipt rules from config,
+// every claim needs at least one receipt, by default up to 5 files of at most 5 MB each
+func newReceiptPolicy(cfg *config.Config) reimbursement.ReceiptPolicy {
+	maxSizeMB := cfg.Reimbursement.ReceiptMaxSizeMB
+	if maxSizeMB <= 0 {
+		maxSizeMB = 5
+	}
+	maxFiles := cfg.Reimbursement.ReceiptMaxFiles
+	if maxFiles <= 0 {
+		maxFiles = 5
+	}
+	return reimbursement.ReceiptPolicy{
+		MinFiles:     1,
+		MaxFiles:     maxFiles,
+		MaxSizeBytes: int64(maxSizeMB) << 20,
+	}
+}
+
 func SetupHttpClient(cfg *config.Config) httpclient.Client {
 	httpClientCfg := &httpclient.Config{
 		Timeout: cfg.HTTPClient.TimeoutMS,
@@ -116,3 +308,61 @@ func SetupHttpClient(cfg *config.Config) httpclient.Client {
 
 	return httpclient.New(httpClientCfg)
 }
+
+// newDuplicatePolicy builds the duplicate claim rules from config,
+// by default claims of the last 30 days with descriptions at least 80% alike are compared
+func newDuplicatePolicy(cfg *config.Config) reimbursement.DuplicatePolicy {
+	windowDays := cfg.Reimbursement.DuplicateWindowDays
+	if windowDays <= 0 {
+		windowDays = 30
+	}
+	minSimilarity := cfg.Reimbursement.DuplicateMinSimilarity
+	if minSimilarity <= 0 || minSimilarity > 1 {
+		minSimilarity = 0.8
+	}
+	return reimbursement.DuplicatePolicy{
+		WindowDays:    windowDays,
+		MinSimilarity: minSimilarity,
+	}
+}
+
+// newCompany is the company printed on payslips, the address may use \n between its lines
+func newCompany(cfg *config.Config) payslipdoc.Company {
+	return payslipdoc.Company{
+		Name:    cfg.Company.Name,
+		Address: strings.ReplaceAll(cfg.Company.Address, `\n`, "\n"),
+	}
+}
+
+// newDeliveryPolicy builds the payslip email retries and how often the queue is checked from config,
+// by default a payslip is tried 5 times starting 5 minutes apart and the queue is checked every 30 seconds
+func newDeliveryPolicy(cfg *config.Config) (payslip.DeliveryPolicy, time.Duration) {
+	maxAttempts := cfg.SMTP.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	retryMinutes := cfg.SMTP.RetryIntervalMinutes
+	if retryMinutes <= 0 {
+		retryMinutes = 5
+	}
+	pollSeconds := cfg.SMTP.PollIntervalSeconds
+	if pollSeconds <= 0 {
+		pollSeconds = 30
+	}
+	return payslip.DeliveryPolicy{
+		MaxAttempts:   maxAttempts,
+		RetryInterval: time.Duration(retryMinutes) * time.Minute,
+	}, time.Duration(pollSeconds) * time.Second
+}
+
+// newOriginator is the company account bank disbursement files pay salaries from, held by the company
+func newOriginator(cfg *config.Config) disbursement.Originator {
+	return disbursement.Originator{
+		CompanyCode: cfg.Disbursement.CompanyCode,
+		Account: disbursement.Account{
+			BankCode: cfg.Disbursement.BankCode,
+			Number:   cfg.Disbursement.AccountNumber,
+			Holder:   cfg.Company.Name,
+		},
+	}
+}
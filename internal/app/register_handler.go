@@ -32,9 +32,38 @@ func (a *appHttp) registerV1(r *gin.RouterGroup, cfg *config.Config) {
 	employeeGroup.Use(a.middleware.LoggingMiddleware())
 	employeeGroup.Use(a.middleware.JWTMiddleware([]byte(cfg.JWT.SecretKey)))
 	employeeGroup.POST("/submit-attendance", a.v1Controller.SubmitAttendance)
+	employeeGroup.POST("/clock-in", a.v1Controller.ClockIn)
+	employeeGroup.POST("/clock-out", a.v1Controller.ClockOut)
+	employeeGroup.POST("/request-attendance-correction", a.v1Controller.RequestAttendanceCorrection)
 	employeeGroup.POST("/submit-overtime", a.v1Controller.SubmitOvertime)
+	// managers review the overtime of their direct reports
+	employeeGroup.GET("/team-overtimes", a.v1Controller.GetOvertimes)
+	employeeGroup.POST("/review-overtime", a.v1Controller.ReviewOvertime)
+	employeeGroup.POST("/bulk-approve-overtimes", a.v1Controller.BulkApproveOvertimes)
+	employeeGroup.GET("/reimbursement-categories", a.v1Controller.GetReimbursementCategories)
+	employeeGroup.GET("/reimbursement-rates", a.v1Controller.GetReimbursementRates)
 	employeeGroup.POST("/submit-reimbursement", a.v1Controller.SubmitReimbursement)
+	employeeGroup.GET("/reimbursement-attachments/:id", a.v1Controller.DownloadReimbursementAttachment)
 	employeeGroup.GET("/generate-payslips", a.v1Controller.GeneratePayslips)
+	employeeGroup.GET("/payslips/:id/pdf", a.v1Controller.DownloadPayslipPDF)
+	// employees list what they submitted themselves
+	employeeGroup.GET("/attendances", a.v1Controller.GetMyAttendances)
+	employeeGroup.GET("/overtimes", a.v1Controller.GetMyOvertimes)
+	employeeGroup.GET("/reimbursements", a.v1Controller.GetMyReimbursements)
+	// and can change or take back submissions until payroll is generated for the period
+	employeeGroup.POST("/amend-attendance", a.v1Controller.AmendAttendance)
+	employeeGroup.POST("/withdraw-attendance", a.v1Controller.WithdrawAttendance)
+	employeeGroup.POST("/amend-overtime", a.v1Controller.AmendOvertime)
+	employeeGroup.POST("/withdraw-overtime", a.v1Controller.WithdrawOvertime)
+	employeeGroup.POST("/amend-reimbursement", a.v1Controller.AmendReimbursement)
+	employeeGroup.POST("/withdraw-reimbursement", a.v1Controller.WithdrawReimbursement)
+	// bank accounts are only paid to after an admin has verified them
+	employeeGroup.GET("/bank-accounts", a.v1Controller.GetMyBankAccounts)
+	employeeGroup.POST("/submit-bank-account", a.v1Controller.SubmitBankAccount)
+	// part of the take home pay can go to other verified accounts, the rest goes to the primary account
+	employeeGroup.GET("/salary-allocations", a.v1Controller.GetSalaryAllocations)
+	employeeGroup.POST("/set-salary-allocation", a.v1Controller.SetSalaryAllocation)
+	employeeGroup.POST("/remove-salary-allocation", a.v1Controller.RemoveSalaryAllocation)
 
 	adminGroup := r.Group("/admin")
 	employeeGroup.Use(a.middleware.LoggingMiddleware())
@@ -42,4 +71,50 @@ func (a *appHttp) registerV1(r *gin.RouterGroup, cfg *config.Config) {
 	adminGroup.POST("/add-attendance-period", a.v1Controller.AddAttendancePeriod)
 	adminGroup.POST("/run-payroll", a.v1Controller.RunPayroll)
 	adminGroup.GET("/get-payslip-summary/:period_id", a.v1Controller.GetPayslipSummary)
+	adminGroup.GET("/download-payslips/:period_id", a.v1Controller.DownloadPeriodPayslips)
+	adminGroup.POST("/prepare-payments", a.v1Controller.PreparePayments)
+	adminGroup.GET("/download-disbursement/:period_id", a.v1Controller.DownloadDisbursementFile)
+	adminGroup.POST("/add-shift", a.v1Controller.AddShift)
+	adminGroup.POST("/assign-schedule", a.v1Controller.AssignSchedule)
+	adminGroup.POST("/import-attendance", a.v1Controller.ImportAttendance)
+	adminGroup.GET("/attendance-corrections", a.v1Controller.GetAttendanceCorrections)
+	adminGroup.POST("/review-attendance-correction", a.v1Controller.ReviewAttendanceCorrection)
+	adminGroup.GET("/overtimes", a.v1Controller.GetOvertimes)
+	adminGroup.POST("/review-overtime", a.v1Controller.ReviewOvertime)
+	adminGroup.POST("/bulk-approve-overtimes", a.v1Controller.BulkApproveOvertimes)
+	adminGroup.POST("/submit-overtime-override", a.v1Controller.SubmitOvertimeOverride)
+	adminGroup.POST("/add-public-holiday", a.v1Controller.AddPublicHoliday)
+	adminGroup.GET("/reimbursement-categories", a.v1Controller.GetReimbursementCategories)
+	adminGroup.POST("/update-reimbursement-category", a.v1Controller.UpdateReimbursementCategory)
+	adminGroup.GET("/reimbursement-attachments/:id", a.v1Controller.DownloadReimbursementAttachment)
+	adminGroup.GET("/reimbursements", a.v1Controller.GetReimbursements)
+	adminGroup.POST("/review-reimbursement", a.v1Controller.ReviewReimbursement)
+	adminGroup.GET("/reimbursement-duplicates", a.v1Controller.GetReimbursementDuplicates)
+	adminGroup.GET("/reimbursement-rates", a.v1Controller.GetReimbursementRates)
+	adminGroup.POST("/add-reimbursement-rate", a.v1Controller.AddReimbursementRate)
+	adminGroup.GET("/exchange-rates", a.v1Controller.GetExchangeRates)
+	adminGroup.POST("/add-exchange-rate", a.v1Controller.AddExchangeRate)
+	adminGroup.POST("/fetch-exchange-rate", a.v1Controller.FetchExchangeRate)
+	adminGroup.GET("/payslip-templates", a.v1Controller.GetPayslipTemplates)
+	adminGroup.POST("/upload-payslip-template", a.v1Controller.UploadPayslipTemplate)
+	adminGroup.POST("/activate-payslip-template", a.v1Controller.ActivatePayslipTemplate)
+	adminGroup.POST("/preview-payslip-template", a.v1Controller.PreviewPayslipTemplate)
+	adminGroup.GET("/payslip-deliveries", a.v1Controller.GetPayslipDeliveries)
+	adminGroup.POST("/resend-payslip", a.v1Controller.ResendPayslip)
+	adminGroup.POST("/update-employee-email", a.v1Controller.UpdateEmployeeEmail)
+	adminGroup.POST("/update-employee-manager", a.v1Controller.UpdateEmployeeManager)
+	adminGroup.GET("/bank-accounts", a.v1Controller.GetBankAccounts)
+	adminGroup.POST("/add-bank-account", a.v1Controller.AddBankAccount)
+	adminGroup.POST("/review-bank-account", a.v1Controller.ReviewBankAccount)
+	// payments of a disbursement file are sent once the batch is marked sent, bank statements settle them
+	adminGroup.GET("/payments", a.v1Controller.GetPayments)
+	adminGroup.POST("/mark-payments-sent", a.v1Controller.MarkPaymentsSent)
+	adminGroup.POST("/update-payment-status", a.v1Controller.UpdatePaymentStatus)
+	adminGroup.POST("/import-bank-statement", a.v1Controller.ImportBankStatement)
+	adminGroup.GET("/statement-lines", a.v1Controller.GetStatementLines)
+	adminGroup.POST("/resolve-statement-line", a.v1Controller.ResolveStatementLine)
+	// the payroll journal posts a period to the accounts its components are mapped to per cost center
+	adminGroup.GET("/journal-accounts", a.v1Controller.GetJournalAccounts)
+	adminGroup.POST("/set-journal-account", a.v1Controller.SetJournalAccount)
+	adminGroup.GET("/download-journal/:period_id", a.v1Controller.DownloadJournal)
 }
\ No newline at end of file
@@ -0,0 +1,86 @@
+package bankstatement
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatCSV is a statement exported from a bank portal as CSV, FormatMT940 the SWIFT customer statement
+	// most banks can send
+	FormatCSV   = "csv"
+	FormatMT940 = "mt940"
+
+	// the debit or credit mark of an entry, a reversal undoes an earlier entry of the other direction
+	MarkCredit         = "C"
+	MarkDebit          = "D"
+	MarkReversalCredit = "RC"
+	MarkReversalDebit  = "RD"
+)
+
+// Entry is one booking on a bank statement
+type Entry struct {
+	// Line is the 1-based line of the file the entry starts on
+	Line      int
+	ValueDate time.Time
+	Mark      string
+	Amount    int
+	// AccountNumber is the account of the other party, empty when the statement doesn't have it
+	AccountNumber string
+	Reference     string
+	Description   string
+}
+
+// IsValidFormat reports whether format is a statement format that can be parsed
+func IsValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatMT940
+}
+
+// Parse reads the entries of a statement, a statement with an entry that can't be read is refused as a whole
+// so no booking is silently left out
+func Parse(format string, r io.Reader) ([]Entry, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatMT940:
+		return parseMT940(r)
+	}
+	return nil, fmt.Errorf("format must be %s or %s", FormatCSV, FormatMT940)
+}
+
+func isValidMark(mark string) bool {
+	return mark == MarkCredit || mark == MarkDebit || mark == MarkReversalCredit || mark == MarkReversalDebit
+}
+
+// parseAmount reads an amount in rupiah with the given decimal separator, the other of '.' and ',' may
+// separate thousands. Rupiah have no minor unit so only a zero fraction is accepted
+func parseAmount(value string, decimal byte) (int, error) {
+	thousands := ","
+	if decimal == ',' {
+		thousands = "."
+	}
+	value = strings.ReplaceAll(strings.TrimSpace(value), thousands, "")
+	whole, fraction, _ := strings.Cut(value, string(decimal))
+	if strings.Trim(fraction, "0") != "" {
+		return 0, fmt.Errorf("amount %s has a fraction of a rupiah", value)
+	}
+	amount, err := strconv.Atoi(whole)
+	if err != nil || amount < 0 {
+		return 0, fmt.Errorf("invalid amount %s", value)
+	}
+	return amount, nil
+}
+
+// cleanAccountNumber keeps the digits of an account number, statements print them with spaces or dashes
+func cleanAccountNumber(number string) string {
+	var b strings.Builder
+	for _, r := range number {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
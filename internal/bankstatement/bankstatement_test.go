@@ -0,0 +1,77 @@
+package bankstatement
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_CSV(t *testing.T) {
+	statement := "Date,Type,Account_Number,Amount,Reference,Description\n" +
+		"2025-07-01,C,123-456-7890,\"5,293,750.00\",PAYSLIP-12,Salary June\n" +
+		"\n" +
+		"01/07/2025,rc,1230004567890,1000000,PAYSLIP-12-2,Returned: account closed\n" +
+		"2025-07-01,D,0123456789,9350500,PAYROLL-3,\n"
+	entries, err := Parse(FormatCSV, strings.NewReader(statement))
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{Line: 2, ValueDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Mark: MarkCredit, Amount: 5293750, AccountNumber: "1234567890", Reference: "PAYSLIP-12", Description: "Salary June"},
+		{Line: 4, ValueDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Mark: MarkReversalCredit, Amount: 1000000, AccountNumber: "1230004567890", Reference: "PAYSLIP-12-2", Description: "Returned: account closed"},
+		{Line: 5, ValueDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), Mark: MarkDebit, Amount: 9350500, AccountNumber: "0123456789", Reference: "PAYROLL-3"},
+	}, entries)
+
+	_, err = Parse(FormatCSV, strings.NewReader("date,type,amount\n2025-07-01,C,100\n"))
+	assert.ErrorContains(t, err, "no account_number column")
+	_, err = Parse(FormatCSV, strings.NewReader("date,type,account_number,amount\n2025-07-01,C,1234567890,100.50\n"))
+	assert.ErrorContains(t, err, "line 2")
+	_, err = Parse(FormatCSV, strings.NewReader("date,type,account_number,amount\n2025-07-01,X,1234567890,100\n"))
+	assert.Error(t, err)
+	_, err = Parse("xls", strings.NewReader(""))
+	assert.Error(t, err)
+}
+
+func TestParse_MT940(t *testing.T) {
+	statement := "{1:F01BNINIDJAXXXX0000000000}{2:O9400000250701BNINIDJAXXXX00000000002507010000N}{4:\n" +
+		":20:STMT250701\n" +
+		":25:0123456789\n" +
+		":28C:1/1\n" +
+		":60F:C250630IDR1000000000,00\n" +
+		":61:2507010701C5293750,00NTRFPAYSLIP-12//B250701001\n" +
+		":86:/EREF/PAYSLIP-12/BENM//ACCT/1234567890/NAME/BUDI SANTOSO/REMI/SA\n" +
+		"LARY JUNE 2025\n" +
+		":61:250702RC1000000,NTRFNONREF\n" +
+		"RETURN ACCOUNT CLOSED\n" +
+		":86:/EREF/PAYSLIP-1234-5678/BENM//ACCT/123 000 4567890\n" +
+		":61:250702D9350500,00NTRFPAYROLL-3\n" +
+		":62F:C250702IDR990649750,00\n" +
+		"-}\n"
+	entries, err := Parse(FormatMT940, strings.NewReader(statement))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, Entry{
+		Line:          6,
+		ValueDate:     time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		Mark:          MarkCredit,
+		Amount:        5293750,
+		AccountNumber: "1234567890",
+		Reference:     "PAYSLIP-12",
+		Description:   "/EREF/PAYSLIP-12/BENM//ACCT/1234567890/NAME/BUDI SANTOSO/REMI/SALARY JUNE 2025",
+	}, entries[0])
+	// the owner's reference is cut at 16 characters, the end to end reference of :86: is the full one
+	assert.Equal(t, MarkReversalCredit, entries[1].Mark)
+	assert.Equal(t, 1000000, entries[1].Amount)
+	assert.Equal(t, "PAYSLIP-1234-5678", entries[1].Reference)
+	assert.Equal(t, "1230004567890", entries[1].AccountNumber)
+	assert.Equal(t, MarkDebit, entries[2].Mark)
+	assert.Equal(t, "PAYROLL-3", entries[2].Reference)
+	assert.Equal(t, "", entries[2].AccountNumber)
+
+	_, err = Parse(FormatMT940, strings.NewReader(":20:STMT\n:61:2507X1C100,00NTRF\n"))
+	assert.ErrorContains(t, err, "line 2")
+	_, err = Parse(FormatMT940, strings.NewReader(":20:STMT\n:61:250701C100,50NTRFNONREF\n"))
+	assert.Error(t, err)
+	_, err = Parse(FormatMT940, strings.NewReader(""))
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+package bankstatement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// csvDateLayouts are the date formats bank portals export
+var csvDateLayouts = []string{"2006-01-02", "02/01/2006", "02-01-2006"}
+
+// parseCSV reads a statement with a header row naming its columns, date, type (C, D, RC or RD), account_number
+// and amount are required, reference and description are optional. Amounts use '.' for decimals
+func parseCSV(r io.Reader) ([]Entry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("statement is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"date", "type", "account_number", "amount"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("statement has no %s column", name)
+		}
+	}
+
+	entries := []Entry{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		entry := Entry{
+			Line:          line,
+			Mark:          strings.ToUpper(field("type")),
+			AccountNumber: cleanAccountNumber(field("account_number")),
+			Reference:     field("reference"),
+			Description:   field("description"),
+		}
+		if !isValidMark(entry.Mark) {
+			return nil, fmt.Errorf("line %d: type must be C, D, RC or RD", line)
+		}
+		entry.ValueDate, err = parseCSVDate(field("date"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entry.Amount, err = parseAmount(field("amount"), '.')
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func parseCSVDate(value string) (time.Time, error) {
+	for _, layout := range csvDateLayouts {
+		date, err := time.Parse(layout, value)
+		if err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %s", value)
+}
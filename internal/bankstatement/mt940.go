@@ -0,0 +1,110 @@
+package bankstatement
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// statementLine is the first line of a :61: field: value date YYMMDD, an optional entry date MMDD, the mark,
+// an optional funds code, the amount with a decimal comma, the transaction type, the reference for the
+// account owner and, after //, the reference of the bank
+var statementLine = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+,\d*)([A-Z][A-Z0-9]{3})([^/]*)(?://(.*))?$`)
+
+// information codes of the structured :86: field this reads, the end to end reference and the account
+// of the other party, e.g. /EREF/PAYSLIP-12/BENM//ACCT/1234567890/NAME/BUDI SANTOSO
+var (
+	endToEndReference = regexp.MustCompile(`/EREF/([^/]+)`)
+	otherAccount      = regexp.MustCompile(`/ACCT/([^/]+)`)
+)
+
+type mt940Field struct {
+	tag   string
+	line  int
+	value string
+}
+
+// parseMT940 reads the :61: statement lines with the :86: information that follows them, the balances and
+// other fields of the statement aren't needed to settle payments
+func parseMT940(r io.Reader) ([]Entry, error) {
+	fields, err := mt940Fields(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for i, field := range fields {
+		if field.tag != "61" {
+			continue
+		}
+		first, _, _ := strings.Cut(field.value, "\n")
+		match := statementLine.FindStringSubmatch(first)
+		if match == nil {
+			return nil, fmt.Errorf("line %d: invalid statement line %s", field.line, first)
+		}
+		entry := Entry{Line: field.line, Mark: match[3]}
+		entry.ValueDate, err = time.Parse("060102", match[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid value date %s", field.line, match[1])
+		}
+		entry.Amount, err = parseAmount(match[5], ',')
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", field.line, err)
+		}
+		if reference := strings.TrimSpace(match[7]); reference != "NONREF" {
+			entry.Reference = reference
+		}
+
+		if i+1 < len(fields) && fields[i+1].tag == "86" {
+			// the information is wrapped at 65 characters wherever the line was full
+			information := strings.ReplaceAll(fields[i+1].value, "\n", "")
+			entry.Description = strings.TrimSpace(information)
+			if m := otherAccount.FindStringSubmatch(information); m != nil {
+				entry.AccountNumber = cleanAccountNumber(m[1])
+			}
+			// the owner's reference is at most 16 characters, the end to end reference is the full one
+			if m := endToEndReference.FindStringSubmatch(information); m != nil && m[1] != "NOTPROVIDED" {
+				entry.Reference = strings.TrimSpace(m[1])
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// mt940Fields splits a statement into its fields, a line that doesn't start a field continues the one before
+func mt940Fields(r io.Reader) ([]mt940Field, error) {
+	fields := []mt940Field{}
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimRight(scanner.Text(), "\r ")
+		// statements sent as a SWIFT message have header blocks before the text block {4: and end it with -}
+		if start := strings.Index(line, "{4:"); start >= 0 {
+			line = line[start+3:]
+		}
+		if line == "" || line == "-" || line == "-}" || strings.HasPrefix(line, "{") {
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			tag, value, ok := strings.Cut(line[1:], ":")
+			if ok {
+				fields = append(fields, mt940Field{tag: tag, line: number, value: value})
+				continue
+			}
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("line %d: statement must start with a field", number)
+		}
+		fields[len(fields)-1].value += "\n" + line
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("statement is empty")
+	}
+	return fields, nil
+}
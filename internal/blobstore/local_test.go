@@ -0,0 +1,41 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_localStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	err = store.Put(ctx, "reimbursements/1/receipt.pdf", strings.NewReader("%PDF-1.4"))
+	assert.NoError(t, err)
+
+	r, err := store.Get(ctx, "reimbursements/1/receipt.pdf")
+	assert.NoError(t, err)
+	content, err := io.ReadAll(r)
+	r.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4", string(content))
+
+	assert.NoError(t, store.Delete(ctx, "reimbursements/1/receipt.pdf"))
+	_, err = store.Get(ctx, "reimbursements/1/receipt.pdf")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, "reimbursements/1/receipt.pdf"))
+}
+
+func Test_localStore_InvalidKey(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"", ".", "..", "../outside", "/etc/passwd"} {
+		err := store.Put(context.Background(), key, strings.NewReader("x"))
+		assert.Error(t, err, key)
+	}
+}
@@ -3,12 +3,23 @@ package v1
 import (
 	"context"
 	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"time"
 
+	"payslip-generation-system/internal/bankstatement"
 	serverctrl "payslip-generation-system/internal/controller/http"
+	"payslip-generation-system/internal/disbursement"
 	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/bankaccount"
+	journalmodel "payslip-generation-system/internal/entity/journal"
+	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/entity/schedule"
+	"payslip-generation-system/internal/journal"
+	payslipsvc "payslip-generation-system/internal/services/payslip"
 
 	"github.com/gin-gonic/gin"
 )
@@ -117,3 +128,1447 @@ func (v1 *v1Controller) GetPayslipSummary(c *gin.Context){
 	serverctrl.ResponseHandler(c, http.StatusOK, summary, nil)
 }
 
+// DownloadPeriodPayslips sends a ZIP with the PDF payslip of every employee paid in the period
+func (v1 *v1Controller) DownloadPeriodPayslips(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Minute*2)
+	defer cancelCtx()
+
+	periodID, err := strconv.Atoi(c.Param("period_id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+		return
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	fileName, content, err := v1.payslipService.GetPeriodPayslipsZip(ctx, periodID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	c.Data(http.StatusOK, "application/zip", content)
+}
+
+func (v1 *v1Controller) AddShift(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Name             string `json:"name"`
+		WorkPattern      string `json:"work_pattern"`
+		PatternStartDate string `json:"pattern_start_date"`
+		StartTime        string `json:"start_time"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	patternStartDate, err := time.Parse("2006-01-02", req.PatternStartDate)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input pattern_start_date"))
+		return
+	}
+
+	shift := schedule.Shift{
+		Name:             req.Name,
+		WorkPattern:      req.WorkPattern,
+		PatternStartDate: patternStartDate,
+		StartTime:        req.StartTime,
+	}
+	if shift.StartTime == "" {
+		shift.StartTime = "09:00"
+	}
+	id, err := v1.adminService.AddShift(ctx, shift, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	shift.ID = id
+	serverctrl.ResponseHandler(c, http.StatusOK, shift, nil)
+}
+
+func (v1 *v1Controller) AssignSchedule(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		UserID        int    `json:"user_id"`
+		ShiftID       int    `json:"shift_id"`
+		EffectiveFrom string `json:"effective_from"`
+		EffectiveTo   string `json:"effective_to"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	effectiveFrom, err := time.Parse("2006-01-02", req.EffectiveFrom)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input effective_from"))
+		return
+	}
+
+	employeeSchedule := schedule.EmployeeSchedule{
+		UserID:        req.UserID,
+		ShiftID:       req.ShiftID,
+		EffectiveFrom: effectiveFrom,
+	}
+	if req.EffectiveTo != "" {
+		effectiveTo, err := time.Parse("2006-01-02", req.EffectiveTo)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input effective_to"))
+			return
+		}
+		employeeSchedule.EffectiveTo = &effectiveTo
+	}
+
+	id, err := v1.adminService.AssignSchedule(ctx, employeeSchedule, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	employeeSchedule.ID = id
+	serverctrl.ResponseHandler(c, http.StatusOK, employeeSchedule, nil)
+}
+
+func (v1 *v1Controller) ImportAttendance(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*60)
+	defer cancelCtx()
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	periodID, err := strconv.Atoi(c.PostForm("period_id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input period_id"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input file"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input file"))
+		return
+	}
+	defer file.Close()
+
+	format := c.DefaultPostForm("format", attendance.ImportFormatCSV)
+	report, err := v1.adminService.ImportAttendance(ctx, periodID, format, file, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, report, nil)
+}
+
+func (v1 *v1Controller) GetAttendanceCorrections(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	corrections, err := v1.adminService.GetAttendanceCorrections(ctx, c.Query("status"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, corrections, nil)
+}
+
+func (v1 *v1Controller) ReviewAttendanceCorrection(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		CorrectionID int    `json:"correction_id"`
+		Status       string `json:"status"`
+		Note         string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	correction, err := v1.adminService.ReviewAttendanceCorrection(ctx, req.CorrectionID, req.Status, req.Note, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, correction, nil)
+}
+
+// GetOvertimes is served to admins and, for their direct reports, to managers
+func (v1 *v1Controller) GetOvertimes(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID := 0
+	if periodIDStr := c.Query("period_id"); periodIDStr != "" {
+		var err error
+		periodID, err = strconv.Atoi(periodIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+			return
+		}
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+
+	overtimes, err := v1.adminService.GetOvertimes(ctx, c.Query("status"), periodID, userID, isAdmin)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, overtimes, nil)
+}
+
+// ReviewOvertime is served to admins and, for their direct reports, to managers
+func (v1 *v1Controller) ReviewOvertime(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		OvertimeID int    `json:"overtime_id"`
+		Status     string `json:"status"`
+		Note       string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+
+	overtimes, err := v1.adminService.ReviewOvertimes(ctx, []int{req.OvertimeID}, req.Status, req.Note, userID, isAdmin, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, overtimes[0], nil)
+}
+
+// BulkApproveOvertimes is served to admins and, for their direct reports, to managers
+func (v1 *v1Controller) BulkApproveOvertimes(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	var req struct {
+		OvertimeIDs []int  `json:"overtime_ids"`
+		Note        string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+
+	overtimes, err := v1.adminService.ReviewOvertimes(ctx, req.OvertimeIDs, overtime.StatusApproved, req.Note, userID, isAdmin, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, overtimes, nil)
+}
+
+func (v1 *v1Controller) AddPublicHoliday(c *gin.Context){
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Date string `json:"date"`
+		Name string `json:"name"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input date"))
+		return
+	}
+
+	holiday := schedule.PublicHoliday{
+		Date: date,
+		Name: req.Name,
+	}
+	id, err := v1.adminService.AddPublicHoliday(ctx, holiday, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	holiday.ID = id
+	serverctrl.ResponseHandler(c, http.StatusOK, holiday, nil)
+}
+
+// SubmitOvertimeOverride records overtime for an employee above the day, week or period overtime limits,
+// the override reason is stored with the overtime and in its audit log
+func (v1 *v1Controller) SubmitOvertimeOverride(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		UserID         int    `json:"user_id"`
+		PeriodID       int    `json:"period_id"`
+		StartAt        string `json:"start_at"`
+		EndAt          string `json:"end_at"`
+		Type           string `json:"type"`
+		OverrideReason string `json:"override_reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	startAt, err := time.Parse(time.RFC3339, req.StartAt)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("start_at must be in RFC3339 format"))
+		return
+	}
+	endAt, err := time.Parse(time.RFC3339, req.EndAt)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("end_at must be in RFC3339 format"))
+		return
+	}
+
+	ot := overtime.Overtime{
+		UserID:              req.UserID,
+		PeriodID:            req.PeriodID,
+		StartAt:             &startAt,
+		EndAt:               &endAt,
+		Type:                req.Type,
+		LimitOverriddenBy:   &userID,
+		LimitOverrideReason: req.OverrideReason,
+	}
+	id, err := v1.employeeService.SubmitOvertime(ctx, ot, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	ot.ID = id
+	serverctrl.ResponseHandler(c, http.StatusOK, ot, nil)
+}
+
+// GetReimbursementCategories is served to admins and employees, employees need the codes and limits to claim
+func (v1 *v1Controller) GetReimbursementCategories(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	categories, err := v1.adminService.GetReimbursementCategories(ctx)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, categories, nil)
+}
+
+func (v1 *v1Controller) UpdateReimbursementCategory(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Code            string   `json:"code"`
+		Name            string   `json:"name"`
+		PerClaimLimit   int      `json:"per_claim_limit"`
+		PerPeriodLimit  int      `json:"per_period_limit"`
+		PerYearLimit    int      `json:"per_year_limit"`
+		EligibleGrades  []string `json:"eligible_grades"`
+		OverLimitAction string   `json:"over_limit_action"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	category := reimbursement.Category{
+		Code:            req.Code,
+		Name:            req.Name,
+		PerClaimLimit:   req.PerClaimLimit,
+		PerPeriodLimit:  req.PerPeriodLimit,
+		PerYearLimit:    req.PerYearLimit,
+		EligibleGrades:  req.EligibleGrades,
+		OverLimitAction: req.OverLimitAction,
+	}
+	result, err := v1.adminService.UpdateReimbursementCategory(ctx, category, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) GetReimbursements(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID := 0
+	if periodIDStr := c.Query("period_id"); periodIDStr != "" {
+		var err error
+		periodID, err = strconv.Atoi(periodIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+			return
+		}
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	reimbursements, err := v1.adminService.GetReimbursements(ctx, c.Query("status"), periodID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, reimbursements, nil)
+}
+
+// GetReimbursementDuplicates lists the suspected duplicate claims with the claims they appear to repeat
+func (v1 *v1Controller) GetReimbursementDuplicates(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID := 0
+	if periodIDStr := c.Query("period_id"); periodIDStr != "" {
+		var err error
+		periodID, err = strconv.Atoi(periodIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+			return
+		}
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	report, err := v1.adminService.GetDuplicateReport(ctx, c.Query("status"), periodID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, report, nil)
+}
+
+// ReviewReimbursement approves or rejects a submitted or held claim, an approved_amount below the claim partially approves it
+func (v1 *v1Controller) ReviewReimbursement(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		ReimbursementID int    `json:"reimbursement_id"`
+		Status          string `json:"status"`
+		ApprovedAmount  *int   `json:"approved_amount"`
+		Notes           string `json:"notes"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.adminService.ReviewReimbursement(ctx, req.ReimbursementID, req.Status, req.ApprovedAmount, req.Notes, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) GetExchangeRates(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	rates, err := v1.adminService.GetExchangeRates(ctx, c.Query("currency"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, rates, nil)
+}
+
+// AddExchangeRate records a manually maintained rate, rate is the IDR amount for one unit of the currency
+func (v1 *v1Controller) AddExchangeRate(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Currency string  `json:"currency"`
+		RateDate string  `json:"rate_date"`
+		Rate     float64 `json:"rate"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	rateDate, err := time.Parse("2006-01-02", req.RateDate)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid rate_date format, must be YYYY-MM-DD"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	rate := reimbursement.ExchangeRate{
+		Currency: req.Currency,
+		RateDate: rateDate,
+		Rate:     req.Rate,
+	}
+	result, err := v1.adminService.AddExchangeRate(ctx, rate, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// GetReimbursementRates lists the mileage and per diem rates so employees can see what a claim will pay
+func (v1 *v1Controller) GetReimbursementRates(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	rates, err := v1.adminService.GetReimbursementRates(ctx, c.Query("calculation"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, rates, nil)
+}
+
+// AddReimbursementRate sets a mileage rate per kilometer or a per diem rate per day for a destination from effective_from on
+func (v1 *v1Controller) AddReimbursementRate(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Calculation   string `json:"calculation"`
+		Destination   string `json:"destination"`
+		Rate          int    `json:"rate"`
+		EffectiveFrom string `json:"effective_from"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	effectiveFrom, err := time.Parse("2006-01-02", req.EffectiveFrom)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid effective_from format, must be YYYY-MM-DD"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	rate := reimbursement.Rate{
+		Calculation:   req.Calculation,
+		Destination:   req.Destination,
+		Rate:          req.Rate,
+		EffectiveFrom: effectiveFrom,
+	}
+	result, err := v1.adminService.AddReimbursementRate(ctx, rate, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// FetchExchangeRate stores the current rate of a currency from the configured provider
+func (v1 *v1Controller) FetchExchangeRate(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Currency string `json:"currency"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.adminService.FetchExchangeRate(ctx, req.Currency, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// GetPayslipTemplates lists the uploaded versions of the payslip template of a legal entity,
+// no legal_entity lists the templates of the company
+func (v1 *v1Controller) GetPayslipTemplates(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	templates, err := v1.payslipService.GetTemplates(ctx, c.Query("legal_entity"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, templates, nil)
+}
+
+// UploadPayslipTemplate stores a new version of a payslip template sent as the multipart file "template",
+// it has to be activated before payslips use it
+func (v1 *v1Controller) UploadPayslipTemplate(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("template")
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input template"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input template"))
+		return
+	}
+	defer file.Close()
+
+	// one byte more than allowed is read so an oversized template is refused by the service
+	content, err := io.ReadAll(io.LimitReader(file, payslipsvc.MaxTemplateSize+1))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input template"))
+		return
+	}
+
+	result, err := v1.payslipService.UploadTemplate(ctx, c.PostForm("legal_entity"), string(content), userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// ActivatePayslipTemplate makes a template version the one payslips of its legal entity are rendered with
+func (v1 *v1Controller) ActivatePayslipTemplate(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		TemplateID int `json:"template_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.payslipService.ActivateTemplate(ctx, req.TemplateID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// PreviewPayslipTemplate renders a stored template or the given content with a payslip, or a sample payslip
+// when no payslip_id is given, and sends the HTML or PDF back
+func (v1 *v1Controller) PreviewPayslipTemplate(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	var req struct {
+		TemplateID int    `json:"template_id"`
+		Content    string `json:"content"`
+		PayslipID  int    `json:"payslip_id"`
+		Format     string `json:"format"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	fileName, content, err := v1.payslipService.PreviewTemplate(ctx, req.TemplateID, req.Content, req.PayslipID, req.Format)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	contentType := "application/pdf"
+	if req.Format == payslipsvc.PreviewFormatHTML {
+		contentType = "text/html; charset=utf-8"
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": fileName}))
+	c.Data(http.StatusOK, contentType, content)
+}
+
+// GetPayslipDeliveries lists the payslip emails and whether they were sent, optionally of a period and a status
+func (v1 *v1Controller) GetPayslipDeliveries(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID := 0
+	if periodIDStr := c.Query("period_id"); periodIDStr != "" {
+		var err error
+		periodID, err = strconv.Atoi(periodIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+			return
+		}
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	deliveries, err := v1.payslipService.GetDeliveries(ctx, periodID, c.Query("status"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, deliveries, nil)
+}
+
+// ResendPayslip queues the email of a payslip again, e.g. after it failed or the employee's address changed
+func (v1 *v1Controller) ResendPayslip(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		PayslipID int `json:"payslip_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.payslipService.ResendPayslip(ctx, req.PayslipID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// PreparePayments records the bank transfers that pay the payslips of a period, executed on execution_date
+// (YYYY-MM-DD) or today
+func (v1 *v1Controller) PreparePayments(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	var req struct {
+		PeriodID      int    `json:"period_id"`
+		ExecutionDate string `json:"execution_date"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	executionDate := time.Now()
+	if req.ExecutionDate != "" {
+		var err error
+		executionDate, err = time.Parse("2006-01-02", req.ExecutionDate)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input execution_date"))
+			return
+		}
+	}
+
+	payments, err := v1.payslipService.PreparePayments(ctx, req.PeriodID, executionDate, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, payments, nil)
+}
+
+// DownloadDisbursementFile sends the pending payments of a period as a csv, bca, mandiri or pain001 (ISO 20022) file
+func (v1 *v1Controller) DownloadDisbursementFile(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	periodID, err := strconv.Atoi(c.Param("period_id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+		return
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	format := c.DefaultQuery("format", disbursement.FormatCSV)
+	fileName, content, err := v1.payslipService.GetDisbursementFile(ctx, periodID, format)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	contentType := "text/plain; charset=utf-8"
+	switch format {
+	case disbursement.FormatCSV:
+		contentType = "text/csv"
+	case disbursement.FormatPain001:
+		contentType = "application/xml"
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	c.Data(http.StatusOK, contentType, content)
+}
+
+// GetBankAccounts lists bank accounts, of one employee with user_id and of one status with status,
+// e.g. pending for the accounts waiting for verification
+func (v1 *v1Controller) GetBankAccounts(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	employeeID := 0
+	if userIDStr := c.Query("user_id"); userIDStr != "" {
+		var err error
+		employeeID, err = strconv.Atoi(userIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid user_id"))
+			return
+		}
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	accounts, err := v1.adminService.GetBankAccounts(ctx, employeeID, c.Query("status"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, accounts, nil)
+}
+
+// AddBankAccount enters a bank account for an employee, another admin has to verify it
+func (v1 *v1Controller) AddBankAccount(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		UserID        int    `json:"user_id"`
+		BankCode      string `json:"bank_code"`
+		AccountNumber string `json:"account_number"`
+		AccountHolder string `json:"account_holder"`
+		IsPrimary     bool   `json:"is_primary"`
+		EffectiveDate string `json:"effective_date"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input effective_date"))
+		return
+	}
+	account := bankaccount.BankAccount{
+		UserID:        req.UserID,
+		BankCode:      req.BankCode,
+		AccountNumber: req.AccountNumber,
+		AccountHolder: req.AccountHolder,
+		IsPrimary:     req.IsPrimary,
+		EffectiveDate: effectiveDate,
+	}
+	result, err := v1.adminService.AddBankAccount(ctx, account, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// ReviewBankAccount verifies or rejects a pending bank account
+func (v1 *v1Controller) ReviewBankAccount(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		BankAccountID int    `json:"bank_account_id"`
+		Status        string `json:"status"`
+		Note          string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.adminService.ReviewBankAccount(ctx, req.BankAccountID, req.Status, req.Note, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// UpdateEmployeeEmail sets the address the payslips of an employee are emailed to
+func (v1 *v1Controller) UpdateEmployeeEmail(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		UserID int    `json:"user_id"`
+		Email  string `json:"email"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.adminService.UpdateEmployeeEmail(ctx, req.UserID, req.Email, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// UpdateEmployeeManager sets the manager who reviews the overtime of an employee, a null manager_id removes it
+func (v1 *v1Controller) UpdateEmployeeManager(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		UserID    int  `json:"user_id"`
+		ManagerID *int `json:"manager_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.adminService.UpdateEmployeeManager(ctx, req.UserID, req.ManagerID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// GetPayments lists the payments of the disbursement files, of one period with period_id and of one status with status
+func (v1 *v1Controller) GetPayments(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID := 0
+	if periodIDStr := c.Query("period_id"); periodIDStr != "" {
+		var err error
+		periodID, err = strconv.Atoi(periodIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+			return
+		}
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	payments, err := v1.payslipService.GetPayments(ctx, periodID, c.Query("status"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, payments, nil)
+}
+
+// MarkPaymentsSent records that the disbursement file of a period was sent to the bank
+func (v1 *v1Controller) MarkPaymentsSent(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	var req struct {
+		PeriodID int `json:"period_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	payments, err := v1.payslipService.MarkPaymentsSent(ctx, req.PeriodID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, payments, nil)
+}
+
+// UpdatePaymentStatus settles a payment by hand as paid, failed or returned, the last two with a note
+func (v1 *v1Controller) UpdatePaymentStatus(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		PaymentID int    `json:"payment_id"`
+		Status    string `json:"status"`
+		Note      string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.payslipService.UpdatePaymentStatus(ctx, req.PaymentID, req.Status, req.Note, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// ImportBankStatement settles the sent payments a bank statement shows, format is csv or mt940
+func (v1 *v1Controller) ImportBankStatement(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*60)
+	defer cancelCtx()
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input file"))
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input file"))
+		return
+	}
+	defer file.Close()
+
+	format := c.DefaultPostForm("format", bankstatement.FormatCSV)
+	report, err := v1.payslipService.ImportBankStatement(ctx, fileHeader.Filename, format, file, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, report, nil)
+}
+
+// GetStatementLines lists the lines of imported bank statements, of one statement with statement_id and of one
+// status with status, e.g. unmatched for the lines waiting to be resolved
+func (v1 *v1Controller) GetStatementLines(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	statementID := 0
+	if statementIDStr := c.Query("statement_id"); statementIDStr != "" {
+		var err error
+		statementID, err = strconv.Atoi(statementIDStr)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid statement_id"))
+			return
+		}
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	lines, err := v1.payslipService.GetStatementLines(ctx, statementID, c.Query("status"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, lines, nil)
+}
+
+// ResolveStatementLine matches an unmatched statement line to a payment, or dismisses it with payment_id 0 and a note
+func (v1 *v1Controller) ResolveStatementLine(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		LineID    int    `json:"line_id"`
+		PaymentID int    `json:"payment_id"`
+		Note      string `json:"note"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	result, err := v1.payslipService.ResolveStatementLine(ctx, req.LineID, req.PaymentID, req.Note, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// GetJournalAccounts lists the chart of accounts mapping the payroll journal is posted with
+func (v1 *v1Controller) GetJournalAccounts(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	accounts, err := v1.payslipService.GetJournalAccounts(ctx)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusInternalServerError, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, accounts, nil)
+}
+
+// SetJournalAccount maps a journal component of a cost center to an account, no cost_center sets the default account
+func (v1 *v1Controller) SetJournalAccount(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		Component   string `json:"component"`
+		CostCenter  string `json:"cost_center"`
+		AccountCode string `json:"account_code"`
+		AccountName string `json:"account_name"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	account := journalmodel.Account{
+		Component:  req.Component,
+		CostCenter: req.CostCenter,
+		Code:       req.AccountCode,
+		Name:       req.AccountName,
+	}
+	result, err := v1.payslipService.SetJournalAccount(ctx, account, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// DownloadJournal sends the payroll of a period as a balanced journal entry for the accounting system, as a csv
+// or json file
+func (v1 *v1Controller) DownloadJournal(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	periodID, err := strconv.Atoi(c.Param("period_id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid period_id"))
+		return
+	}
+
+	isAdmin := c.GetBool("is_admin")
+	if !isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only admin can perform this action"))
+		return
+	}
+
+	format := c.DefaultQuery("format", journal.FormatCSV)
+	fileName, content, err := v1.payslipService.GetJournalFile(ctx, periodID, format)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	contentType := "text/csv"
+	if format == journal.FormatJSON {
+		contentType = "application/json"
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	c.Data(http.StatusOK, contentType, content)
+}
@@ -7,6 +7,7 @@ import (
 	adminsvc "payslip-generation-system/internal/services/admin"
 	authsvc "payslip-generation-system/internal/services/auth"
 	empsvc "payslip-generation-system/internal/services/employee"
+	payslipsvc "payslip-generation-system/internal/services/payslip"
 	pingsvc "payslip-generation-system/internal/services/ping"
 )
 
@@ -15,11 +16,73 @@ type V1Controller interface {
 	Login(c *gin.Context)
 	AddAttendancePeriod(c *gin.Context)
 	SubmitAttendance(c *gin.Context)
+	ClockIn(c *gin.Context)
+	ClockOut(c *gin.Context)
+	RequestAttendanceCorrection(c *gin.Context)
 	SubmitOvertime(c *gin.Context)
 	SubmitReimbursement(c *gin.Context)
+	GetMyAttendances(c *gin.Context)
+	GetMyOvertimes(c *gin.Context)
+	GetMyReimbursements(c *gin.Context)
+	AmendAttendance(c *gin.Context)
+	WithdrawAttendance(c *gin.Context)
+	AmendOvertime(c *gin.Context)
+	WithdrawOvertime(c *gin.Context)
+	AmendReimbursement(c *gin.Context)
+	WithdrawReimbursement(c *gin.Context)
+	SubmitBankAccount(c *gin.Context)
+	GetMyBankAccounts(c *gin.Context)
+	SetSalaryAllocation(c *gin.Context)
+	RemoveSalaryAllocation(c *gin.Context)
+	GetSalaryAllocations(c *gin.Context)
 	RunPayroll(c *gin.Context)
 	GetPayslipSummary(c *gin.Context)
 	GeneratePayslips(c *gin.Context)
+	DownloadPayslipPDF(c *gin.Context)
+	DownloadPeriodPayslips(c *gin.Context)
+	AddShift(c *gin.Context)
+	AssignSchedule(c *gin.Context)
+	ImportAttendance(c *gin.Context)
+	GetAttendanceCorrections(c *gin.Context)
+	ReviewAttendanceCorrection(c *gin.Context)
+	GetOvertimes(c *gin.Context)
+	ReviewOvertime(c *gin.Context)
+	BulkApproveOvertimes(c *gin.Context)
+	AddPublicHoliday(c *gin.Context)
+	SubmitOvertimeOverride(c *gin.Context)
+	GetReimbursementCategories(c *gin.Context)
+	UpdateReimbursementCategory(c *gin.Context)
+	DownloadReimbursementAttachment(c *gin.Context)
+	GetReimbursements(c *gin.Context)
+	ReviewReimbursement(c *gin.Context)
+	GetReimbursementDuplicates(c *gin.Context)
+	GetReimbursementRates(c *gin.Context)
+	AddReimbursementRate(c *gin.Context)
+	GetExchangeRates(c *gin.Context)
+	AddExchangeRate(c *gin.Context)
+	FetchExchangeRate(c *gin.Context)
+	GetPayslipTemplates(c *gin.Context)
+	UploadPayslipTemplate(c *gin.Context)
+	ActivatePayslipTemplate(c *gin.Context)
+	PreviewPayslipTemplate(c *gin.Context)
+	GetPayslipDeliveries(c *gin.Context)
+	ResendPayslip(c *gin.Context)
+	PreparePayments(c *gin.Context)
+	DownloadDisbursementFile(c *gin.Context)
+	GetBankAccounts(c *gin.Context)
+	AddBankAccount(c *gin.Context)
+	ReviewBankAccount(c *gin.Context)
+	UpdateEmployeeEmail(c *gin.Context)
+	UpdateEmployeeManager(c *gin.Context)
+	GetPayments(c *gin.Context)
+	MarkPaymentsSent(c *gin.Context)
+	UpdatePaymentStatus(c *gin.Context)
+	ImportBankStatement(c *gin.Context)
+	GetStatementLines(c *gin.Context)
+	ResolveStatementLine(c *gin.Context)
+	GetJournalAccounts(c *gin.Context)
+	SetJournalAccount(c *gin.Context)
+	DownloadJournal(c *gin.Context)
 }
 
 type v1Controller struct {
@@ -27,6 +90,7 @@ type v1Controller struct {
 	authService authsvc.AuthServiceProvider
 	adminService adminsvc.AdminServiceProvider
 	employeeService empsvc.EmployeeServiceProvider
+	payslipService payslipsvc.PayslipServiceProvider
 }
 
 func NewV1Controller(
@@ -34,11 +98,13 @@ func NewV1Controller(
 	authService authsvc.AuthServiceProvider,
 	adminService adminsvc.AdminServiceProvider,
 	employeeService empsvc.EmployeeServiceProvider,
+	payslipService payslipsvc.PayslipServiceProvider,
 ) V1Controller {
 	return &v1Controller{
 		pingService:                   pingService,
 		authService: authService,
 		adminService: adminService,
 		employeeService: employeeService,
+		payslipService: payslipService,
 	}
 }
\ No newline at end of file
@@ -3,11 +3,16 @@ package v1
 import (
 	"context"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	serverctrl "payslip-generation-system/internal/controller/http"
 	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/bankaccount"
 	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/entity/reimbursement"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -18,8 +23,9 @@ func (v1 *v1Controller) SubmitAttendance(c *gin.Context) {
 	defer cancelCtx()
 
 	var req struct {
-		Date     string `json:"date"`
-		PeriodID int    `json:"period_id"`
+		Date       string `json:"date"`
+		PeriodID   int    `json:"period_id"`
+		IsExtraDay bool   `json:"is_extra_day"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -40,18 +46,140 @@ func (v1 *v1Controller) SubmitAttendance(c *gin.Context) {
 		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input date"))
 		return
 	}
+	attendance := attendance.Attendance{
+		UserID:     userID,
+		PeriodID:   req.PeriodID,
+		Date:       date,
+		IsExtraDay: req.IsExtraDay,
+	}
+	_, err = v1.employeeService.SubmitAttendance(ctx, attendance, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, "attendance submitted", nil)
+}
+
+func (v1 *v1Controller) ClockIn(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		PeriodID   int  `json:"period_id"`
+		IsExtraDay bool `json:"is_extra_day"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	clockIn := time.Now()
+	attendance := attendance.Attendance{
+		UserID:     userID,
+		PeriodID:   req.PeriodID,
+		ClockIn:    &clockIn,
+		IsExtraDay: req.IsExtraDay,
+	}
+	result, err := v1.employeeService.ClockIn(ctx, attendance, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) ClockOut(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		PeriodID int `json:"period_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	clockOut := time.Now()
 	attendance := attendance.Attendance{
+		UserID:   userID,
+		PeriodID: req.PeriodID,
+		ClockOut: &clockOut,
+	}
+	result, err := v1.employeeService.ClockOut(ctx, attendance, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) RequestAttendanceCorrection(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		PeriodID int    `json:"period_id"`
+		Date     string `json:"date"`
+		Action   string `json:"action"`
+		Reason   string `json:"reason"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input date"))
+		return
+	}
+	correction := attendance.AttendanceCorrection{
 		UserID:   userID,
 		PeriodID: req.PeriodID,
 		Date:     date,
+		Action:   req.Action,
+		Reason:   req.Reason,
 	}
-	_, err = v1.employeeService.SubmitAttendance(ctx, attendance, requestID)
+	id, err := v1.employeeService.RequestAttendanceCorrection(ctx, correction, requestID)
 	if err != nil {
 		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
 		return
 	}
 
-	serverctrl.ResponseHandler(c, http.StatusOK, "attendance submitted", nil)
+	correction.ID = id
+	correction.Status = attendance.CorrectionStatusPending
+	serverctrl.ResponseHandler(c, http.StatusOK, correction, nil)
 }
 
 func (v1 *v1Controller) SubmitOvertime(c *gin.Context) {
@@ -59,9 +187,10 @@ func (v1 *v1Controller) SubmitOvertime(c *gin.Context) {
 	defer cancelCtx()
 
 	var req struct {
-		Date     string `json:"date"`
 		PeriodID int    `json:"period_id"`
-		Hours int    `json:"hours"`
+		StartAt  string `json:"start_at"`
+		EndAt    string `json:"end_at"`
+		Type string `json:"type"`
 		WorkCompleted bool    `json:"work_completed"`
 	}
 
@@ -78,9 +207,14 @@ func (v1 *v1Controller) SubmitOvertime(c *gin.Context) {
 		return
 	}
 
-	date, err := time.Parse("2006-01-02", req.Date)
+	startAt, err := time.Parse(time.RFC3339, req.StartAt)
 	if err != nil {
-		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input date"))
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("start_at must be in RFC3339 format"))
+		return
+	}
+	endAt, err := time.Parse(time.RFC3339, req.EndAt)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("end_at must be in RFC3339 format"))
 		return
 	}
 
@@ -92,8 +226,9 @@ func (v1 *v1Controller) SubmitOvertime(c *gin.Context) {
 	overtime := overtime.Overtime{
 		UserID:   userID,
 		PeriodID: req.PeriodID,
-		Date:     date,
-		Hours:    req.Hours,
+		StartAt:  &startAt,
+		EndAt:    &endAt,
+		Type:     req.Type,
 	}
 	_, err = v1.employeeService.SubmitOvertime(ctx, overtime, requestID)
 	if err != nil {
@@ -104,14 +239,231 @@ func (v1 *v1Controller) SubmitOvertime(c *gin.Context) {
 	serverctrl.ResponseHandler(c, http.StatusOK, "overtime submitted", nil)
 }
 
+// SubmitReimbursement takes a multipart form with period_id, category, amount, currency (IDR when empty),
+// description and one or more receipt files under "receipts"
 func (v1 *v1Controller) SubmitReimbursement(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	periodID, err := strconv.Atoi(c.PostForm("period_id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input period_id"))
+		return
+	}
+
+	// mileage and per diem claims send their inputs instead of an amount, the amount is computed from the rate table
+	var amount float64
+	var calculation *reimbursement.Calculation
+	distanceKm, travelDays := c.PostForm("distance_km"), c.PostForm("travel_days")
+	if distanceKm != "" || travelDays != "" {
+		calculation = &reimbursement.Calculation{Destination: c.PostForm("destination")}
+		if distanceKm != "" {
+			calculation.DistanceKm, err = strconv.ParseFloat(distanceKm, 64)
+			if err != nil {
+				serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input distance_km"))
+				return
+			}
+		}
+		if travelDays != "" {
+			calculation.TravelDays, err = strconv.Atoi(travelDays)
+			if err != nil {
+				serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input travel_days"))
+				return
+			}
+		}
+	} else {
+		amount, err = strconv.ParseFloat(c.PostForm("amount"), 64)
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input amount"))
+			return
+		}
+		if amount <= 0 {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("amount must be greater than 0"))
+			return
+		}
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input receipts"))
+		return
+	}
+	receipts := []reimbursement.Receipt{}
+	files := []multipart.File{}
+	defer func() {
+		for _, file := range files {
+			file.Close()
+		}
+	}()
+	for _, fileHeader := range form.File["receipts"] {
+		file, err := fileHeader.Open()
+		if err != nil {
+			serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input receipts"))
+			return
+		}
+		files = append(files, file)
+		receipts = append(receipts, reimbursement.Receipt{FileName: fileHeader.Filename, Content: file})
+	}
+
+	reimbursement := reimbursement.Reimbursement{
+		UserID:         userID,
+		PeriodID:       periodID,
+		Category:       c.PostForm("category"),
+		Currency:       c.PostForm("currency"),
+		OriginalAmount: amount,
+		Description:    c.PostForm("description"),
+		Calculation:    calculation,
+	}
+	result, err := v1.employeeService.SubmitReimbursement(ctx, reimbursement, receipts, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	// a partially approved claim comes back with the amount that will be paid
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// AmendAttendance moves a date-only attendance to another date or changes its extra day flag
+func (v1 *v1Controller) AmendAttendance(c *gin.Context) {
 	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
 	defer cancelCtx()
 
 	var req struct {
-		PeriodID int    `json:"period_id"`
-		Amount int    `json:"amount"`
-		Description string    `json:"description"`
+		AttendanceID int    `json:"attendance_id"`
+		Date         string `json:"date"`
+		IsExtraDay   bool   `json:"is_extra_day"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input date"))
+		return
+	}
+	attendance := attendance.Attendance{
+		ID:         req.AttendanceID,
+		UserID:     userID,
+		Date:       date,
+		IsExtraDay: req.IsExtraDay,
+	}
+	result, err := v1.employeeService.AmendAttendance(ctx, attendance, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) WithdrawAttendance(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		AttendanceID int `json:"attendance_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	err := v1.employeeService.WithdrawAttendance(ctx, req.AttendanceID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, "attendance withdrawn", nil)
+}
+
+// AmendOvertime changes the times or type of overtime that hasn't been reviewed yet
+func (v1 *v1Controller) AmendOvertime(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		OvertimeID int    `json:"overtime_id"`
+		StartAt    string `json:"start_at"`
+		EndAt      string `json:"end_at"`
+		Type       string `json:"type"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	startAt, err := time.Parse(time.RFC3339, req.StartAt)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("start_at must be in RFC3339 format"))
+		return
+	}
+	endAt, err := time.Parse(time.RFC3339, req.EndAt)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("end_at must be in RFC3339 format"))
+		return
+	}
+
+	overtime := overtime.Overtime{
+		ID:      req.OvertimeID,
+		UserID:  userID,
+		StartAt: &startAt,
+		EndAt:   &endAt,
+		Type:    req.Type,
+	}
+	result, err := v1.employeeService.AmendOvertime(ctx, overtime, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) WithdrawOvertime(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		OvertimeID int `json:"overtime_id"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -127,24 +479,126 @@ func (v1 *v1Controller) SubmitReimbursement(c *gin.Context) {
 		return
 	}
 
-	if req.Amount <= 0 {
-		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("amount must be greater than 0"))
+	err := v1.employeeService.WithdrawOvertime(ctx, req.OvertimeID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, "overtime withdrawn", nil)
+}
+
+// AmendReimbursement changes a claim waiting for review, mileage and per diem claims send
+// distance_km or travel_days and destination instead of an amount
+func (v1 *v1Controller) AmendReimbursement(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		ReimbursementID int      `json:"reimbursement_id"`
+		Amount          float64  `json:"amount"`
+		Currency        string   `json:"currency"`
+		Description     string   `json:"description"`
+		DistanceKm      *float64 `json:"distance_km"`
+		TravelDays      *int     `json:"travel_days"`
+		Destination     string   `json:"destination"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
 		return
 	}
 
+	var calculation *reimbursement.Calculation
+	if req.DistanceKm != nil || req.TravelDays != nil {
+		calculation = &reimbursement.Calculation{Destination: req.Destination}
+		if req.DistanceKm != nil {
+			calculation.DistanceKm = *req.DistanceKm
+		}
+		if req.TravelDays != nil {
+			calculation.TravelDays = *req.TravelDays
+		}
+	}
+
 	reimbursement := reimbursement.Reimbursement{
-		UserID:   userID,
-		PeriodID: req.PeriodID,
-		Amount:   req.Amount,
-		Description: req.Description,
+		ID:             req.ReimbursementID,
+		UserID:         userID,
+		Currency:       req.Currency,
+		OriginalAmount: req.Amount,
+		Description:    req.Description,
+		Calculation:    calculation,
 	}
-	_, err := v1.employeeService.SubmitReimbursement(ctx, reimbursement, requestID)
+	result, err := v1.employeeService.AmendReimbursement(ctx, reimbursement, requestID)
 	if err != nil {
 		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
 		return
 	}
 
-	serverctrl.ResponseHandler(c, http.StatusOK, "reimbursement submitted", nil)
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// WithdrawReimbursement removes a claim waiting for review together with its receipts
+func (v1 *v1Controller) WithdrawReimbursement(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		ReimbursementID int `json:"reimbursement_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	err := v1.employeeService.WithdrawReimbursement(ctx, req.ReimbursementID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, "reimbursement withdrawn", nil)
+}
+
+// DownloadReimbursementAttachment streams a receipt, employees only get receipts of their own claims
+func (v1 *v1Controller) DownloadReimbursementAttachment(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	attachmentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid id"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	attachment, content, err := v1.employeeService.GetReimbursementAttachment(ctx, attachmentID, userID, isAdmin)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusNotFound, nil, err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.SizeBytes, attachment.ContentType, content, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+	})
 }
 
 func (v1 *v1Controller) GeneratePayslips(c *gin.Context) {
@@ -165,4 +619,287 @@ func (v1 *v1Controller) GeneratePayslips(c *gin.Context) {
 	}
 
 	serverctrl.ResponseHandler(c, http.StatusOK, payslips, nil)
-}
\ No newline at end of file
+}
+
+// DownloadPayslipPDF sends a payslip as a PDF, employees only get their own payslips
+func (v1 *v1Controller) DownloadPayslipPDF(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*30)
+	defer cancelCtx()
+
+	payslipID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid id"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	fileName, content, err := v1.payslipService.GetPayslipPDF(ctx, payslipID, userID, isAdmin)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusNotFound, nil, err)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	c.Data(http.StatusOK, "application/pdf", content)
+}
+
+// GetMyAttendances lists the attendances of the logged in employee, optionally of one period, 20 per page by default
+func (v1 *v1Controller) GetMyAttendances(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID, page, err := listParams(c)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	items, total, err := v1.employeeService.GetAttendances(ctx, userID, periodID, page)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, pagination.NewPage(items, total, page), nil)
+}
+
+// GetMyOvertimes lists the overtime of the logged in employee, optionally of one period, 20 per page by default
+func (v1 *v1Controller) GetMyOvertimes(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID, page, err := listParams(c)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	items, total, err := v1.employeeService.GetOvertimes(ctx, userID, periodID, page)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, pagination.NewPage(items, total, page), nil)
+}
+
+// GetMyReimbursements lists the reimbursement claims of the logged in employee, optionally of one period, 20 per page by default
+func (v1 *v1Controller) GetMyReimbursements(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	periodID, page, err := listParams(c)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	items, total, err := v1.employeeService.GetReimbursements(ctx, userID, periodID, page)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, pagination.NewPage(items, total, page), nil)
+}
+
+// listParams reads the optional period_id, page and page_size query parameters of a list endpoint
+func listParams(c *gin.Context) (int, pagination.Request, error) {
+	values := make([]int, 3)
+	for i, name := range []string{"period_id", "page", "page_size"} {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number < 0 {
+			return 0, pagination.Request{}, fmt.Errorf("invalid %s", name)
+		}
+		values[i] = number
+	}
+	return values[0], pagination.NewRequest(values[1], values[2]), nil
+}
+
+// SubmitBankAccount asks for salary to be paid to a bank account from effective_date, an admin verifies it first
+func (v1 *v1Controller) SubmitBankAccount(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		BankCode      string `json:"bank_code"`
+		AccountNumber string `json:"account_number"`
+		AccountHolder string `json:"account_holder"`
+		IsPrimary     bool   `json:"is_primary"`
+		EffectiveDate string `json:"effective_date"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	effectiveDate, err := time.Parse("2006-01-02", req.EffectiveDate)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input effective_date"))
+		return
+	}
+	account := bankaccount.BankAccount{
+		UserID:        userID,
+		BankCode:      req.BankCode,
+		AccountNumber: req.AccountNumber,
+		AccountHolder: req.AccountHolder,
+		IsPrimary:     req.IsPrimary,
+		EffectiveDate: effectiveDate,
+	}
+	result, err := v1.employeeService.SubmitBankAccount(ctx, account, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+// GetMyBankAccounts lists the employee's own bank accounts and whether they are verified
+func (v1 *v1Controller) GetMyBankAccounts(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	accounts, err := v1.employeeService.GetBankAccounts(ctx, userID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, accounts, nil)
+}
+
+// SetSalaryAllocation sends a fixed amount or a percentage of the take home pay to one of the employee's
+// verified accounts other than the primary
+func (v1 *v1Controller) SetSalaryAllocation(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		BankAccountID int    `json:"bank_account_id"`
+		Method        string `json:"method"`
+		Amount        int    `json:"amount"`
+		Percentage    int    `json:"percentage"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	allocation := bankaccount.Allocation{
+		UserID:        userID,
+		BankAccountID: req.BankAccountID,
+		Method:        req.Method,
+		Amount:        req.Amount,
+		Percentage:    req.Percentage,
+	}
+	result, err := v1.employeeService.SetSalaryAllocation(ctx, allocation, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, result, nil)
+}
+
+func (v1 *v1Controller) RemoveSalaryAllocation(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	var req struct {
+		AllocationID int `json:"allocation_id"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, fmt.Errorf("invalid input"))
+		return
+	}
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	requestID := c.GetInt("request_log_id")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	err := v1.employeeService.RemoveSalaryAllocation(ctx, req.AllocationID, userID, requestID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, "salary allocation removed", nil)
+}
+
+// GetSalaryAllocations lists how the employee's take home pay is split over their accounts
+func (v1 *v1Controller) GetSalaryAllocations(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), time.Second*10)
+	defer cancelCtx()
+
+	userID := c.GetInt("user_id")
+	isAdmin := c.GetBool("is_admin")
+	if isAdmin {
+		serverctrl.ResponseHandler(c, http.StatusForbidden, nil, fmt.Errorf("only employee can perform this action"))
+		return
+	}
+
+	allocations, err := v1.employeeService.GetSalaryAllocations(ctx, userID)
+	if err != nil {
+		serverctrl.ResponseHandler(c, http.StatusBadRequest, nil, err)
+		return
+	}
+
+	serverctrl.ResponseHandler(c, http.StatusOK, allocations, nil)
+}
@@ -0,0 +1,74 @@
+package disbursement
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// encodeBCA writes the fixed width payroll upload of BCA, lines end with CRLF:
+//
+//	header  1 "0", 10 company code, 10 debit account, 8 date DDMMYYYY, 5 transfers, 17 control total, 20 batch reference
+//	detail  1 "1", 10 account, 17 amount, 10 employee number, 30 account holder, 18 description
+//
+// Amounts have two implied decimals and numbers are zero padded, text is upper case and space padded.
+// The payroll upload only pays BCA accounts
+func encodeBCA(b Batch) ([]byte, error) {
+	if b.Debtor.BankCode != BankCodeBCA || len(b.Debtor.Number) != 10 {
+		return nil, fmt.Errorf("the BCA file needs a 10 digit BCA account to pay from")
+	}
+	if b.CompanyCode == "" {
+		return nil, fmt.Errorf("the BCA file needs the company code of the payroll contract")
+	}
+	for _, instruction := range b.Instructions {
+		if instruction.Account.BankCode != BankCodeBCA || len(instruction.Account.Number) != 10 {
+			return nil, fmt.Errorf("the BCA file only pays 10 digit BCA accounts, %s has another bank", instruction.EmployeeNumber)
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "0%-10s%s%s%05d%017d%-20s\r\n",
+		clean(b.CompanyCode, 10), b.Debtor.Number, b.ExecutionDate.Format("02012006"), b.Count(), b.ControlTotal()*100, clean(b.Reference, 20))
+	for _, instruction := range b.Instructions {
+		fmt.Fprintf(&buf, "1%s%017d%-10s%-30s%-18s\r\n",
+			instruction.Account.Number, instruction.Amount*100, clean(instruction.EmployeeNumber, 10),
+			clean(instruction.Account.Holder, 30), clean(instruction.Description, 18))
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeMandiri writes the comma separated bulk transfer upload of Mandiri, lines end with CRLF:
+//
+//	header  P, date YYYYMMDD, debit account, transfers, control total
+//	detail  account, account holder, currency, amount, description, reference, IBU or LBU, bank code
+//
+// IBU pays Mandiri accounts and LBU other banks through the clearing, commas are left out of text
+func encodeMandiri(b Batch) ([]byte, error) {
+	if b.Debtor.BankCode != BankCodeMandiri {
+		return nil, fmt.Errorf("the Mandiri file needs a Mandiri account to pay from")
+	}
+
+	var buf bytes.Buffer
+	line := func(fields ...string) {
+		buf.WriteString(strings.Join(fields, ","))
+		buf.WriteString("\r\n")
+	}
+	line("P", b.ExecutionDate.Format("20060102"), b.Debtor.Number, fmt.Sprint(b.Count()), fmt.Sprint(b.ControlTotal()))
+	for _, instruction := range b.Instructions {
+		transferType := "LBU"
+		if instruction.Account.BankCode == BankCodeMandiri {
+			transferType = "IBU"
+		}
+		line(
+			instruction.Account.Number,
+			clean(instruction.Account.Holder, 40),
+			Currency,
+			fmt.Sprint(instruction.Amount),
+			clean(instruction.Description, 40),
+			clean(instruction.Reference, 20),
+			transferType,
+			instruction.Account.BankCode,
+		)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,34 @@
+package disbursement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+)
+
+// encodeCSV writes a row per transfer and a TOTAL row with the control total and the number of transfers
+func encodeCSV(b Batch) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{{"reference", "employee_number", "bank_code", "account_number", "account_holder", "amount", "currency", "description"}}
+	for _, instruction := range b.Instructions {
+		rows = append(rows, []string{
+			instruction.Reference,
+			instruction.EmployeeNumber,
+			instruction.Account.BankCode,
+			instruction.Account.Number,
+			instruction.Account.Holder,
+			strconv.Itoa(instruction.Amount),
+			Currency,
+			instruction.Description,
+		})
+	}
+	rows = append(rows, []string{"TOTAL", "", "", "", "", strconv.Itoa(b.ControlTotal()), Currency, fmt.Sprintf("%d transfers", b.Count())})
+
+	err := w.WriteAll(rows)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
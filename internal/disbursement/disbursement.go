@@ -0,0 +1,159 @@
+package disbursement
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatCSV is a plain CSV for any bank portal, FormatBCA and FormatMandiri the bulk transfer uploads
+	// of those banks and FormatPain001 an ISO 20022 pain.001 credit transfer initiation
+	FormatCSV     = "csv"
+	FormatBCA     = "bca"
+	FormatMandiri = "mandiri"
+	FormatPain001 = "pain001"
+
+	// Bank Indonesia codes of the banks with their own file format
+	BankCodeBCA     = "014"
+	BankCodeMandiri = "008"
+
+	// Currency is what every transfer is paid in, rupiah have no minor unit
+	Currency = "IDR"
+)
+
+// Account is a bank account salaries are paid from or to
+type Account struct {
+	BankCode string
+	Number   string
+	Holder   string
+}
+
+// Originator is the company account salaries are paid from, with the company code of the bank's payroll contract
+type Originator struct {
+	CompanyCode string
+	Account     Account
+}
+
+// Instruction is one transfer of a batch
+type Instruction struct {
+	// Reference identifies the transfer end to end, e.g. the payslip it pays
+	Reference      string
+	EmployeeNumber string
+	Account        Account
+	Amount         int
+	Description    string
+}
+
+// Batch is the transfers that pay the payslips of a period from the company account
+type Batch struct {
+	Reference     string
+	CompanyCode   string
+	Debtor        Account
+	ExecutionDate time.Time
+	CreatedAt     time.Time
+	Instructions  []Instruction
+}
+
+// ControlTotal is the sum of the transfers, banks check it against the transfers in the file
+func (b Batch) ControlTotal() int {
+	total := 0
+	for _, instruction := range b.Instructions {
+		total += instruction.Amount
+	}
+	return total
+}
+
+// Count is the number of transfers in the batch
+func (b Batch) Count() int {
+	return len(b.Instructions)
+}
+
+// Validate checks that every transfer can be made, so no file is written that the bank would refuse
+func (b Batch) Validate() error {
+	if b.Debtor.Number == "" || b.Debtor.BankCode == "" {
+		return fmt.Errorf("the company bank account to pay from is not configured")
+	}
+	if len(b.Instructions) == 0 {
+		return fmt.Errorf("there is nothing to pay")
+	}
+	for _, instruction := range b.Instructions {
+		if instruction.Account.Number == "" || instruction.Account.BankCode == "" {
+			return fmt.Errorf("%s has no bank account", instruction.EmployeeNumber)
+		}
+		if !isDigits(instruction.Account.Number) {
+			return fmt.Errorf("bank account of %s must only have digits", instruction.EmployeeNumber)
+		}
+		if instruction.Amount <= 0 {
+			return fmt.Errorf("transfer to %s must be more than 0", instruction.EmployeeNumber)
+		}
+	}
+	return nil
+}
+
+// IsValidFormat reports whether format is one of the file formats
+func IsValidFormat(format string) bool {
+	switch format {
+	case FormatCSV, FormatBCA, FormatMandiri, FormatPain001:
+		return true
+	}
+	return false
+}
+
+// Encode validates the batch and writes it in the given format
+func Encode(format string, b Batch) ([]byte, error) {
+	if !IsValidFormat(format) {
+		return nil, fmt.Errorf("unknown format %s, use %s, %s, %s or %s", format, FormatCSV, FormatBCA, FormatMandiri, FormatPain001)
+	}
+	err := b.Validate()
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatBCA:
+		return encodeBCA(b)
+	case FormatMandiri:
+		return encodeMandiri(b)
+	case FormatPain001:
+		return encodePain001(b)
+	default:
+		return encodeCSV(b)
+	}
+}
+
+// FileName is the name the file of a batch is downloaded as
+func FileName(format string, b Batch) string {
+	extension := "txt"
+	switch format {
+	case FormatCSV:
+		extension = "csv"
+	case FormatPain001:
+		extension = "xml"
+	}
+	return fmt.Sprintf("disbursement-%s-%s-%s.%s", format, strings.ToLower(b.Reference), b.ExecutionDate.Format("20060102"), extension)
+}
+
+// clean keeps the characters bank files accept, upper cased, and cuts the text to size
+func clean(text string, size int) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(text) {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == ' ', r == '-', r == '.', r == '/':
+			b.WriteRune(r)
+		}
+	}
+	cleaned := strings.Join(strings.Fields(b.String()), " ")
+	if len(cleaned) > size {
+		cleaned = cleaned[:size]
+	}
+	return cleaned
+}
+
+func isDigits(text string) bool {
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return text != ""
+}
@@ -0,0 +1,130 @@
+package disbursement
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testBatch(debtorBank string) Batch {
+	return Batch{
+		Reference:     "PAYROLL-3",
+		CompanyCode:   "PSLIP",
+		Debtor:        Account{BankCode: debtorBank, Number: "0123456789", Holder: "PT Payslip Indonesia"},
+		ExecutionDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		CreatedAt:     time.Date(2025, 6, 28, 10, 15, 0, 0, time.UTC),
+		Instructions: []Instruction{
+			{Reference: "PAYSLIP-11", EmployeeNumber: "EMP00002", Account: Account{BankCode: BankCodeBCA, Number: "1234567890", Holder: "Budi Santoso"}, Amount: 5250000, Description: "Salary June 2025"},
+			{Reference: "PAYSLIP-12", EmployeeNumber: "EMP00003", Account: Account{BankCode: BankCodeBCA, Number: "9876543210", Holder: "Siti Rahayu, S.E."}, Amount: 4100500, Description: "Salary June 2025"},
+		},
+	}
+}
+
+func TestBatch_Validate(t *testing.T) {
+	b := testBatch(BankCodeBCA)
+	assert.NoError(t, b.Validate())
+	assert.Equal(t, 9350500, b.ControlTotal())
+	assert.Equal(t, 2, b.Count())
+
+	b = testBatch(BankCodeBCA)
+	b.Debtor.Number = ""
+	assert.Error(t, b.Validate())
+
+	b = testBatch(BankCodeBCA)
+	b.Instructions[1].Account.Number = ""
+	assert.ErrorContains(t, b.Validate(), "EMP00003 has no bank account")
+
+	b = testBatch(BankCodeBCA)
+	b.Instructions[0].Account.Number = "1234-5678"
+	assert.Error(t, b.Validate())
+
+	b = testBatch(BankCodeBCA)
+	b.Instructions[0].Amount = 0
+	assert.Error(t, b.Validate())
+
+	_, err := Encode("xls", testBatch(BankCodeBCA))
+	assert.Error(t, err)
+}
+
+func TestEncode_CSV(t *testing.T) {
+	content, err := Encode(FormatCSV, testBatch(BankCodeBCA))
+	assert.NoError(t, err)
+
+	rows, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, rows, 4)
+	assert.Equal(t, []string{"PAYSLIP-12", "EMP00003", "014", "9876543210", "Siti Rahayu, S.E.", "4100500", "IDR", "Salary June 2025"}, rows[2])
+	assert.Equal(t, []string{"TOTAL", "", "", "", "", "9350500", "IDR", "2 transfers"}, rows[3])
+}
+
+func TestEncode_BCA(t *testing.T) {
+	content, err := Encode(FormatBCA, testBatch(BankCodeBCA))
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(string(content), "\r\n"), "\r\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, "0PSLIP     01234567893006202500002"+"00000000935050000"+"PAYROLL-3           ", lines[0])
+	assert.Equal(t, "19876543210"+"00000000410050000"+"EMP00003  "+"SITI RAHAYU S.E.              "+"SALARY JUNE 2025  ", lines[2])
+	for _, line := range lines[1:] {
+		assert.Len(t, line, 86)
+	}
+
+	b := testBatch(BankCodeBCA)
+	b.Instructions[1].Account.BankCode = BankCodeMandiri
+	_, err = Encode(FormatBCA, b)
+	assert.ErrorContains(t, err, "EMP00003")
+
+	_, err = Encode(FormatBCA, testBatch(BankCodeMandiri))
+	assert.Error(t, err)
+}
+
+func TestEncode_Mandiri(t *testing.T) {
+	b := testBatch(BankCodeMandiri)
+	b.Instructions[0].Account.BankCode = BankCodeMandiri
+	content, err := Encode(FormatMandiri, b)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "P,20250630,0123456789,2,9350500\r\n"+
+		"1234567890,BUDI SANTOSO,IDR,5250000,SALARY JUNE 2025,PAYSLIP-11,IBU,008\r\n"+
+		"9876543210,SITI RAHAYU S.E.,IDR,4100500,SALARY JUNE 2025,PAYSLIP-12,LBU,014\r\n", string(content))
+
+	_, err = Encode(FormatMandiri, testBatch(BankCodeBCA))
+	assert.Error(t, err)
+}
+
+func TestEncode_Pain001(t *testing.T) {
+	content, err := Encode(FormatPain001, testBatch(BankCodeBCA))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), xml.Header))
+
+	var document pain001Document
+	assert.NoError(t, xml.Unmarshal(content, &document))
+	assert.Equal(t, pain001Namespace, document.Namespace)
+	header := document.Initiation.GroupHeader
+	assert.Equal(t, "PAYROLL-3-20250628101500", header.MessageID)
+	assert.Equal(t, 2, header.NumberOfTxs)
+	assert.Equal(t, "9350500", header.ControlSum)
+
+	payment := document.Initiation.Payment
+	assert.Equal(t, "2025-06-30", payment.ExecutionDate)
+	assert.Equal(t, "SALA", payment.CategoryPurpose)
+	assert.Equal(t, "0123456789", payment.DebtorAccount.ID)
+	assert.Len(t, payment.Transfers, 2)
+	assert.Equal(t, "PAYSLIP-12", payment.Transfers[1].EndToEndID)
+	assert.Equal(t, pain001Amount{Currency: "IDR", Value: "4100500"}, payment.Transfers[1].Amount)
+	assert.Equal(t, "014", payment.Transfers[1].CreditorAgent.MemberID)
+	assert.Equal(t, "9876543210", payment.Transfers[1].CreditorAccount.ID)
+	assert.Contains(t, string(content), "<CtrlSum>9350500</CtrlSum>")
+	assert.Contains(t, string(content), `<InstdAmt Ccy="IDR">5250000</InstdAmt>`)
+}
+
+func TestFileName(t *testing.T) {
+	b := testBatch(BankCodeBCA)
+	assert.Equal(t, "disbursement-csv-payroll-3-20250630.csv", FileName(FormatCSV, b))
+	assert.Equal(t, "disbursement-bca-payroll-3-20250630.txt", FileName(FormatBCA, b))
+	assert.Equal(t, "disbursement-pain001-payroll-3-20250630.xml", FileName(FormatPain001, b))
+}
@@ -0,0 +1,118 @@
+package disbursement
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+const pain001Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+
+// the subset of pain.001.001.03 a salary batch needs, one payment information block with a credit transfer per instruction
+type pain001Document struct {
+	XMLName    xml.Name          `xml:"Document"`
+	Namespace  string            `xml:"xmlns,attr"`
+	Initiation pain001Initiation `xml:"CstmrCdtTrfInitn"`
+}
+
+type pain001Initiation struct {
+	GroupHeader pain001GroupHeader `xml:"GrpHdr"`
+	Payment     pain001Payment     `xml:"PmtInf"`
+}
+
+type pain001GroupHeader struct {
+	MessageID       string       `xml:"MsgId"`
+	CreatedAt       string       `xml:"CreDtTm"`
+	NumberOfTxs     int          `xml:"NbOfTxs"`
+	ControlSum      string       `xml:"CtrlSum"`
+	InitiatingParty pain001Party `xml:"InitgPty"`
+}
+
+type pain001Party struct {
+	Name string `xml:"Nm"`
+}
+
+type pain001Account struct {
+	ID       string `xml:"Id>Othr>Id"`
+	Currency string `xml:"Ccy,omitempty"`
+}
+
+type pain001Agent struct {
+	MemberID string `xml:"FinInstnId>ClrSysMmbId>MmbId"`
+}
+
+type pain001Payment struct {
+	PaymentInfoID   string                  `xml:"PmtInfId"`
+	Method          string                  `xml:"PmtMtd"`
+	BatchBooking    bool                    `xml:"BtchBookg"`
+	NumberOfTxs     int                     `xml:"NbOfTxs"`
+	ControlSum      string                  `xml:"CtrlSum"`
+	CategoryPurpose string                  `xml:"PmtTpInf>CtgyPurp>Cd"`
+	ExecutionDate   string                  `xml:"ReqdExctnDt"`
+	Debtor          pain001Party            `xml:"Dbtr"`
+	DebtorAccount   pain001Account          `xml:"DbtrAcct"`
+	DebtorAgent     pain001Agent            `xml:"DbtrAgt"`
+	ChargeBearer    string                  `xml:"ChrgBr"`
+	Transfers       []pain001CreditTransfer `xml:"CdtTrfTxInf"`
+}
+
+type pain001CreditTransfer struct {
+	EndToEndID      string         `xml:"PmtId>EndToEndId"`
+	Amount          pain001Amount  `xml:"Amt>InstdAmt"`
+	CreditorAgent   pain001Agent   `xml:"CdtrAgt"`
+	Creditor        pain001Party   `xml:"Cdtr"`
+	CreditorAccount pain001Account `xml:"CdtrAcct"`
+	Remittance      string         `xml:"RmtInf>Ustrd,omitempty"`
+}
+
+type pain001Amount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// encodePain001 writes the batch as an ISO 20022 customer credit transfer initiation (pain.001.001.03)
+// with the SALA category purpose, banks are identified by their Bank Indonesia code
+func encodePain001(b Batch) ([]byte, error) {
+	controlSum := fmt.Sprint(b.ControlTotal())
+	payment := pain001Payment{
+		PaymentInfoID:   b.Reference,
+		Method:          "TRF",
+		BatchBooking:    true,
+		NumberOfTxs:     b.Count(),
+		ControlSum:      controlSum,
+		CategoryPurpose: "SALA",
+		ExecutionDate:   b.ExecutionDate.Format("2006-01-02"),
+		Debtor:          pain001Party{Name: b.Debtor.Holder},
+		DebtorAccount:   pain001Account{ID: b.Debtor.Number, Currency: Currency},
+		DebtorAgent:     pain001Agent{MemberID: b.Debtor.BankCode},
+		ChargeBearer:    "SLEV",
+	}
+	for _, instruction := range b.Instructions {
+		payment.Transfers = append(payment.Transfers, pain001CreditTransfer{
+			EndToEndID:      instruction.Reference,
+			Amount:          pain001Amount{Currency: Currency, Value: fmt.Sprint(instruction.Amount)},
+			CreditorAgent:   pain001Agent{MemberID: instruction.Account.BankCode},
+			Creditor:        pain001Party{Name: instruction.Account.Holder},
+			CreditorAccount: pain001Account{ID: instruction.Account.Number},
+			Remittance:      instruction.Description,
+		})
+	}
+
+	document := pain001Document{
+		Namespace: pain001Namespace,
+		Initiation: pain001Initiation{
+			GroupHeader: pain001GroupHeader{
+				MessageID:       b.Reference + "-" + b.CreatedAt.Format("20060102150405"),
+				CreatedAt:       b.CreatedAt.Format("2006-01-02T15:04:05"),
+				NumberOfTxs:     b.Count(),
+				ControlSum:      controlSum,
+				InitiatingParty: pain001Party{Name: b.Debtor.Holder},
+			},
+			Payment: payment,
+		},
+	}
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(content, '\n')...), nil
+}
@@ -3,10 +3,15 @@ package attendance
 import "time"
 
 type Attendance struct {
-	ID        int       `json:"id"`
-	UserID    int       `json:"user_id"`
-	PeriodID  int       `json:"period_id"`
-	Date      time.Time `json:"date"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            int        `json:"id"`
+	UserID        int        `json:"user_id"`
+	PeriodID      int        `json:"period_id"`
+	Date          time.Time  `json:"date"`
+	ClockIn       *time.Time `json:"clock_in"`
+	ClockOut      *time.Time `json:"clock_out"`
+	WorkedMinutes int        `json:"worked_minutes"`
+	LateMinutes   int        `json:"late_minutes"`
+	IsExtraDay    bool       `json:"is_extra_day"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
 }
@@ -0,0 +1,29 @@
+package attendance
+
+import "time"
+
+const (
+	CorrectionActionAdd    = "add"
+	CorrectionActionRemove = "remove"
+
+	CorrectionStatusPending  = "pending"
+	CorrectionStatusApproved = "approved"
+	CorrectionStatusRejected = "rejected"
+)
+
+// AttendanceCorrection is an employee's request to add a missing attendance day or remove a wrong one,
+// it only changes the attendances table once an admin approves it
+type AttendanceCorrection struct {
+	ID         int        `json:"id"`
+	UserID     int        `json:"user_id"`
+	PeriodID   int        `json:"period_id"`
+	Date       time.Time  `json:"date"`
+	Action     string     `json:"action"`
+	Reason     string     `json:"reason"`
+	Status     string     `json:"status"`
+	ReviewedBy *int       `json:"reviewed_by"`
+	ReviewNote string     `json:"review_note"`
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,22 @@
+package attendance
+
+const (
+	ImportFormatCSV    = "csv"
+	ImportFormatAttlog = "attlog"
+)
+
+// ImportRowError describes why a single row of an attendance import was rejected,
+// Row is the 1-based line number in the uploaded file
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	User  string `json:"user"`
+	Date  string `json:"date"`
+	Error string `json:"error"`
+}
+
+type ImportReport struct {
+	TotalRows int              `json:"total_rows"`
+	Imported  int              `json:"imported"`
+	Failed    int              `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
+}
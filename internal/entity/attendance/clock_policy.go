@@ -0,0 +1,22 @@
+package attendance
+
+import "time"
+
+// ClockPolicy holds the shift rules applied to clock-in / clock-out attendance
+type ClockPolicy struct {
+	// ShiftStart is the offset from midnight at which the shift begins
+	ShiftStart       time.Duration
+	MinWorkDuration  time.Duration
+	MaxShiftDuration time.Duration
+	Location         *time.Location
+}
+
+// LateMinutes returns how many whole minutes the clock-in is past the shift start,
+// the clock-in must already be in the shift's location
+func LateMinutes(localClockIn time.Time, shiftStart time.Duration) int {
+	shiftStartAt := time.Date(localClockIn.Year(), localClockIn.Month(), localClockIn.Day(), 0, 0, 0, 0, localClockIn.Location()).Add(shiftStart)
+	if !localClockIn.After(shiftStartAt) {
+		return 0
+	}
+	return int(localClockIn.Sub(shiftStartAt).Minutes())
+}
@@ -1,9 +1,10 @@
 package attendance
 
 type EmployeeAttendanceSummary struct {
-	UserID             int
-	BaseSalary         int
-	PresentDays        int
-	OvertimeHours      int
-	ReimbursementTotal int
-}
\ No newline at end of file
+	UserID                       int
+	BaseSalary                   int
+	PresentDays                  int
+	OvertimeMinutes              int
+	RestDayOvertimeMinutes       int
+	PublicHolidayOvertimeMinutes int
+}
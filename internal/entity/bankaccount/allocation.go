@@ -0,0 +1,67 @@
+package bankaccount
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	AllocationFixed      = "fixed"
+	AllocationPercentage = "percentage"
+)
+
+// Allocation sends part of an employee's take home pay to one of their other verified accounts, either a fixed
+// amount or a percentage of the take home pay. What the allocations leave goes to the primary account
+type Allocation struct {
+	ID            int       `json:"id"`
+	UserID        int       `json:"user_id"`
+	BankAccountID int       `json:"bank_account_id"`
+	Method        string    `json:"method"`
+	Amount        int       `json:"amount"`
+	Percentage    int       `json:"percentage"`
+	CreatedBy     int       `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	// Account is the account the allocation pays to
+	Account BankAccount `json:"account"`
+}
+
+// ValidateAllocation checks the amount or percentage of an allocation
+func ValidateAllocation(allocation Allocation) error {
+	switch allocation.Method {
+	case AllocationFixed:
+		if allocation.Amount <= 0 || allocation.Percentage != 0 {
+			return fmt.Errorf("a fixed allocation needs an amount greater than 0 and no percentage")
+		}
+	case AllocationPercentage:
+		if allocation.Percentage <= 0 || allocation.Percentage >= 100 || allocation.Amount != 0 {
+			return fmt.Errorf("a percentage allocation needs a percentage from 1 to 99 and no amount")
+		}
+	default:
+		return fmt.Errorf("method must be %s or %s", AllocationFixed, AllocationPercentage)
+	}
+	return nil
+}
+
+// Split divides take home pay over the allocations in their order, a percentage is of the whole take home pay
+// rounded down to the rupiah. An allocation gets at most what the ones before it left, so the amounts never add
+// up to more than the take home pay, and the remainder is what goes to the primary account
+func Split(takeHomePay int, allocations []Allocation) ([]int, int) {
+	amounts := make([]int, len(allocations))
+	remainder := takeHomePay
+	for i, allocation := range allocations {
+		amount := allocation.Amount
+		if allocation.Method == AllocationPercentage {
+			amount = takeHomePay * allocation.Percentage / 100
+		}
+		if amount > remainder {
+			amount = remainder
+		}
+		if amount < 0 {
+			amount = 0
+		}
+		amounts[i] = amount
+		remainder -= amount
+	}
+	return amounts, remainder
+}
@@ -0,0 +1,108 @@
+package bankaccount
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	StatusPending  = "pending"
+	StatusVerified = "verified"
+	StatusRejected = "rejected"
+)
+
+// BankAccount is an account an employee's salary is paid to. A new or changed account is pending until
+// an admin other than the one who entered it verifies it, only verified accounts are paid to. The primary
+// account with the latest effective date that has been reached is the one salaries go to
+type BankAccount struct {
+	ID            int        `json:"id"`
+	UserID        int        `json:"user_id"`
+	BankCode      string     `json:"bank_code"`
+	AccountNumber string     `json:"account_number"`
+	AccountHolder string     `json:"account_holder"`
+	IsPrimary     bool       `json:"is_primary"`
+	EffectiveDate time.Time  `json:"effective_date"`
+	Status        string     `json:"status"`
+	RequestedBy   int        `json:"requested_by"`
+	VerifiedBy    *int       `json:"verified_by"`
+	VerifiedAt    *time.Time `json:"verified_at"`
+	ReviewNote    string     `json:"review_note"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Bank is a bank salaries can be paid to, with the lengths its account numbers can have
+type Bank struct {
+	Code           string `json:"code"`
+	Name           string `json:"name"`
+	AccountLengths []int  `json:"account_lengths"`
+}
+
+// Banks are the banks salaries can be paid to by their Bank Indonesia code
+var Banks = map[string]Bank{
+	"002": {Code: "002", Name: "BRI", AccountLengths: []int{15}},
+	"008": {Code: "008", Name: "Mandiri", AccountLengths: []int{13}},
+	"009": {Code: "009", Name: "BNI", AccountLengths: []int{10}},
+	"011": {Code: "011", Name: "Danamon", AccountLengths: []int{10}},
+	"013": {Code: "013", Name: "Permata", AccountLengths: []int{10}},
+	"014": {Code: "014", Name: "BCA", AccountLengths: []int{10}},
+	"022": {Code: "022", Name: "CIMB Niaga", AccountLengths: []int{12, 13, 14}},
+	"200": {Code: "200", Name: "BTN", AccountLengths: []int{16}},
+	"451": {Code: "451", Name: "BSI", AccountLengths: []int{10}},
+}
+
+// IsValidStatus reports whether status is one of the account statuses
+func IsValidStatus(status string) bool {
+	return status == StatusPending || status == StatusVerified || status == StatusRejected
+}
+
+// Normalize removes the spaces and dashes account numbers are often written with and the extra spaces
+// of the holder name, which banks compare upper cased
+func Normalize(account BankAccount) BankAccount {
+	account.BankCode = strings.TrimSpace(account.BankCode)
+	account.AccountNumber = strings.NewReplacer(" ", "", "-", "", ".", "").Replace(account.AccountNumber)
+	account.AccountHolder = strings.ToUpper(strings.Join(strings.Fields(account.AccountHolder), " "))
+	return account
+}
+
+// Validate checks the account number against the format of its bank and that the holder name can be
+// sent to the bank
+func Validate(account BankAccount) error {
+	bank, ok := Banks[account.BankCode]
+	if !ok {
+		return fmt.Errorf("unknown bank code %s", account.BankCode)
+	}
+	for _, r := range account.AccountNumber {
+		if r < '0' || r > '9' {
+			return fmt.Errorf("account number must only have digits")
+		}
+	}
+	validLength := false
+	for _, length := range bank.AccountLengths {
+		if len(account.AccountNumber) == length {
+			validLength = true
+		}
+	}
+	if !validLength {
+		lengths := make([]string, len(bank.AccountLengths))
+		for i, length := range bank.AccountLengths {
+			lengths[i] = fmt.Sprint(length)
+		}
+		return fmt.Errorf("%s account numbers have %s digits", bank.Name, strings.Join(lengths, " or "))
+	}
+
+	if account.AccountHolder == "" {
+		return fmt.Errorf("account_holder is required")
+	}
+	for _, r := range account.AccountHolder {
+		if !unicode.IsLetter(r) && r != ' ' && r != '.' && r != ',' && r != '\'' && r != '-' {
+			return fmt.Errorf("account_holder can only have letters, spaces and . , ' -")
+		}
+	}
+	if account.EffectiveDate.IsZero() {
+		return fmt.Errorf("effective_date is required")
+	}
+	return nil
+}
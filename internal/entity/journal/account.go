@@ -0,0 +1,46 @@
+package journal
+
+import "time"
+
+const (
+	// expense components are debited with what the payslips earn, the payable components are credited with
+	// what is withheld from pay and what is paid out to employees. Payroll withholds nothing yet, so tax and
+	// bpjs have accounts in the chart but no lines are posted to them
+	ComponentSalary        = "salary"
+	ComponentOvertime      = "overtime"
+	ComponentReimbursement = "reimbursement"
+	ComponentTax           = "tax"
+	ComponentBPJS          = "bpjs"
+	ComponentNetPay        = "net_pay"
+)
+
+// Components are the components of the payroll journal in the order their lines are posted
+var Components = []string{ComponentSalary, ComponentOvertime, ComponentReimbursement, ComponentTax, ComponentBPJS, ComponentNetPay}
+
+// Account maps a journal component of a cost center to an account of the chart of accounts, the account of
+// the empty cost center is used by cost centers without their own
+type Account struct {
+	ID         int       `json:"id"`
+	Component  string    `json:"component"`
+	CostCenter string    `json:"cost_center"`
+	Code       string    `json:"account_code"`
+	Name       string    `json:"account_name"`
+	UpdatedBy  *int      `json:"updated_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// IsValidComponent reports whether component is a component of the payroll journal
+func IsValidComponent(component string) bool {
+	for _, c := range Components {
+		if c == component {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDebit reports whether a component is posted as a debit, the expenses are and the payables aren't
+func IsDebit(component string) bool {
+	return component == ComponentSalary || component == ComponentOvertime || component == ComponentReimbursement
+}
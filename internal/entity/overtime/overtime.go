@@ -2,12 +2,32 @@ package overtime
 
 import "time"
 
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+
+	TypeRegular       = "regular"
+	TypeRestDay       = "rest_day"
+	TypePublicHoliday = "public_holiday"
+)
+
 type Overtime struct {
-	ID        int
-	UserID    int
-	PeriodID  int
-	Date      time.Time 
-	Hours     int
-	CreatedAt time.Time
-	UpdatedAt time.Time
-}
\ No newline at end of file
+	ID         int
+	UserID     int
+	PeriodID   int
+	Date       time.Time 
+	StartAt    *time.Time
+	EndAt      *time.Time
+	Minutes    int
+	Type       string
+	Status     string
+	// LimitOverriddenBy is the admin who recorded the overtime above the overtime limits
+	LimitOverriddenBy   *int
+	LimitOverrideReason string
+	ReviewedBy *int
+	ReviewNote string
+	ReviewedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
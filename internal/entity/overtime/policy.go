@@ -0,0 +1,42 @@
+package overtime
+
+const (
+	RoundDown    = "down"
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+)
+
+// Policy holds the overtime rules per overtime type,
+// MaxHours is the most overtime a single day can hold
+// and RatePercent is the pay rate payroll applies to those hours (100 = the normal overtime rate).
+// The worked duration between start and end is rounded to RoundingMinutes using RoundingMode.
+// WeeklyLimitHours and PeriodLimitHours cap regular overtime per Monday-Sunday week and per attendance period,
+// 0 leaves the window unlimited
+type Policy struct {
+	MaxHours         map[string]int
+	RatePercent      map[string]int
+	RoundingMinutes  int
+	RoundingMode     string
+	WeeklyLimitHours int
+	PeriodLimitHours int
+}
+
+// RoundMinutes rounds a worked duration to the policy increment, no increment keeps the exact minutes
+func (p Policy) RoundMinutes(minutes int) int {
+	if p.RoundingMinutes <= 1 {
+		return minutes
+	}
+	remainder := minutes % p.RoundingMinutes
+	if remainder == 0 {
+		return minutes
+	}
+	switch p.RoundingMode {
+	case RoundUp:
+		return minutes - remainder + p.RoundingMinutes
+	case RoundNearest:
+		if remainder*2 >= p.RoundingMinutes {
+			return minutes - remainder + p.RoundingMinutes
+		}
+	}
+	return minutes - remainder
+}
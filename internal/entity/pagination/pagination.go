@@ -0,0 +1,58 @@
+package pagination
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Request is the page a list is asked for, pages start at 1
+type Request struct {
+	Page     int
+	PageSize int
+}
+
+// NewRequest fills in the first page and the default page size when they are not given
+// and caps the page size at MaxPageSize
+func NewRequest(page, pageSize int) Request {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = DefaultPageSize
+	}
+	return Request{Page: page, PageSize: min(pageSize, MaxPageSize)}
+}
+
+func (r Request) Limit() int {
+	return r.PageSize
+}
+
+func (r Request) Offset() int {
+	return (r.Page - 1) * r.PageSize
+}
+
+// Page is one page of a list, Total counts the items of every page
+type Page[T any] struct {
+	Items      []T `json:"items"`
+	Page       int `json:"page"`
+	PageSize   int `json:"page_size"`
+	Total      int `json:"total"`
+	TotalPages int `json:"total_pages"`
+}
+
+func NewPage[T any](items []T, total int, r Request) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	totalPages := 0
+	if r.PageSize > 0 {
+		totalPages = (total + r.PageSize - 1) / r.PageSize
+	}
+	return Page[T]{
+		Items:      items,
+		Page:       r.Page,
+		PageSize:   r.PageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
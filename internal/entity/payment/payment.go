@@ -0,0 +1,76 @@
+package payment
+
+import "time"
+
+const (
+	// a payment is pending from the disbursement file until the batch is marked sent, then the bank
+	// statement or an admin settles it as paid, failed or returned
+	StatusPending  = "pending"
+	StatusSent     = "sent"
+	StatusPaid     = "paid"
+	StatusFailed   = "failed"
+	StatusReturned = "returned"
+)
+
+// Payment is one transfer of a disbursement file, the part of a payslip's take home pay paid into one account
+type Payment struct {
+	ID        int `json:"id"`
+	PayslipID int `json:"payslip_id"`
+	PeriodID  int `json:"period_id"`
+	UserID    int `json:"user_id"`
+	// Reference is the end to end reference of the transfer, BatchReference the file it was sent in
+	Reference      string     `json:"reference"`
+	BatchReference string     `json:"batch_reference"`
+	ExecutionDate  time.Time  `json:"execution_date"`
+	BankCode       string     `json:"bank_code"`
+	AccountNumber  string     `json:"account_number"`
+	AccountHolder  string     `json:"account_holder"`
+	Amount         int        `json:"amount"`
+	Status         string     `json:"status"`
+	Note           string     `json:"note"`
+	SentAt         *time.Time `json:"sent_at"`
+	SettledAt      *time.Time `json:"settled_at"`
+	// RetryOf is the failed or returned payment this one pays again
+	RetryOf   *int      `json:"retry_of"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsValidStatus reports whether status is a known payment status
+func IsValidStatus(status string) bool {
+	switch status {
+	case StatusPending, StatusSent, StatusPaid, StatusFailed, StatusReturned:
+		return true
+	}
+	return false
+}
+
+// transitions are the statuses a payment can go to from each status. A pending payment fails when the bank
+// refuses the file, a paid one is returned when the receiving bank sends it back. Failed and returned
+// payments are final, paying them again is a new transfer
+var transitions = map[string][]string{
+	StatusPending: {StatusSent, StatusFailed},
+	StatusSent:    {StatusPaid, StatusFailed, StatusReturned},
+	StatusPaid:    {StatusReturned},
+}
+
+// CanChangeStatus reports whether a payment can go from one status to the other
+func CanChangeStatus(from, to string) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusesBefore lists the statuses a payment can go to status from
+func StatusesBefore(status string) []string {
+	from := []string{}
+	for _, s := range []string{StatusPending, StatusSent, StatusPaid} {
+		if CanChangeStatus(s, status) {
+			from = append(from, s)
+		}
+	}
+	return from
+}
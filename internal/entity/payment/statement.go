@@ -0,0 +1,76 @@
+package payment
+
+import "time"
+
+const (
+	// a line is matched when the import settled a payment with it, an unmatched line is resolved by an
+	// admin matching it to a payment or dismissed when it isn't a salary payment at all
+	LineStatusMatched   = "matched"
+	LineStatusUnmatched = "unmatched"
+	LineStatusResolved  = "resolved"
+	LineStatusDismissed = "dismissed"
+)
+
+// Statement is an imported bank statement
+type Statement struct {
+	ID         int       `json:"id"`
+	FileName   string    `json:"file_name"`
+	Format     string    `json:"format"`
+	Checksum   string    `json:"checksum"`
+	ImportedBy int       `json:"imported_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// StatementLine is a credit or the reversal of a credit on a bank statement, Mark is C or RC
+type StatementLine struct {
+	ID            int       `json:"id"`
+	StatementID   int       `json:"statement_id"`
+	LineNumber    int       `json:"line_number"`
+	ValueDate     time.Time `json:"value_date"`
+	Mark          string    `json:"mark"`
+	Amount        int       `json:"amount"`
+	AccountNumber string    `json:"account_number"`
+	Reference     string    `json:"reference"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+	PaymentID     *int      `json:"payment_id"`
+	ResolvedBy    *int      `json:"resolved_by"`
+	Note          string    `json:"note"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Settlement is the status a statement line settles a payment with, From is the status the payment was read
+// with so a payment changed in the meantime isn't settled
+type Settlement struct {
+	PaymentID int
+	From      string
+	Status    string
+	Note      string
+}
+
+// ImportedLine is a line of an imported statement and the settlement of the payment it was matched to, nil
+// for an unmatched line
+type ImportedLine struct {
+	Line       StatementLine
+	Settlement *Settlement
+}
+
+// IsValidLineStatus reports whether status is a known statement line status
+func IsValidLineStatus(status string) bool {
+	switch status {
+	case LineStatusMatched, LineStatusUnmatched, LineStatusResolved, LineStatusDismissed:
+		return true
+	}
+	return false
+}
+
+// ImportReport is what a statement import did, the unmatched lines need to be resolved by hand
+type ImportReport struct {
+	StatementID int             `json:"statement_id"`
+	Entries     int             `json:"entries"`
+	Skipped     int             `json:"skipped"`
+	Paid        int             `json:"paid"`
+	Returned    int             `json:"returned"`
+	Unmatched   []StatementLine `json:"unmatched"`
+}
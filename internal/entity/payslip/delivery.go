@@ -0,0 +1,51 @@
+package payslip
+
+import "time"
+
+const (
+	// a delivery is queued until the mail server accepts it, failed once it ran out of attempts
+	// or the employee has no email address
+	DeliveryStatusQueued = "queued"
+	DeliveryStatusSent   = "sent"
+	DeliveryStatusFailed = "failed"
+)
+
+// Delivery is the email of one payslip to its employee, a resend queues it again
+type Delivery struct {
+	ID            int        `json:"id"`
+	PayslipID     int        `json:"payslip_id"`
+	UserID        int        `json:"user_id"`
+	PeriodID      int        `json:"period_id"`
+	Email         string     `json:"email"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// IsValidDeliveryStatus reports whether status is a known delivery status
+func IsValidDeliveryStatus(status string) bool {
+	return status == DeliveryStatusQueued || status == DeliveryStatusSent || status == DeliveryStatusFailed
+}
+
+// DeliveryPolicy decides how often a payslip email is tried, the wait doubles after every failed attempt
+type DeliveryPolicy struct {
+	MaxAttempts   int
+	RetryInterval time.Duration
+}
+
+// NextAttempt returns when to try again after the given number of failed attempts,
+// false when the delivery has run out of attempts
+func (p DeliveryPolicy) NextAttempt(attempts int, now time.Time) (time.Time, bool) {
+	if attempts >= p.MaxAttempts {
+		return time.Time{}, false
+	}
+	wait := p.RetryInterval
+	for i := 1; i < attempts && wait < 24*time.Hour; i++ {
+		wait *= 2
+	}
+	return now.Add(wait), true
+}
@@ -8,10 +8,11 @@ type Payslip struct {
 	WorkingDays        int
 	PresentDays        int
 	AttendanceAmount   int
-	OvertimeHours      int
+	OvertimeHours      float64
 	OvertimeAmount     int
 	ReimbursementTotal int
 	TakeHomePay        int
+	Items              []PayslipItem
 	CreatedAt          string
 	UpdatedAt          string
 }
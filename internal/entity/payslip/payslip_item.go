@@ -0,0 +1,19 @@
+package payslip
+
+const (
+	ComponentAttendance    = "attendance"
+	ComponentOvertime      = "overtime"
+	ComponentReimbursement = "reimbursement"
+)
+
+// PayslipItem is one line of a payslip, reimbursements get a line per category. ReimbursementIDs are the
+// claims a reimbursement line pays, they are only known while payroll runs and aren't stored with the line
+type PayslipItem struct {
+	ID               int
+	PayslipID        int
+	Component        string
+	Category         string
+	Description      string
+	Amount           int
+	ReimbursementIDs []int
+}
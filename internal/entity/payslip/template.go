@@ -0,0 +1,18 @@
+package payslip
+
+import "time"
+
+// Template is one uploaded version of the payslip layout of a legal entity, an html/template
+// executed with the payslip data. An empty LegalEntity is the template of the company itself
+type Template struct {
+	ID          int        `json:"id"`
+	LegalEntity string     `json:"legal_entity"`
+	Version     int        `json:"version"`
+	Content     string     `json:"content,omitempty"`
+	IsActive    bool       `json:"is_active"`
+	CreatedBy   *int       `json:"created_by"`
+	ActivatedBy *int       `json:"activated_by"`
+	ActivatedAt *time.Time `json:"activated_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,39 @@
+package reimbursement
+
+import (
+	"io"
+	"time"
+)
+
+const (
+	ContentTypePDF  = "application/pdf"
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+)
+
+// Attachment is a stored receipt of a reimbursement, Checksum is the hex SHA-256 of the file
+type Attachment struct {
+	ID              int       `json:"id"`
+	ReimbursementID int       `json:"reimbursement_id"`
+	FileName        string    `json:"file_name"`
+	ContentType     string    `json:"content_type"`
+	SizeBytes       int64     `json:"size_bytes"`
+	Checksum        string    `json:"checksum_sha256"`
+	StorageKey      string    `json:"-"`
+	UploadedBy      int       `json:"uploaded_by"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Receipt is an uploaded receipt file before it is checked and stored
+type Receipt struct {
+	FileName string
+	Content  io.Reader
+}
+
+// ReceiptPolicy limits how many receipts a claim needs and takes and how large each one may be
+type ReceiptPolicy struct {
+	MinFiles     int
+	MaxFiles     int
+	MaxSizeBytes int64
+}
@@ -0,0 +1,73 @@
+package reimbursement
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	CategoryMedical  = "medical"
+	CategoryTravel   = "travel"
+	CategoryMeal     = "meal"
+	CategoryInternet = "internet"
+
+	// OverLimitReject rejects a claim above a limit, OverLimitPartial pays it up to the limit
+	OverLimitReject  = "reject"
+	OverLimitPartial = "partial"
+)
+
+// Category holds the limits of a reimbursement category, a limit of 0 is unlimited
+// and an empty EligibleGrades makes the category available to every grade.
+// A category with a Calculation computes the claim amount from the rate table
+type Category struct {
+	ID              int       `json:"id"`
+	Code            string    `json:"code"`
+	Name            string    `json:"name"`
+	PerClaimLimit   int       `json:"per_claim_limit"`
+	PerPeriodLimit  int       `json:"per_period_limit"`
+	PerYearLimit    int       `json:"per_year_limit"`
+	EligibleGrades  []string  `json:"eligible_grades"`
+	OverLimitAction string    `json:"over_limit_action"`
+	Calculation     string    `json:"calculation"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// CategoryUsage is what an employee was already reimbursed in a category
+type CategoryUsage struct {
+	PeriodTotal int
+	YearTotal   int
+}
+
+// CategoryTotal is an employee's reimbursement total of one category in a period, ReimbursementIDs are the
+// claims it adds up
+type CategoryTotal struct {
+	UserID           int
+	Category         string
+	Name             string
+	Amount           int
+	ReimbursementIDs []int
+}
+
+func (c Category) IsEligible(grade string) bool {
+	return len(c.EligibleGrades) == 0 || slices.Contains(c.EligibleGrades, grade)
+}
+
+// Allowed returns how much of the requested amount fits within the claim, period and year limits
+// given what was already reimbursed, and the name of the tightest limit when it is less than requested
+func (c Category) Allowed(requested int, usage CategoryUsage) (int, string) {
+	allowed, limit := requested, ""
+	apply := func(name string, limitAmount, used int) {
+		if limitAmount == 0 {
+			return
+		}
+		remaining := max(limitAmount-used, 0)
+		if remaining < allowed {
+			allowed, limit = remaining, name
+		}
+	}
+	apply("per claim", c.PerClaimLimit, 0)
+	apply("per period", c.PerPeriodLimit, usage.PeriodTotal)
+	apply("per year", c.PerYearLimit, usage.YearTotal)
+	return allowed, limit
+}
@@ -0,0 +1,107 @@
+package reimbursement
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// DuplicateReasonSimilarClaim is a claim with the same amount and a similar description
+	DuplicateReasonSimilarClaim = "similar_claim"
+	// DuplicateReasonSameReceipt is a claim with a receipt file identical to one already submitted
+	DuplicateReasonSameReceipt = "same_receipt"
+)
+
+// DuplicateFlag marks a claim as a suspected duplicate of an earlier claim,
+// Similarity is how alike the descriptions are, from 0 to 1
+type DuplicateFlag struct {
+	ID              int       `json:"id"`
+	ReimbursementID int       `json:"reimbursement_id"`
+	DuplicateOfID   int       `json:"duplicate_of_id"`
+	Reason          string    `json:"reason"`
+	Similarity      float64   `json:"similarity"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// DuplicatePolicy decides which earlier claims a new claim is compared with:
+// claims of the same user made within WindowDays whose descriptions are at least MinSimilarity alike
+type DuplicatePolicy struct {
+	WindowDays    int
+	MinSimilarity float64
+}
+
+// ClaimSummary is the part of a claim shown in the duplicate report
+type ClaimSummary struct {
+	ID             int       `json:"id"`
+	UserID         int       `json:"user_id"`
+	PeriodID       int       `json:"period_id"`
+	Category       string    `json:"category"`
+	Currency       string    `json:"currency"`
+	OriginalAmount float64   `json:"original_amount"`
+	Amount         int       `json:"amount"`
+	Description    string    `json:"description"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// DuplicateReportEntry is a suspected duplicate together with the claim it appears to repeat
+type DuplicateReportEntry struct {
+	DuplicateFlag
+	Claim       ClaimSummary `json:"claim"`
+	DuplicateOf ClaimSummary `json:"duplicate_of"`
+}
+
+// SimilarClaims returns a flag for every earlier claim with the same amount in the same currency
+// and a description at least policy.MinSimilarity alike, the earlier claims must already be limited to the window
+func SimilarClaims(claim Reimbursement, earlier []Reimbursement, policy DuplicatePolicy) []DuplicateFlag {
+	flags := []DuplicateFlag{}
+	for _, other := range earlier {
+		if other.ID == claim.ID || other.Currency != claim.Currency || !sameAmount(other.OriginalAmount, claim.OriginalAmount) {
+			continue
+		}
+		similarity := DescriptionSimilarity(claim.Description, other.Description)
+		if similarity < policy.MinSimilarity {
+			continue
+		}
+		flags = append(flags, DuplicateFlag{
+			DuplicateOfID: other.ID,
+			Reason:        DuplicateReasonSimilarClaim,
+			Similarity:    math.Round(similarity*1000) / 1000,
+		})
+	}
+	return flags
+}
+
+func sameAmount(a, b float64) bool {
+	return math.Round(a*100) == math.Round(b*100)
+}
+
+// DescriptionSimilarity compares two descriptions by the words they share (Jaccard index),
+// case and punctuation are ignored and two empty descriptions are the same
+func DescriptionSimilarity(a, b string) float64 {
+	wordsA := descriptionWords(a)
+	wordsB := descriptionWords(b)
+	if len(wordsA) == 0 && len(wordsB) == 0 {
+		return 1
+	}
+
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(wordsA)+len(wordsB)-shared)
+}
+
+func descriptionWords(description string) map[string]bool {
+	words := map[string]bool{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		words[word] = true
+	}
+	return words
+}
@@ -0,0 +1,44 @@
+package reimbursement
+
+import (
+	"math"
+	"regexp"
+	"time"
+)
+
+// BaseCurrency is the currency payslips are paid in, every claim is converted to it
+const BaseCurrency = "IDR"
+
+const (
+	RateSourceManual   = "manual"
+	RateSourceProvider = "provider"
+)
+
+// ExchangeRate is how much IDR one unit of Currency was worth on RateDate
+type ExchangeRate struct {
+	ID        int       `json:"id"`
+	Currency  string    `json:"currency"`
+	RateDate  time.Time `json:"rate_date"`
+	Rate      float64   `json:"rate"`
+	Source    string    `json:"source"`
+	CreatedBy *int      `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ExchangeRatePolicy decides how old a rate may be before it is no longer used for new claims
+type ExchangeRatePolicy struct {
+	MaxAgeDays int
+}
+
+// Convert turns an amount in the rate's currency into whole IDR
+func (r ExchangeRate) Convert(amount float64) int {
+	return int(math.Round(amount * r.Rate))
+}
+
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// IsValidCurrency reports whether code looks like an ISO 4217 currency code
+func IsValidCurrency(code string) bool {
+	return currencyRegex.MatchString(code)
+}
@@ -0,0 +1,71 @@
+package reimbursement
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	// CalculationMileage pays a rate per kilometer driven, CalculationPerDiem a daily rate for the destination city
+	CalculationMileage = "mileage"
+	CalculationPerDiem = "per_diem"
+)
+
+// Rate is the IDR paid per kilometer or per day from EffectiveFrom on,
+// an empty Destination is the rate for destinations without a rate of their own
+type Rate struct {
+	ID            int       `json:"id"`
+	Calculation   string    `json:"calculation"`
+	Destination   string    `json:"destination"`
+	Rate          int       `json:"rate"`
+	EffectiveFrom time.Time `json:"effective_from"`
+	CreatedBy     *int      `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Calculation is what a mileage or per diem claim was computed from,
+// Amount = DistanceKm * UnitRate for mileage and TravelDays * UnitRate for per diem
+type Calculation struct {
+	Method      string  `json:"method"`
+	DistanceKm  float64 `json:"distance_km,omitempty"`
+	TravelDays  int     `json:"travel_days,omitempty"`
+	Destination string  `json:"destination,omitempty"`
+	RateID      int     `json:"rate_id"`
+	UnitRate    int     `json:"unit_rate"`
+}
+
+// IsValidCalculation reports whether method is a known calculation, an empty method is a typed in amount
+func IsValidCalculation(method string) bool {
+	return method == CalculationMileage || method == CalculationPerDiem
+}
+
+// Amount is the IDR amount of the claim, mileage is rounded to the nearest rupiah
+func (c Calculation) Amount() int {
+	switch c.Method {
+	case CalculationMileage:
+		return int(math.Round(c.DistanceKm * float64(c.UnitRate)))
+	case CalculationPerDiem:
+		return c.TravelDays * c.UnitRate
+	}
+	return 0
+}
+
+// RoundDistance rounds a distance to the 2 decimals distance_km is stored with, so the amount is
+// computed from the distance that is saved
+func RoundDistance(km float64) float64 {
+	return math.Round(km*100) / 100
+}
+
+// NormalizeDestination trims the city name and capitalizes each word so "  kuala  lumpur" and "Kuala Lumpur" are the same destination
+func NormalizeDestination(destination string) string {
+	words := strings.Fields(destination)
+	for i, word := range words {
+		runes := []rune(strings.ToLower(word))
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
@@ -2,12 +2,52 @@ package reimbursement
 
 import "time"
 
+const (
+	StatusSubmitted         = "submitted"
+	StatusOnHold            = "on_hold"
+	StatusApproved          = "approved"
+	StatusPartiallyApproved = "partially_approved"
+	StatusRejected          = "rejected"
+	StatusPaid              = "paid"
+)
+
+// Reimbursement is a claim within an attendance period, RequestedAmount is what the employee claimed,
+// Amount what is left after the category limits and ApprovedAmount what the reviewer approved to be paid.
+// All three are in IDR, OriginalAmount is what was spent in Currency and ExchangeRate the rate used to convert it.
+// Category is the category code, claims from before categories existed have no category
 type Reimbursement struct {
-	ID          int
-	UserID      int
-	PeriodID    int
-	Amount      int
-	Description string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID               int          `json:"id"`
+	UserID           int          `json:"user_id"`
+	PeriodID         int          `json:"period_id"`
+	CategoryID       *int         `json:"category_id"`
+	Category         string       `json:"category"`
+	Currency         string       `json:"currency"`
+	OriginalAmount   float64      `json:"original_amount"`
+	ExchangeRate     float64      `json:"exchange_rate"`
+	ExchangeRateDate *time.Time   `json:"exchange_rate_date"`
+	RequestedAmount  int          `json:"requested_amount"`
+	Amount           int          `json:"amount"`
+	Description      string       `json:"description"`
+	Status           string       `json:"status"`
+	ApprovedAmount   *int         `json:"approved_amount"`
+	ReviewedBy       *int         `json:"reviewed_by"`
+	ReviewerNotes    string       `json:"reviewer_notes"`
+	ReviewedAt       *time.Time   `json:"reviewed_at"`
+	Attachments      []Attachment `json:"attachments"`
+	// Calculation is set when the amount was computed from a mileage or per diem rate
+	Calculation *Calculation `json:"calculation,omitempty"`
+	// DuplicateFlags are the earlier claims this one looks like, a flagged claim is put on hold
+	DuplicateFlags []DuplicateFlag `json:"duplicate_flags,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// IsApproved reports whether the claim counts toward a payslip
+func (r Reimbursement) IsApproved() bool {
+	return r.Status == StatusApproved || r.Status == StatusPartiallyApproved
+}
+
+// IsAwaitingReview reports whether an admin still has to approve or reject the claim
+func (r Reimbursement) IsAwaitingReview() bool {
+	return r.Status == StatusSubmitted || r.Status == StatusOnHold
 }
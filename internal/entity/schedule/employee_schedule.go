@@ -0,0 +1,31 @@
+package schedule
+
+import "time"
+
+type EmployeeSchedule struct {
+	ID            int        `json:"id"`
+	UserID        int        `json:"user_id"`
+	ShiftID       int        `json:"shift_id"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to"`
+	Shift         Shift      `json:"shift"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// Covers reports whether the schedule is in effect on the date
+func (s EmployeeSchedule) Covers(date time.Time) bool {
+	if date.Before(s.EffectiveFrom) {
+		return false
+	}
+	return s.EffectiveTo == nil || !date.After(*s.EffectiveTo)
+}
+
+// IsWorkingDay reports whether the employee works on the date, an employee without a schedule (the zero
+// EmployeeSchedule) works every day. Payroll, attendance and overtime all go by this rule
+func (s EmployeeSchedule) IsWorkingDay(date time.Time) bool {
+	if s.ID == 0 {
+		return true
+	}
+	return s.Shift.IsWorkingDay(date)
+}
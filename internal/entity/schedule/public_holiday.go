@@ -0,0 +1,11 @@
+package schedule
+
+import "time"
+
+type PublicHoliday struct {
+	ID        int       `json:"id"`
+	Date      time.Time `json:"date"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,42 @@
+package schedule
+
+import "time"
+
+type Shift struct {
+	ID               int       `json:"id"`
+	Name             string    `json:"name"`
+	WorkPattern      string    `json:"work_pattern"`
+	PatternStartDate time.Time `json:"pattern_start_date"`
+	StartTime        string    `json:"start_time"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// IsWorkingDay reports whether the date falls on a working day of the shift cycle
+func (s Shift) IsWorkingDay(date time.Time) bool {
+	if len(s.WorkPattern) == 0 {
+		return false
+	}
+
+	anchor := time.Date(s.PatternStartDate.Year(), s.PatternStartDate.Month(), s.PatternStartDate.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	offset := int(day.Sub(anchor).Hours()/24) % len(s.WorkPattern)
+	if offset < 0 {
+		offset += len(s.WorkPattern)
+	}
+
+	return s.WorkPattern[offset] == '1'
+}
+
+// StartOffset returns the shift start as an offset from midnight
+func (s Shift) StartOffset() (time.Duration, error) {
+	layout := "15:04"
+	if len(s.StartTime) > len(layout) {
+		layout = "15:04:05"
+	}
+	t, err := time.Parse(layout, s.StartTime)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
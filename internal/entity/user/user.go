@@ -1,12 +1,21 @@
 package auth
 
+import "time"
+
 type User struct {
-	ID           int    `json:"id"`
-	Username     string `json:"username"`
-	PasswordHash string `json:"password_hash"`
-	FullName     string `json:"full_name"`
-	Salary       int    `json:"salary"`
-	IsAdmin      bool   `json:"is_admin"`
-	CreatedAt    string `json:"created_at"`
-	UpdatedAt    string `json:"updated_at"`
-}
\ No newline at end of file
+	ID             int        `json:"id"`
+	Username       string     `json:"username"`
+	PasswordHash   string     `json:"password_hash"`
+	FullName       string     `json:"full_name"`
+	Salary         int        `json:"salary"`
+	IsAdmin        bool       `json:"is_admin"`
+	ManagerID      *int       `json:"manager_id"`
+	Grade          string     `json:"grade"`
+	LegalEntity    string     `json:"legal_entity"`
+	CostCenter     string     `json:"cost_center"`
+	EmployeeNumber string     `json:"employee_number"`
+	Email          string     `json:"email"`
+	BirthDate      *time.Time `json:"birth_date"`
+	CreatedAt      string     `json:"created_at"`
+	UpdatedAt      string     `json:"updated_at"`
+}
@@ -0,0 +1,88 @@
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/httpclient"
+	"strings"
+	"time"
+)
+
+//go:generate mockgen -source=provider.go -package=mock -destination=mock/provider_mock.go
+// Provider fetches the current rate of a currency against IDR from an external source
+type Provider interface {
+	GetRate(ctx context.Context, currency string) (reimbursement.ExchangeRate, error)
+}
+
+type httpProvider struct {
+	client      httpclient.Client
+	urlTemplate string
+}
+
+// NewHTTPProvider provides a Provider calling urlTemplate, where {currency} is replaced by the currency code.
+// The response must be JSON in the common latest rates shape, e.g. frankfurter.app or exchangerate.host:
+//
+//	{"base": "USD", "date": "2025-06-02", "rates": {"IDR": 16250.5}}
+func NewHTTPProvider(client httpclient.Client, urlTemplate string) Provider {
+	return &httpProvider{
+		client:      client,
+		urlTemplate: urlTemplate,
+	}
+}
+
+type latestRatesResponse struct {
+	Base  string             `json:"base"`
+	Date  string             `json:"date"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+func (p *httpProvider) GetRate(ctx context.Context, currency string) (reimbursement.ExchangeRate, error) {
+	url := strings.ReplaceAll(p.urlTemplate, "{currency}", currency)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return reimbursement.ExchangeRate{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return reimbursement.ExchangeRate{}, fmt.Errorf("exchange rate provider: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return reimbursement.ExchangeRate{}, fmt.Errorf("exchange rate provider responded with status %d", resp.StatusCode)
+	}
+
+	var body latestRatesResponse
+	err = json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body)
+	if err != nil {
+		return reimbursement.ExchangeRate{}, fmt.Errorf("exchange rate provider: invalid response: %w", err)
+	}
+	if body.Base != "" && !strings.EqualFold(body.Base, currency) {
+		return reimbursement.ExchangeRate{}, fmt.Errorf("exchange rate provider returned rates for %s instead of %s", body.Base, currency)
+	}
+	rate, ok := body.Rates[reimbursement.BaseCurrency]
+	if !ok || rate <= 0 {
+		return reimbursement.ExchangeRate{}, fmt.Errorf("exchange rate provider has no %s rate for %s", reimbursement.BaseCurrency, currency)
+	}
+
+	rateDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if body.Date != "" {
+		rateDate, err = time.Parse("2006-01-02", body.Date)
+		if err != nil {
+			return reimbursement.ExchangeRate{}, fmt.Errorf("exchange rate provider: invalid date %s", body.Date)
+		}
+	}
+
+	return reimbursement.ExchangeRate{
+		Currency: currency,
+		RateDate: rateDate,
+		Rate:     rate,
+		Source:   reimbursement.RateSourceProvider,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package exchangerate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/httpclient"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPProvider_GetRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    reimbursement.ExchangeRate
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			status: http.StatusOK,
+			body:   `{"amount": 1.0, "base": "USD", "date": "2025-06-02", "rates": {"IDR": 16250.5}}`,
+			want: reimbursement.ExchangeRate{
+				Currency: "USD",
+				RateDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+				Rate:     16250.5,
+				Source:   reimbursement.RateSourceProvider,
+			},
+		},
+		{
+			name:    "Error - Missing IDR Rate",
+			status:  http.StatusOK,
+			body:    `{"base": "USD", "date": "2025-06-02", "rates": {"EUR": 0.9}}`,
+			wantErr: true,
+		},
+		{
+			name:    "Error - Other Base Currency",
+			status:  http.StatusOK,
+			body:    `{"base": "EUR", "date": "2025-06-02", "rates": {"IDR": 17500}}`,
+			wantErr: true,
+		},
+		{
+			name:    "Error - Provider Down",
+			status:  http.StatusServiceUnavailable,
+			body:    `{}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "USD", r.URL.Query().Get("from"))
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			provider := NewHTTPProvider(httpclient.New(&httpclient.Config{Timeout: 1000}), server.URL+"/latest?from={currency}&to=IDR")
+			got, err := provider.GetRate(context.Background(), "USD")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	journalmodel "payslip-generation-system/internal/entity/journal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+
+	// Currency is what the journal is posted in
+	Currency = "IDR"
+)
+
+// Posting is the amount of a component for a cost center, what a journal is built from
+type Posting struct {
+	Component  string
+	CostCenter string
+	Amount     int
+}
+
+// Line is one line of a journal, either Debit or Credit is set
+type Line struct {
+	Number      int    `json:"line"`
+	AccountCode string `json:"account_code"`
+	AccountName string `json:"account_name"`
+	Component   string `json:"component"`
+	CostCenter  string `json:"cost_center"`
+	Description string `json:"description"`
+	Debit       int    `json:"debit"`
+	Credit      int    `json:"credit"`
+}
+
+// Journal is the payroll of a period as a balanced journal entry, Reference ties it back to the period
+type Journal struct {
+	Reference   string
+	PeriodID    int
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Date        time.Time
+	Description string
+	Lines       []Line
+}
+
+// TotalDebit is the sum of the debit lines
+func (j Journal) TotalDebit() int {
+	total := 0
+	for _, line := range j.Lines {
+		total += line.Debit
+	}
+	return total
+}
+
+// TotalCredit is the sum of the credit lines
+func (j Journal) TotalCredit() int {
+	total := 0
+	for _, line := range j.Lines {
+		total += line.Credit
+	}
+	return total
+}
+
+// Chart is the chart of accounts mapping journals are posted with
+type Chart struct {
+	accounts map[string]journalmodel.Account
+}
+
+// NewChart indexes the accounts of the mapping by component and cost center
+func NewChart(accounts []journalmodel.Account) Chart {
+	c := Chart{accounts: map[string]journalmodel.Account{}}
+	for _, account := range accounts {
+		c.accounts[account.Component+"/"+account.CostCenter] = account
+	}
+	return c
+}
+
+// Account returns the account of a component for a cost center, the account of the empty cost center when
+// the cost center has none of its own
+func (c Chart) Account(component, costCenter string) (journalmodel.Account, bool) {
+	if account, ok := c.accounts[component+"/"+costCenter]; ok {
+		return account, true
+	}
+	account, ok := c.accounts[component+"/"]
+	return account, ok
+}
+
+// Build posts the postings to the journal, one line per component and cost center in the order of the
+// components. Expenses are debited and payables credited, a negative amount is posted on the other side.
+// A journal with a component that has no account, or that doesn't balance, is refused
+func Build(j Journal, chart Chart, postings []Posting) (Journal, error) {
+	type key struct{ component, costCenter string }
+	amounts := map[key]int{}
+	for _, posting := range postings {
+		if !journalmodel.IsValidComponent(posting.Component) {
+			return Journal{}, fmt.Errorf("unknown journal component %s", posting.Component)
+		}
+		amounts[key{posting.Component, posting.CostCenter}] += posting.Amount
+	}
+
+	keys := make([]key, 0, len(amounts))
+	for k, amount := range amounts {
+		if amount != 0 {
+			keys = append(keys, k)
+		}
+	}
+	order := map[string]int{}
+	for i, component := range journalmodel.Components {
+		order[component] = i
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		if keys[a].component != keys[b].component {
+			return order[keys[a].component] < order[keys[b].component]
+		}
+		return keys[a].costCenter < keys[b].costCenter
+	})
+
+	j.Lines = []Line{}
+	missing := []string{}
+	for _, k := range keys {
+		account, ok := chart.Account(k.component, k.costCenter)
+		if !ok {
+			missing = append(missing, describe(k.component, k.costCenter))
+			continue
+		}
+		line := Line{
+			Number:      len(j.Lines) + 1,
+			AccountCode: account.Code,
+			AccountName: account.Name,
+			Component:   k.component,
+			CostCenter:  k.costCenter,
+			Description: j.Description,
+		}
+		amount := amounts[k]
+		debit := journalmodel.IsDebit(k.component)
+		if amount < 0 {
+			debit, amount = !debit, -amount
+		}
+		if debit {
+			line.Debit = amount
+		} else {
+			line.Credit = amount
+		}
+		j.Lines = append(j.Lines, line)
+	}
+	if len(missing) > 0 {
+		return Journal{}, fmt.Errorf("no account mapped for %s", strings.Join(missing, ", "))
+	}
+	if j.TotalDebit() != j.TotalCredit() {
+		return Journal{}, fmt.Errorf("journal doesn't balance, debits of %d and credits of %d", j.TotalDebit(), j.TotalCredit())
+	}
+	return j, nil
+}
+
+func describe(component, costCenter string) string {
+	if costCenter == "" {
+		return component
+	}
+	return component + " of cost center " + costCenter
+}
+
+// IsValidFormat reports whether format is a format a journal can be written in
+func IsValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSON
+}
+
+// Encode writes the journal in the given format
+func Encode(format string, j Journal) ([]byte, error) {
+	switch format {
+	case FormatCSV:
+		return encodeCSV(j)
+	case FormatJSON:
+		return encodeJSON(j)
+	}
+	return nil, fmt.Errorf("format must be %s or %s", FormatCSV, FormatJSON)
+}
+
+// FileName is the name of the journal file of a period
+func FileName(format string, j Journal) string {
+	return fmt.Sprintf("journal-%s.%s", strings.ToLower(j.Reference), format)
+}
+
+// encodeCSV writes a row per line, each with the reference and period so rows can be imported on their own
+func encodeCSV(j Journal) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	rows := [][]string{{"reference", "period_id", "date", "line", "account_code", "account_name", "component", "cost_center", "description", "debit", "credit", "currency"}}
+	for _, line := range j.Lines {
+		rows = append(rows, []string{
+			j.Reference,
+			strconv.Itoa(j.PeriodID),
+			j.Date.Format("2006-01-02"),
+			strconv.Itoa(line.Number),
+			line.AccountCode,
+			line.AccountName,
+			line.Component,
+			line.CostCenter,
+			line.Description,
+			strconv.Itoa(line.Debit),
+			strconv.Itoa(line.Credit),
+			Currency,
+		})
+	}
+	err := w.WriteAll(rows)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeJSON(j Journal) ([]byte, error) {
+	return json.MarshalIndent(struct {
+		Reference   string `json:"reference"`
+		PeriodID    int    `json:"period_id"`
+		PeriodStart string `json:"period_start"`
+		PeriodEnd   string `json:"period_end"`
+		Date        string `json:"date"`
+		Description string `json:"description"`
+		Currency    string `json:"currency"`
+		TotalDebit  int    `json:"total_debit"`
+		TotalCredit int    `json:"total_credit"`
+		Lines       []Line `json:"lines"`
+	}{
+		Reference:   j.Reference,
+		PeriodID:    j.PeriodID,
+		PeriodStart: j.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:   j.PeriodEnd.Format("2006-01-02"),
+		Date:        j.Date.Format("2006-01-02"),
+		Description: j.Description,
+		Currency:    Currency,
+		TotalDebit:  j.TotalDebit(),
+		TotalCredit: j.TotalCredit(),
+		Lines:       j.Lines,
+	}, "", "  ")
+}
@@ -0,0 +1,108 @@
+package journal
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	journalmodel "payslip-generation-system/internal/entity/journal"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testChart() Chart {
+	return NewChart([]journalmodel.Account{
+		{Component: journalmodel.ComponentSalary, Code: "6100", Name: "Salary expense"},
+		{Component: journalmodel.ComponentSalary, CostCenter: "OPS", Code: "6101", Name: "Salary expense - operations"},
+		{Component: journalmodel.ComponentOvertime, Code: "6110", Name: "Overtime expense"},
+		{Component: journalmodel.ComponentReimbursement, Code: "6120", Name: "Reimbursement expense"},
+		{Component: journalmodel.ComponentTax, Code: "2110", Name: "PPh 21 payable"},
+		{Component: journalmodel.ComponentNetPay, Code: "2100", Name: "Net pay payable"},
+	})
+}
+
+func testJournal() Journal {
+	return Journal{
+		Reference:   "PAYROLL-3",
+		PeriodID:    3,
+		PeriodStart: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		Date:        time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		Description: "Payroll 01/06/2025-30/06/2025",
+	}
+}
+
+func TestBuild(t *testing.T) {
+	postings := []Posting{
+		{Component: journalmodel.ComponentNetPay, CostCenter: "OPS", Amount: 5000000},
+		{Component: journalmodel.ComponentSalary, CostCenter: "OPS", Amount: 4800000},
+		{Component: journalmodel.ComponentOvertime, CostCenter: "OPS", Amount: 300000},
+		{Component: journalmodel.ComponentTax, CostCenter: "OPS", Amount: 100000},
+		{Component: journalmodel.ComponentSalary, CostCenter: "FIN", Amount: 4000000},
+		{Component: journalmodel.ComponentNetPay, CostCenter: "FIN", Amount: 4000000},
+		{Component: journalmodel.ComponentReimbursement, CostCenter: "FIN", Amount: 0},
+	}
+	j, err := Build(testJournal(), testChart(), postings)
+	assert.NoError(t, err)
+	assert.Equal(t, 9100000, j.TotalDebit())
+	assert.Equal(t, 9100000, j.TotalCredit())
+
+	got := []string{}
+	for _, line := range j.Lines {
+		got = append(got, strings.Join([]string{line.AccountCode, line.CostCenter}, " "))
+	}
+	// a cost center without its own account uses the default one, zero amounts get no line
+	assert.Equal(t, []string{"6100 FIN", "6101 OPS", "6110 OPS", "2110 OPS", "2100 FIN", "2100 OPS"}, got)
+	assert.Equal(t, 1, j.Lines[0].Number)
+	assert.Equal(t, 4000000, j.Lines[0].Debit)
+	assert.Equal(t, 5000000, j.Lines[5].Credit)
+
+	_, err = Build(testJournal(), testChart(), []Posting{
+		{Component: journalmodel.ComponentSalary, Amount: 100},
+		{Component: journalmodel.ComponentBPJS, CostCenter: "OPS", Amount: 100},
+	})
+	assert.ErrorContains(t, err, "no account mapped for bpjs of cost center OPS")
+
+	_, err = Build(testJournal(), testChart(), []Posting{
+		{Component: journalmodel.ComponentSalary, Amount: 100},
+		{Component: journalmodel.ComponentNetPay, Amount: 90},
+	})
+	assert.ErrorContains(t, err, "doesn't balance")
+}
+
+func TestEncode(t *testing.T) {
+	j, err := Build(testJournal(), testChart(), []Posting{
+		{Component: journalmodel.ComponentSalary, Amount: 4800000},
+		{Component: journalmodel.ComponentNetPay, Amount: 4800000},
+	})
+	assert.NoError(t, err)
+
+	content, err := Encode(FormatCSV, j)
+	assert.NoError(t, err)
+	rows, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, []string{"PAYROLL-3", "3", "2025-06-30", "1", "6100", "Salary expense", "salary", "", "Payroll 01/06/2025-30/06/2025", "4800000", "0", "IDR"}, rows[1])
+	assert.Equal(t, "journal-payroll-3.csv", FileName(FormatCSV, j))
+
+	content, err = Encode(FormatJSON, j)
+	assert.NoError(t, err)
+	var decoded struct {
+		Reference   string `json:"reference"`
+		PeriodID    int    `json:"period_id"`
+		PeriodStart string `json:"period_start"`
+		TotalDebit  int    `json:"total_debit"`
+		TotalCredit int    `json:"total_credit"`
+		Lines       []Line `json:"lines"`
+	}
+	assert.NoError(t, json.Unmarshal(content, &decoded))
+	assert.Equal(t, "PAYROLL-3", decoded.Reference)
+	assert.Equal(t, 3, decoded.PeriodID)
+	assert.Equal(t, "2025-06-01", decoded.PeriodStart)
+	assert.Equal(t, 4800000, decoded.TotalCredit)
+	assert.Equal(t, j.Lines, decoded.Lines)
+
+	_, err = Encode("xlsx", j)
+	assert.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+//go:generate mockgen -source=mailer.go -package=mock -destination=mock/mailer_mock.go
+// Sender delivers an email, an error means it was not accepted and can be tried again
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Message is a plain text email with optional attachments
+type Message struct {
+	To          string
+	Subject     string
+	Body        string
+	Attachments []Attachment
+}
+
+// Attachment is a file attached to a Message
+type Attachment struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// bytes renders the message as a MIME document, multipart when it has attachments
+func (m Message) bytes(from string, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+	header("From", from)
+	header("To", m.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	body := base64Lines([]byte(normalizeNewlines(m.Body)))
+	if len(m.Attachments) == 0 {
+		header("Content-Type", "text/plain; charset=utf-8")
+		header("Content-Transfer-Encoding", "base64")
+		buf.WriteString("\r\n")
+		buf.WriteString(body)
+		return buf.Bytes(), nil
+	}
+
+	random := make([]byte, 12)
+	_, err := rand.Read(random)
+	if err != nil {
+		return nil, err
+	}
+	boundary := "payslip-" + hex.EncodeToString(random)
+	header("Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": boundary}))
+	buf.WriteString("\r\n")
+
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "base64")
+	buf.WriteString("\r\n")
+	buf.WriteString(body)
+
+	for _, attachment := range m.Attachments {
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		header("Content-Type", mime.FormatMediaType(attachment.ContentType, map[string]string{"name": attachment.FileName}))
+		header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+		header("Content-Transfer-Encoding", "base64")
+		buf.WriteString("\r\n")
+		buf.WriteString(base64Lines(attachment.Content))
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+// base64Lines encodes content in base64 lines of 76 characters, the longest line MIME allows
+func base64Lines(content []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76])
+		b.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+func normalizeNewlines(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.ReplaceAll(text, "\n", "\r\n")
+}
@@ -0,0 +1,130 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const (
+	// TLSNone sends in plain text, for a local SMTP sink. TLSStartTLS upgrades the connection with STARTTLS,
+	// usually on port 587, and TLSImplicit connects over TLS from the start, usually on port 465
+	TLSNone     = "none"
+	TLSStartTLS = "starttls"
+	TLSImplicit = "tls"
+)
+
+// SMTPConfig is where and how mail is sent, no Username sends without authentication
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	TLS      string
+	Timeout  time.Duration
+}
+
+type smtpSender struct {
+	config SMTPConfig
+}
+
+// NewSMTPSender provides a Sender that hands messages to an SMTP server
+func NewSMTPSender(config SMTPConfig) (Sender, error) {
+	if config.Host == "" || config.Port <= 0 {
+		return nil, fmt.Errorf("smtp host and port are required")
+	}
+	if _, err := mail.ParseAddress(config.From); err != nil {
+		return nil, fmt.Errorf("invalid smtp from address %q", config.From)
+	}
+	switch config.TLS {
+	case "":
+		config.TLS = TLSStartTLS
+	case TLSNone, TLSStartTLS, TLSImplicit:
+	default:
+		return nil, fmt.Errorf("smtp tls must be %s, %s or %s", TLSNone, TLSStartTLS, TLSImplicit)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 30 * time.Second
+	}
+	return &smtpSender{config: config}, nil
+}
+
+func (s *smtpSender) Send(ctx context.Context, msg Message) error {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient %q", msg.To)
+	}
+	from, _ := mail.ParseAddress(s.config.From)
+	msg.To = to.String()
+	content, err := msg.bytes(from.String(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	tlsConfig := &tls.Config{ServerName: s.config.Host}
+
+	var conn net.Conn
+	if s.config.TLS == TLSImplicit {
+		dialer := &tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		dialer := &net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("smtp connect: %w", err)
+	}
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp greeting: %w", err)
+	}
+	defer client.Close()
+
+	if s.config.TLS == TLSStartTLS {
+		err = client.StartTLS(tlsConfig)
+		if err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if s.config.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host))
+		if err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	err = client.Mail(from.Address)
+	if err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	err = client.Rcpt(to.Address)
+	if err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	_, err = w.Write(content)
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	return client.Quit()
+}
@@ -0,0 +1,167 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sink is a local SMTP server that accepts every message, like the sinks used in development
+type sink struct {
+	listener net.Listener
+	auth     string
+	from     string
+	to       string
+	data     string
+	done     chan struct{}
+}
+
+func newSink(t *testing.T, rejectRcpt bool) *sink {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := &sink{listener: listener, done: make(chan struct{})}
+	go s.serve(rejectRcpt)
+	t.Cleanup(func() { listener.Close() })
+	return s
+}
+
+func (s *sink) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *sink) serve(rejectRcpt bool) {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 sink ready")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			reply("250-sink")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			s.auth = line
+			reply("235 authenticated")
+		case "MAIL":
+			s.from = line
+			reply("250 ok")
+		case "RCPT":
+			s.to = line
+			if rejectRcpt {
+				reply("550 no such user")
+				continue
+			}
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.data = data.String()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	s := newSink(t, false)
+	sender, err := NewSMTPSender(SMTPConfig{
+		Host:     "127.0.0.1",
+		Port:     s.port(),
+		Username: "payroll",
+		Password: "secret",
+		From:     "Payroll <payroll@example.com>",
+		TLS:      TLSNone,
+	})
+	assert.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{
+		To:      "Budi Santoso <budi@example.com>",
+		Subject: "Payslip June 2025",
+		Body:    "Dear Budi,\nyour payslip is attached.",
+		Attachments: []Attachment{
+			{FileName: "payslip-2025-06-01-budi-12.pdf", ContentType: "application/pdf", Content: []byte("%PDF-1.4 payslip")},
+		},
+	})
+	assert.NoError(t, err)
+	<-s.done
+
+	assert.Equal(t, "AUTH PLAIN AHBheXJvbGwAc2VjcmV0", s.auth)
+	assert.Equal(t, "MAIL FROM:<payroll@example.com>", s.from)
+	assert.Equal(t, "RCPT TO:<budi@example.com>", s.to)
+
+	msg, err := mail.ReadMessage(strings.NewReader(s.data))
+	assert.NoError(t, err)
+	assert.Equal(t, "Payslip June 2025", msg.Header.Get("Subject"))
+	assert.Equal(t, `"Budi Santoso" <budi@example.com>`, msg.Header.Get("To"))
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	body, err := parts.NextPart()
+	assert.NoError(t, err)
+	content, _ := io.ReadAll(base64.NewDecoder(base64.StdEncoding, body))
+	assert.Equal(t, "Dear Budi,\r\nyour payslip is attached.", string(content))
+	attachment, err := parts.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "payslip-2025-06-01-budi-12.pdf", attachment.FileName())
+	content, _ = io.ReadAll(base64.NewDecoder(base64.StdEncoding, attachment))
+	assert.Equal(t, "%PDF-1.4 payslip", string(content))
+}
+
+func TestSMTPSender_Send_Rejected(t *testing.T) {
+	s := newSink(t, true)
+	sender, err := NewSMTPSender(SMTPConfig{Host: "127.0.0.1", Port: s.port(), From: "payroll@example.com", TLS: TLSNone, Timeout: 5 * time.Second})
+	assert.NoError(t, err)
+
+	err = sender.Send(context.Background(), Message{To: "nobody@example.com", Subject: "Payslip", Body: "-"})
+	assert.ErrorContains(t, err, "550")
+
+	err = sender.Send(context.Background(), Message{To: "not an address", Subject: "Payslip", Body: "-"})
+	assert.Error(t, err)
+}
+
+func TestNewSMTPSender(t *testing.T) {
+	_, err := NewSMTPSender(SMTPConfig{Host: "smtp.example.com", Port: 587, From: "payroll@example.com", TLS: "ssl"})
+	assert.Error(t, err)
+	_, err = NewSMTPSender(SMTPConfig{Host: "smtp.example.com", Port: 587, From: "payroll"})
+	assert.Error(t, err)
+	_, err = NewSMTPSender(SMTPConfig{Host: "smtp.example.com", Port: 587, From: "payroll@example.com"})
+	assert.NoError(t, err)
+}
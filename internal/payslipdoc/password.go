@@ -0,0 +1,103 @@
+package payslipdoc
+
+import (
+	"fmt"
+	usermodel "payslip-generation-system/internal/entity/user"
+	"strings"
+)
+
+// dateLayouts maps the date format tokens of a password rule to Go layouts, the longest token first
+var dateLayouts = strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02")
+
+// PasswordRule builds the password of an employee's payslip PDF from their details, so the password
+// is never stored and employees know it without being told. A rule is text with placeholders, e.g.
+// "{birth_date}{employee_number}":
+//
+//	{birth_date}          birth date as DDMMYYYY, another format with {birth_date:YYYYMMDD}
+//	{employee_number}     employee number
+//	{username}            login name
+//
+// The zero PasswordRule leaves payslips unprotected
+type PasswordRule struct {
+	parts []rulePart
+}
+
+type rulePart struct {
+	literal     string
+	placeholder string
+	layout      string
+}
+
+// ParsePasswordRule parses a password rule, an empty rule leaves payslips unprotected
+func ParsePasswordRule(rule string) (PasswordRule, error) {
+	parts := []rulePart{}
+	hasPlaceholder := false
+	for rest := rule; rest != ""; {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			parts = append(parts, rulePart{literal: rest})
+			break
+		}
+		if start > 0 {
+			parts = append(parts, rulePart{literal: rest[:start]})
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return PasswordRule{}, fmt.Errorf("password rule has an unclosed {")
+		}
+		end += start
+
+		name, format, _ := strings.Cut(rest[start+1:end], ":")
+		part := rulePart{placeholder: name}
+		switch name {
+		case "birth_date":
+			if format == "" {
+				format = "DDMMYYYY"
+			}
+			part.layout = dateLayouts.Replace(format)
+		case "employee_number", "username":
+			if format != "" {
+				return PasswordRule{}, fmt.Errorf("{%s} in the password rule has no format", name)
+			}
+		default:
+			return PasswordRule{}, fmt.Errorf("unknown placeholder {%s} in the password rule", name)
+		}
+		parts = append(parts, part)
+		hasPlaceholder = true
+		rest = rest[end+1:]
+	}
+	if len(parts) > 0 && !hasPlaceholder {
+		return PasswordRule{}, fmt.Errorf("password rule needs a placeholder, every employee would have the same password")
+	}
+	return PasswordRule{parts: parts}, nil
+}
+
+// Enabled reports whether payslips are protected with a password
+func (r PasswordRule) Enabled() bool {
+	return len(r.parts) > 0
+}
+
+// Password returns the password of the employee's payslips, an employee without the details
+// the rule needs can't have a protected payslip
+func (r PasswordRule) Password(employee usermodel.User) (string, error) {
+	var b strings.Builder
+	for _, part := range r.parts {
+		switch part.placeholder {
+		case "":
+			b.WriteString(part.literal)
+		case "birth_date":
+			if employee.BirthDate == nil {
+				return "", fmt.Errorf("%s has no birth date to protect the payslip with", employee.Username)
+			}
+			b.WriteString(employee.BirthDate.Format(part.layout))
+		case "employee_number":
+			if employee.EmployeeNumber == "" {
+				return "", fmt.Errorf("%s has no employee number to protect the payslip with", employee.Username)
+			}
+			b.WriteString(employee.EmployeeNumber)
+		case "username":
+			b.WriteString(employee.Username)
+		}
+	}
+	return b.String(), nil
+}
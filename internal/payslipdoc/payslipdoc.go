@@ -0,0 +1,161 @@
+package payslipdoc
+
+import (
+	"fmt"
+	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/payslip"
+	usermodel "payslip-generation-system/internal/entity/user"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Company is printed in the header of every payslip
+type Company struct {
+	Name    string
+	Address string
+}
+
+// AddressLines splits the address into the lines it is printed on
+func (c Company) AddressLines() []string {
+	lines := []string{}
+	for _, line := range strings.Split(c.Address, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	return lines
+}
+
+// Data is everything printed on one payslip, it is what payslip templates are executed with
+type Data struct {
+	Company     Company
+	Payslip     payslip.Payslip
+	Employee    usermodel.User
+	Period      attendance.AttendancePeriod
+	GeneratedAt time.Time
+	// Payments are the accounts the take home pay is paid into, empty until the payments of the period are prepared
+	Payments []Payment
+}
+
+// Payment is the part of the take home pay paid into one bank account
+type Payment struct {
+	BankName      string
+	AccountNumber string
+	AccountHolder string
+	Amount        int
+	Primary       bool
+}
+
+// MaskedAccountNumber hides all but the last 4 digits of the account number, payslips are emailed around
+func (p Payment) MaskedAccountNumber() string {
+	if len(p.AccountNumber) <= 4 {
+		return p.AccountNumber
+	}
+	return strings.Repeat("*", len(p.AccountNumber)-4) + p.AccountNumber[len(p.AccountNumber)-4:]
+}
+
+// Earnings returns the lines of the payslip, payslips generated before line items were kept
+// are broken down from their totals
+func (d Data) Earnings() []payslip.PayslipItem {
+	p := d.Payslip
+	if len(p.Items) > 0 {
+		return p.Items
+	}
+	items := []payslip.PayslipItem{
+		{Component: payslip.ComponentAttendance, Description: fmt.Sprintf("Attendance (%d of %d working days)", p.PresentDays, p.WorkingDays), Amount: p.AttendanceAmount},
+	}
+	if p.OvertimeAmount > 0 {
+		items = append(items, payslip.PayslipItem{Component: payslip.ComponentOvertime, Description: "Overtime", Amount: p.OvertimeAmount})
+	}
+	if p.ReimbursementTotal > 0 {
+		items = append(items, payslip.PayslipItem{Component: payslip.ComponentReimbursement, Description: "Reimbursement", Amount: p.ReimbursementTotal})
+	}
+	return items
+}
+
+// TotalEarnings is the sum of the earnings lines
+func (d Data) TotalEarnings() int {
+	total := 0
+	for _, item := range d.Earnings() {
+		total += item.Amount
+	}
+	return total
+}
+
+// Deductions returns what is withheld from pay, nothing is withheld yet so templates can already
+// have the section and every payslip has the same layout
+func (d Data) Deductions() []payslip.PayslipItem {
+	return []payslip.PayslipItem{}
+}
+
+// TotalDeductions is the sum of the deductions lines
+func (d Data) TotalDeductions() int {
+	total := 0
+	for _, item := range d.Deductions() {
+		total += item.Amount
+	}
+	return total
+}
+
+// SampleData is a made up payslip to preview templates with when no real payslip is given
+func SampleData(company Company) Data {
+	return Data{
+		Company: company,
+		Payslip: payslip.Payslip{
+			ID:                 1,
+			BaseSalary:         6000000,
+			WorkingDays:        20,
+			PresentDays:        19,
+			AttendanceAmount:   5700000,
+			OvertimeHours:      6.5,
+			OvertimeAmount:     243750,
+			ReimbursementTotal: 350000,
+			TakeHomePay:        6293750,
+			Items: []payslip.PayslipItem{
+				{Component: payslip.ComponentAttendance, Description: "Attendance (19 of 20 working days)", Amount: 5700000},
+				{Component: payslip.ComponentOvertime, Description: "Overtime (6.5 hours)", Amount: 243750},
+				{Component: payslip.ComponentReimbursement, Category: "meal", Description: "Reimbursement - Meal", Amount: 350000},
+			},
+		},
+		Employee: usermodel.User{ID: 1, Username: "sample", FullName: "Sample Employee", Grade: "staff"},
+		Period: attendance.AttendancePeriod{
+			ID:        1,
+			StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		},
+		GeneratedAt: time.Now(),
+		Payments: []Payment{
+			{BankName: "BCA", AccountNumber: "1234567890", AccountHolder: "SAMPLE EMPLOYEE", Amount: 5293750, Primary: true},
+			{BankName: "Mandiri", AccountNumber: "1230004567890", AccountHolder: "SAMPLE EMPLOYEE", Amount: 1000000},
+		},
+	}
+}
+
+// FileName is the name of the payslip file, unique per employee and period
+func FileName(data Data) string {
+	return fmt.Sprintf("payslip-%s-%s-%d.pdf", data.Period.StartDate.Format("2006-01-02"), data.Employee.Username, data.Payslip.ID)
+}
+
+// FormatAmount formats an IDR amount with dots between thousands, e.g. 5.250.000
+func FormatAmount(amount int) string {
+	digits := strconv.Itoa(amount)
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	for i := len(digits) - 3; i > 0; i -= 3 {
+		digits = digits[:i] + "." + digits[i:]
+	}
+	return sign + digits
+}
+
+// FormatDate formats a date the way it is printed on payslips, e.g. 2 January 2006
+func FormatDate(date time.Time) string {
+	return date.Format("2 January 2006")
+}
+
+// FormatHours formats overtime hours without trailing zeros, e.g. 1.5
+func FormatHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', -1, 64)
+}
@@ -0,0 +1,147 @@
+package payslipdoc
+
+import (
+	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/payslip"
+	usermodel "payslip-generation-system/internal/entity/user"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmountInWords(t *testing.T) {
+	tests := []struct {
+		amount int
+		want   string
+	}{
+		{0, "Zero rupiah"},
+		{7, "Seven rupiah"},
+		{15, "Fifteen rupiah"},
+		{40, "Forty rupiah"},
+		{99, "Ninety-nine rupiah"},
+		{100, "One hundred rupiah"},
+		{1001, "One thousand one rupiah"},
+		{5250000, "Five million two hundred fifty thousand rupiah"},
+		{12000345, "Twelve million three hundred forty-five rupiah"},
+		{1000000000, "One billion rupiah"},
+		{-2500, "Minus two thousand five hundred rupiah"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, AmountInWords(tt.amount), "amount %d", tt.amount)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "0", FormatAmount(0))
+	assert.Equal(t, "999", FormatAmount(999))
+	assert.Equal(t, "1.000", FormatAmount(1000))
+	assert.Equal(t, "5.250.000", FormatAmount(5250000))
+	assert.Equal(t, "-120.500", FormatAmount(-120500))
+}
+
+func TestEarnings(t *testing.T) {
+	items := []payslip.PayslipItem{{Component: payslip.ComponentAttendance, Description: "Attendance (20 of 20 working days)", Amount: 5000000}}
+	assert.Equal(t, items, Data{Payslip: payslip.Payslip{Items: items}}.Earnings())
+
+	// payslips from before line items were kept are broken down from their totals
+	data := Data{Payslip: payslip.Payslip{PresentDays: 18, WorkingDays: 20, AttendanceAmount: 4500000, OvertimeAmount: 150000}}
+	got := data.Earnings()
+	assert.Len(t, got, 2)
+	assert.Equal(t, 4650000, data.TotalEarnings())
+	assert.Equal(t, "Attendance (18 of 20 working days)", got[0].Description)
+	assert.Equal(t, payslip.ComponentOvertime, got[1].Component)
+}
+
+func TestRenderPDF(t *testing.T) {
+	data := Data{
+		Company:  Company{Name: "PT Maju Jaya", Address: "Jl. Sudirman 1\nJakarta"},
+		Payslip:  payslip.Payslip{ID: 12, PresentDays: 20, WorkingDays: 20, AttendanceAmount: 5000000, TakeHomePay: 5000000},
+		Employee: usermodel.User{ID: 3, Username: "budi", FullName: "Budi Santoso", Grade: "staff"},
+		Period: attendance.AttendancePeriod{
+			StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	content, err := RenderPDF(data)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "%PDF-1.4"))
+	assert.Contains(t, string(content), "/Count 1")
+	assert.Equal(t, "payslip-2025-06-01-budi-12.pdf", FileName(data))
+
+	// a long breakdown continues on another page
+	for i := 0; i < 60; i++ {
+		data.Payslip.Items = append(data.Payslip.Items, payslip.PayslipItem{Description: "Reimbursement", Amount: 1000})
+	}
+	content, err = RenderPDF(data)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "/Count 2")
+}
+
+func TestPasswordRule(t *testing.T) {
+	birthDate := time.Date(1990, 8, 15, 0, 0, 0, 0, time.UTC)
+	employee := usermodel.User{Username: "budi", EmployeeNumber: "EMP00012", BirthDate: &birthDate}
+
+	tests := []struct {
+		rule    string
+		want    string
+		wantErr bool
+	}{
+		{"{birth_date}{employee_number}", "15081990EMP00012", false},
+		{"{birth_date:YYYYMMDD}-{username}", "19900815-budi", false},
+		{"pay{birth_date:DDMMYY}", "pay150890", false},
+		{"secret", "", true},
+		{"{birth_date", "", true},
+		{"{salary}", "", true},
+		{"{employee_number:upper}", "", true},
+	}
+	for _, tt := range tests {
+		rule, err := ParsePasswordRule(tt.rule)
+		if tt.wantErr {
+			assert.Error(t, err, "rule %s", tt.rule)
+			continue
+		}
+		assert.NoError(t, err, "rule %s", tt.rule)
+		assert.True(t, rule.Enabled())
+		got, err := rule.Password(employee)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, "rule %s", tt.rule)
+	}
+
+	rule, err := ParsePasswordRule("")
+	assert.NoError(t, err)
+	assert.False(t, rule.Enabled())
+
+	// employees without the details the rule needs can't get a protected payslip
+	rule, _ = ParsePasswordRule("{birth_date}{employee_number}")
+	_, err = rule.Password(usermodel.User{Username: "budi", EmployeeNumber: "EMP00012"})
+	assert.Error(t, err)
+}
+
+func TestTemplate_RenderPDF_Password(t *testing.T) {
+	tmpl, err := Parse(DefaultTemplate)
+	assert.NoError(t, err)
+	content, err := tmpl.RenderPDF(SampleData(Company{Name: "PT Maju Jaya"}), "15081990EMP00012")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "%PDF-1.6"))
+	assert.Contains(t, string(content), "/Encrypt")
+	assert.NotContains(t, string(content), "15081990EMP00012")
+}
+
+func TestTemplate_RenderHTML_Payments(t *testing.T) {
+	tmpl, err := Parse(DefaultTemplate)
+	assert.NoError(t, err)
+	content, err := tmpl.RenderHTML(SampleData(Company{Name: "PT Maju Jaya"}))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "BCA ******7890 a.n. SAMPLE EMPLOYEE (primary)")
+	assert.Contains(t, string(content), "Mandiri *********7890 a.n. SAMPLE EMPLOYEE")
+	assert.Contains(t, string(content), "1.000.000")
+
+	// employees without an account yet have no payments section
+	data := SampleData(Company{Name: "PT Maju Jaya"})
+	data.Payments = nil
+	content, err = tmpl.RenderHTML(data)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "Paid to")
+}
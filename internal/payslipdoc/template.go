@@ -0,0 +1,90 @@
+package payslipdoc
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"payslip-generation-system/internal/pdf"
+	"time"
+)
+
+// DefaultTemplate is the built-in payslip layout, used for employees without an active template
+//
+//go:embed templates/default.html
+var DefaultTemplate string
+
+// funcs are the helpers templates can use besides the methods of Data
+var funcs = template.FuncMap{
+	"amount": FormatAmount,
+	"words":  AmountInWords,
+	"date":   FormatDate,
+	"hours":  FormatHours,
+}
+
+// Template is a parsed payslip template, an html/template executed with Data
+type Template struct {
+	tmpl *template.Template
+}
+
+// Parse parses a payslip template, see pdf.FromHTML for the HTML the PDF renderer understands
+func Parse(content string) (*Template, error) {
+	tmpl, err := template.New("payslip").Funcs(funcs).Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{tmpl: tmpl}, nil
+}
+
+// Validate parses a template and renders the sample payslip with it so a template
+// that fails on real payslips is refused before it is stored
+func Validate(content string) error {
+	tmpl, err := Parse(content)
+	if err != nil {
+		return err
+	}
+	_, err = tmpl.RenderPDF(SampleData(Company{Name: "Sample Company", Address: "Sample Street 1"}), "")
+	return err
+}
+
+// RenderHTML executes the template with the payslip data
+func (t *Template) RenderHTML(data Data) ([]byte, error) {
+	if data.GeneratedAt.IsZero() {
+		data.GeneratedAt = time.Now()
+	}
+	var buf bytes.Buffer
+	err := t.tmpl.Execute(&buf, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render payslip template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderPDF renders the payslip to HTML and converts it into a PDF that needs the password to be opened,
+// an empty password leaves the PDF unprotected
+func (t *Template) RenderPDF(data Data, password string) ([]byte, error) {
+	content, err := t.RenderHTML(data)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := pdf.FromHTML(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if password != "" {
+		err = doc.Encrypt(password, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc.Bytes()
+}
+
+// RenderPDF renders an unprotected payslip with the built-in layout
+func RenderPDF(data Data) ([]byte, error) {
+	tmpl, err := Parse(DefaultTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.RenderPDF(data, "")
+}
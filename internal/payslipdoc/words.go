@@ -0,0 +1,60 @@
+package payslipdoc
+
+import "strings"
+
+var (
+	smallNumbers = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scales = []string{"", "thousand", "million", "billion", "trillion"}
+)
+
+// AmountInWords spells out an IDR amount, e.g. 5250000 is "Five million two hundred fifty thousand rupiah"
+func AmountInWords(amount int) string {
+	words := "zero"
+	if amount != 0 {
+		n := amount
+		if n < 0 {
+			n = -n
+		}
+		groups := []string{}
+		for scale := 0; n > 0; scale++ {
+			group := n % 1000
+			n /= 1000
+			if group == 0 {
+				continue
+			}
+			spelled := hundreds(group)
+			if scales[scale] != "" {
+				spelled += " " + scales[scale]
+			}
+			groups = append([]string{spelled}, groups...)
+		}
+		words = strings.Join(groups, " ")
+		if amount < 0 {
+			words = "minus " + words
+		}
+	}
+	return strings.ToUpper(words[:1]) + words[1:] + " rupiah"
+}
+
+// hundreds spells out a number from 1 to 999
+func hundreds(n int) string {
+	parts := []string{}
+	if n >= 100 {
+		parts = append(parts, smallNumbers[n/100]+" hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		parts = append(parts, smallNumbers[n])
+	case n%10 == 0:
+		parts = append(parts, tens[n/10])
+	default:
+		parts = append(parts, tens[n/10]+"-"+smallNumbers[n%10])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,211 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 page size in points, coordinates passed to a Page start at the top left corner
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document is a PDF made of A4 pages drawn with the standard Helvetica fonts,
+// which every PDF reader has so nothing needs to be embedded
+type Document struct {
+	pages      []*Page
+	encryption *encryption
+}
+
+// Page collects the drawing operators of one page
+type Page struct {
+	content bytes.Buffer
+}
+
+// New returns an empty document
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage appends a blank page and returns it for drawing
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text draws text with its baseline at y, characters outside of Latin-1 are drawn as ?
+func (p *Page) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		font, number(size), number(x), number(PageHeight-y), escape(text))
+}
+
+// TextRight draws text so it ends at x, for amounts in a column
+func (p *Page) TextRight(x, y float64, font Font, size float64, text string) {
+	p.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Line draws a black line of the given width
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n",
+		number(width), number(x1), number(PageHeight-y1), number(x2), number(PageHeight-y2))
+}
+
+// FillRect fills a rectangle with its top left corner at x, y in a gray from 0 (black) to 1 (white)
+func (p *Page) FillRect(x, y, width, height, gray float64) {
+	fmt.Fprintf(&p.content, "q %s g %s %s %s %s re f Q\n",
+		number(gray), number(x), number(PageHeight-y-height), number(width), number(height))
+}
+
+// WriteTo writes the document as a PDF 1.4 file, or a PDF 1.6 file when it is encrypted
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	objects := []string{}
+	add := func(object string) int {
+		objects = append(objects, object)
+		return len(objects)
+	}
+
+	// objects 1 and 2 are the catalog and the page tree, the fonts follow
+	add("<< /Type /Catalog /Pages 2 0 R >>")
+	add("")
+	fontRefs := []string{}
+	for _, font := range fonts {
+		id := add(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", font.baseFont))
+		fontRefs = append(fontRefs, fmt.Sprintf("/%s %d 0 R", font.name, id))
+	}
+	resources := fmt.Sprintf("<< /Font << %s >> >>", strings.Join(fontRefs, " "))
+
+	kids := []string{}
+	for _, page := range d.pages {
+		stream, err := compress(page.content.Bytes())
+		if err != nil {
+			return 0, err
+		}
+		if d.encryption != nil {
+			stream, err = d.encryption.encryptStream(len(objects)+1, stream)
+			if err != nil {
+				return 0, err
+			}
+		}
+		contentID := add(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", len(stream), stream))
+		pageID := add(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+			number(PageWidth), number(PageHeight), resources, contentID))
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageID))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	// AES encryption needs PDF 1.6, the trailer points to the encryption dictionary and has the file ID
+	// the keys were derived from
+	version, trailer := "1.4", ""
+	if d.encryption != nil {
+		encryptID := add(d.encryption.dictionary())
+		version = "1.6"
+		trailer = fmt.Sprintf(" /Encrypt %d 0 R /ID [<%x> <%x>]", encryptID, d.encryption.id, d.encryption.id)
+	}
+
+	var out bytes.Buffer
+	// the binary comment tells transfer tools the file is not plain text
+	fmt.Fprintf(&out, "%%PDF-%s\n%%\xe2\xe3\xcf\xd3\n", version)
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R%s >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, trailer, xref)
+
+	return out.WriteTo(w)
+}
+
+// Bytes returns the document as a PDF file
+func (d *Document) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := d.WriteTo(&buf)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func compress(content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, err := zw.Write(content)
+	if err != nil {
+		return nil, err
+	}
+	err = zw.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// number formats a coordinate with at most 2 decimals, PDF readers don't accept exponents
+func number(value float64) string {
+	s := fmt.Sprintf("%.2f", value)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// escape encodes text as a PDF string in WinAnsiEncoding
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		c := winAnsi(r)
+		switch c {
+		case '(', ')', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			if c < 32 || c > 126 {
+				fmt.Fprintf(&b, "\\%03o", c)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// winAnsi maps a rune to its WinAnsiEncoding byte, which matches Latin-1 apart from a few punctuation marks
+func winAnsi(r rune) byte {
+	switch r {
+	case '€':
+		return 0x80
+	case '–':
+		return 0x96
+	case '—':
+		return 0x97
+	case '‘':
+		return 0x91
+	case '’':
+		return 0x92
+	case '“':
+		return 0x93
+	case '”':
+		return 0x94
+	case '•':
+		return 0x95
+	}
+	if r == '\t' || r == '\n' || r == '\r' {
+		return ' '
+	}
+	if r < 32 || (r > 126 && r < 160) || r > 255 {
+		return '?'
+	}
+	return byte(r)
+}
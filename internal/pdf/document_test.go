@@ -0,0 +1,67 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument_WriteTo(t *testing.T) {
+	doc := New()
+	page := doc.AddPage()
+	page.Text(40, 60, FontBold, 18, "PT Maju (Jaya)")
+	page.TextRight(555, 60, FontRegular, 10, "Rp 5.000.000")
+	page.Line(40, 70, 555, 70, 0.5)
+	page.FillRect(40, 80, 515, 20, 0.9)
+	doc.AddPage().Text(40, 60, FontRegular, 10, "Café")
+
+	content, err := doc.Bytes()
+	assert.NoError(t, err)
+	file := string(content)
+
+	assert.True(t, strings.HasPrefix(file, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(file, "%%EOF\n"))
+	assert.Contains(t, file, "/Type /Pages /Kids [6 0 R 8 0 R] /Count 2")
+	assert.Contains(t, file, "/BaseFont /Helvetica-Bold")
+
+	// every xref entry points at the start of its object
+	xref := regexp.MustCompile(`startxref\n(\d+)`).FindStringSubmatch(file)
+	assert.Len(t, xref, 2)
+	xrefOffset, _ := strconv.Atoi(xref[1])
+	assert.True(t, strings.HasPrefix(file[xrefOffset:], "xref\n0 9\n"))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(file[xrefOffset:], -1)
+	assert.Len(t, entries, 8)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		assert.True(t, strings.HasPrefix(file[offset:], fmt.Sprintf("%d 0 obj\n", i+1)), "object %d", i+1)
+	}
+
+	streams := regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllStringSubmatch(file, -1)
+	assert.Len(t, streams, 2)
+	first := inflate(t, streams[0][1])
+	assert.Contains(t, first, `BT /F2 18 Tf 40 781.89 Td (PT Maju \(Jaya\)) Tj ET`)
+	assert.Contains(t, first, "0.5 w 40 771.89 m 555 771.89 l S")
+	assert.Contains(t, first, "q 0.9 g 40 741.89 515 20 re f Q")
+	assert.Contains(t, inflate(t, streams[1][1]), `(Caf\351)`)
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.Equal(t, 5.56, TextWidth(FontRegular, 10, "0"))
+	assert.Equal(t, 23.9, TextWidth(FontRegular, 10, "Rp 0 "))
+	assert.Greater(t, TextWidth(FontBold, 10, "Take home pay"), TextWidth(FontRegular, 10, "Take home pay"))
+}
+
+func inflate(t *testing.T, stream string) string {
+	zr, err := zlib.NewReader(bytes.NewReader([]byte(stream)))
+	assert.NoError(t, err)
+	content, err := io.ReadAll(zr)
+	assert.NoError(t, err)
+	return string(content)
+}
@@ -0,0 +1,161 @@
+package pdf
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rc4"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// passwordPadding pads passwords to 32 bytes, as defined by the standard security handler
+var passwordPadding = []byte{
+	0x28, 0xbf, 0x4e, 0x5e, 0x4e, 0x75, 0x8a, 0x41, 0x64, 0x00, 0x4e, 0x56, 0xff, 0xfa, 0x01, 0x08,
+	0x2e, 0x2e, 0x00, 0xb6, 0xd0, 0x68, 0x3e, 0x80, 0x2f, 0x0c, 0xa9, 0xfe, 0x64, 0x53, 0x69, 0x7a,
+}
+
+// permissions allows printing and text extraction for accessibility, not changing or copying the document
+const permissions = int32(-4096) | 0xc0 | 1<<2 | 1<<9 | 1<<11
+
+// encryption holds what the standard security handler (revision 4, AES-128) needs to encrypt a document
+type encryption struct {
+	key   []byte
+	owner []byte
+	user  []byte
+	id    []byte
+}
+
+// Encrypt protects the document with a password that is needed to open it. The owner password, which lifts
+// the restrictions on copying and changing the document, is random when empty so nobody can lift them
+func (d *Document) Encrypt(userPassword, ownerPassword string) error {
+	if ownerPassword == "" {
+		random := make([]byte, 16)
+		_, err := rand.Read(random)
+		if err != nil {
+			return err
+		}
+		ownerPassword = hex.EncodeToString(random)
+	}
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return err
+	}
+
+	e := &encryption{id: id}
+	e.owner, err = ownerKey(padPassword(userPassword), padPassword(ownerPassword))
+	if err != nil {
+		return err
+	}
+	e.key = fileKey(padPassword(userPassword), e.owner, id)
+	e.user, err = userKey(e.key, id)
+	if err != nil {
+		return err
+	}
+	d.encryption = e
+	return nil
+}
+
+// dictionary is the /Encrypt dictionary of the trailer
+func (e *encryption) dictionary() string {
+	return fmt.Sprintf("<< /Filter /Standard /V 4 /R 4 /Length 128 "+
+		"/CF << /StdCF << /Type /CryptFilter /CFM /AESV2 /AuthEvent /DocOpen /Length 16 >> >> /StmF /StdCF /StrF /StdCF "+
+		"/O <%x> /U <%x> /P %d >>", e.owner, e.user, permissions)
+}
+
+// encryptStream encrypts the stream of an object with AES-128 in CBC mode, the random IV comes first
+func (e *encryption) encryptStream(objectID int, data []byte) ([]byte, error) {
+	// the key of each object is derived from the file key, the object number and "sAlT" for AES
+	objectKey := make([]byte, 0, len(e.key)+9)
+	objectKey = append(objectKey, e.key...)
+	objectKey = append(objectKey, byte(objectID), byte(objectID>>8), byte(objectID>>16), 0, 0)
+	objectKey = append(objectKey, "sAlT"...)
+	sum := md5.Sum(objectKey)
+
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	padding := aes.BlockSize - len(data)%aes.BlockSize
+	out := make([]byte, aes.BlockSize+len(data)+padding)
+	_, err = rand.Read(out[:aes.BlockSize])
+	if err != nil {
+		return nil, err
+	}
+	copy(out[aes.BlockSize:], data)
+	for i := len(out) - padding; i < len(out); i++ {
+		out[i] = byte(padding)
+	}
+	cipher.NewCBCEncrypter(block, out[:aes.BlockSize]).CryptBlocks(out[aes.BlockSize:], out[aes.BlockSize:])
+	return out, nil
+}
+
+func padPassword(password string) []byte {
+	padded := make([]byte, 0, 32)
+	for _, r := range password {
+		if len(padded) == 32 {
+			break
+		}
+		padded = append(padded, winAnsi(r))
+	}
+	return append(padded, passwordPadding[:32-len(padded)]...)
+}
+
+// ownerKey computes the /O entry, the padded user password encrypted with a key from the owner password
+func ownerKey(user, owner []byte) ([]byte, error) {
+	sum := md5.Sum(owner)
+	for i := 0; i < 50; i++ {
+		sum = md5.Sum(sum[:])
+	}
+	return rc4Rounds(sum[:], user)
+}
+
+// fileKey computes the key the whole document is encrypted with from the user password
+func fileKey(user, owner, id []byte) []byte {
+	h := md5.New()
+	h.Write(user)
+	h.Write(owner)
+	p := make([]byte, 4)
+	binary.LittleEndian.PutUint32(p, uint32(int64(permissions)&0xffffffff))
+	h.Write(p)
+	h.Write(id)
+	key := h.Sum(nil)
+	for i := 0; i < 50; i++ {
+		sum := md5.Sum(key)
+		key = sum[:]
+	}
+	return key
+}
+
+// userKey computes the /U entry readers check the password against
+func userKey(key, id []byte) ([]byte, error) {
+	h := md5.New()
+	h.Write(passwordPadding)
+	h.Write(id)
+	hashed, err := rc4Rounds(key, h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	// only the first 16 bytes are compared, the rest is padding
+	return append(hashed, make([]byte, 16)...), nil
+}
+
+// rc4Rounds encrypts data 20 times, each time with the key XORed with the round number
+func rc4Rounds(key, data []byte) ([]byte, error) {
+	out := append([]byte{}, data...)
+	roundKey := make([]byte, len(key))
+	for round := 0; round < 20; round++ {
+		for i := range key {
+			roundKey[i] = key[i] ^ byte(round)
+		}
+		c, err := rc4.NewCipher(roundKey)
+		if err != nil {
+			return nil, err
+		}
+		c.XORKeyStream(out, out)
+	}
+	return out, nil
+}
@@ -0,0 +1,71 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument_Encrypt(t *testing.T) {
+	doc := New()
+	doc.AddPage().Text(40, 60, FontRegular, 10, "Take home pay 5.000.000")
+	err := doc.Encrypt("15081990EMP00012", "")
+	assert.NoError(t, err)
+
+	content, err := doc.Bytes()
+	assert.NoError(t, err)
+	file := string(content)
+	assert.True(t, strings.HasPrefix(file, "%PDF-1.6\n"))
+	assert.Contains(t, file, "/Filter /Standard /V 4 /R 4")
+	assert.Contains(t, file, "/Encrypt 7 0 R")
+	assert.NotContains(t, file, "15081990EMP00012")
+
+	// a reader derives the key from the password and checks it against /U
+	decode := func(pattern string) []byte {
+		match := regexp.MustCompile(pattern).FindStringSubmatch(file)
+		assert.Len(t, match, 2)
+		value, err := hex.DecodeString(match[1])
+		assert.NoError(t, err)
+		return value
+	}
+	owner, user, id := decode(`/O <([0-9a-f]+)>`), decode(`/U <([0-9a-f]+)>`), decode(`/ID \[<([0-9a-f]+)>`)
+	authenticate := func(password string) []byte {
+		key := fileKey(padPassword(password), owner, id)
+		expected, err := userKey(key, id)
+		assert.NoError(t, err)
+		if !bytes.Equal(expected[:16], user[:16]) {
+			return nil
+		}
+		return key
+	}
+	assert.Nil(t, authenticate("wrong"))
+	key := authenticate("15081990EMP00012")
+	assert.NotNil(t, key)
+
+	// the page content is object 5, encrypted with its own AES key and then inflated
+	stream := regexp.MustCompile(`(?s)5 0 obj\n<< /Length \d+ /Filter /FlateDecode >>\nstream\n(.*?)\nendstream`).FindStringSubmatch(file)
+	assert.Len(t, stream, 2)
+	encrypted := []byte(stream[1])
+	assert.Zero(t, len(encrypted)%aes.BlockSize)
+	objectKey := md5.Sum(append(append(append([]byte{}, key...), 5, 0, 0, 0, 0), "sAlT"...))
+	block, err := aes.NewCipher(objectKey[:])
+	assert.NoError(t, err)
+	decrypted := make([]byte, len(encrypted)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, encrypted[:aes.BlockSize]).CryptBlocks(decrypted, encrypted[aes.BlockSize:])
+	decrypted = decrypted[:len(decrypted)-int(decrypted[len(decrypted)-1])]
+
+	zr, err := zlib.NewReader(bytes.NewReader(decrypted))
+	assert.NoError(t, err)
+	plain, err := io.ReadAll(zr)
+	assert.NoError(t, err)
+	assert.Contains(t, string(plain), "(Take home pay 5.000.000) Tj")
+}
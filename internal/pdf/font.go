@@ -0,0 +1,62 @@
+package pdf
+
+// Font is the resource name of one of the standard fonts on a page
+type Font string
+
+const (
+	FontRegular Font = "F1"
+	FontBold    Font = "F2"
+)
+
+type fontInfo struct {
+	name     Font
+	baseFont string
+	// widths of the printable ASCII characters from space to ~ in 1/1000 of the font size
+	widths [95]int
+}
+
+var fonts = []fontInfo{
+	{
+		name:     FontRegular,
+		baseFont: "Helvetica",
+		widths: [95]int{
+			278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+			556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+			1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+			667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+			333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+			556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+		},
+	},
+	{
+		name:     FontBold,
+		baseFont: "Helvetica-Bold",
+		widths: [95]int{
+			278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+			556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+			975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+			667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+			333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+			611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+		},
+	},
+}
+
+// TextWidth is the width of text in points, characters outside of ASCII are counted as wide as a digit
+func TextWidth(font Font, size float64, text string) float64 {
+	info := fonts[0]
+	for _, f := range fonts {
+		if f.name == font {
+			info = f
+		}
+	}
+	total := 0
+	for _, r := range text {
+		if r >= 32 && r <= 126 {
+			total += info.widths[r-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
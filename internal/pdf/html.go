@@ -0,0 +1,602 @@
+package pdf
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+// FromHTML lays out an HTML document on A4 pages. It supports the subset generated documents need:
+// h1 to h3, p, div, br, hr, b/strong, small and tables with thead, tbody, tfoot, th and td.
+// Cells take width="NN%" and colspan, and text is aligned with align="right" or style="text-align: right".
+// Rows and cells are shaded with bgcolor or a background color in style, th cells are light gray by default.
+// A table with border="1" gets a line under every row, tfoot rows are bold with a line above them.
+// Anything else, such as stylesheets, images and fonts, is ignored.
+func FromHTML(r io.Reader) (*Document, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := buildBlocks(root, textStyle{font: FontRegular, size: 10, align: alignLeft})
+	doc := New()
+	l := &layout{doc: doc, page: doc.AddPage(), y: htmlMargin}
+	for _, b := range blocks {
+		// tables break across pages between their rows
+		if t, ok := b.(*tableBlock); ok {
+			for _, row := range t.rows {
+				l.place(&rowBlock{table: t, row: row})
+			}
+			l.y += t.spaceAfter
+			continue
+		}
+		l.place(b)
+	}
+	return doc, nil
+}
+
+const (
+	htmlMargin   = 40.0
+	lineSpacing  = 1.35
+	cellPaddingX = 5.0
+	cellPaddingY = 3.0
+	alignLeft    = "left"
+	alignRight   = "right"
+	alignCenter  = "center"
+)
+
+type layout struct {
+	doc  *Document
+	page *Page
+	y    float64
+}
+
+// place draws a block below the previous one, on a new page when it doesn't fit on this one
+func (l *layout) place(b block) {
+	width := PageWidth - 2*htmlMargin
+	height := b.measure(width)
+	if height == 0 {
+		return
+	}
+	if l.y+height > PageHeight-htmlMargin && l.y > htmlMargin {
+		l.page = l.doc.AddPage()
+		l.y = htmlMargin
+	}
+	b.draw(l.page, htmlMargin, l.y, width)
+	l.y += height
+}
+
+type block interface {
+	// measure is the height the block takes at the width, including its spacing
+	measure(width float64) float64
+	// draw draws the block with its top left corner at x, y
+	draw(page *Page, x, y, width float64)
+}
+
+type textStyle struct {
+	font  Font
+	size  float64
+	align string
+}
+
+// run is a piece of inline text in one font, a run of "\n" is a line break
+type run struct {
+	text string
+	font Font
+	size float64
+}
+
+// textBlock is a paragraph of inline text wrapped to the width it is drawn in
+type textBlock struct {
+	runs        []run
+	align       string
+	spaceBefore float64
+	spaceAfter  float64
+}
+
+type word struct {
+	text  string
+	font  Font
+	size  float64
+	space bool
+	width float64
+}
+
+type line struct {
+	words []word
+	width float64
+	size  float64
+}
+
+func (b *textBlock) lines(width float64) []line {
+	words := []word{}
+	pendingSpace := false
+	lineBreak := word{text: "\n"}
+	for _, r := range b.runs {
+		if r.text == "\n" {
+			words = append(words, lineBreak)
+			pendingSpace = false
+			continue
+		}
+		current := []rune{}
+		flush := func() {
+			if len(current) == 0 {
+				return
+			}
+			text := string(current)
+			words = append(words, word{text: text, font: r.font, size: r.size, space: pendingSpace, width: TextWidth(r.font, r.size, text)})
+			current = current[:0]
+			pendingSpace = false
+		}
+		for _, c := range r.text {
+			if unicode.IsSpace(c) {
+				flush()
+				pendingSpace = true
+				continue
+			}
+			current = append(current, c)
+		}
+		flush()
+	}
+
+	lines := []line{}
+	current := line{}
+	for _, w := range words {
+		if w.text == "\n" {
+			lines = append(lines, current)
+			current = line{}
+			continue
+		}
+		gap := 0.0
+		if w.space && len(current.words) > 0 {
+			gap = TextWidth(w.font, w.size, " ")
+		}
+		// words glued to the previous one, like a colon after bold text, stay on its line
+		if w.space && len(current.words) > 0 && current.width+gap+w.width > width {
+			lines = append(lines, current)
+			current = line{}
+			gap = 0
+		}
+		if len(current.words) == 0 {
+			w.space = false
+		}
+		current.words = append(current.words, w)
+		current.width += gap + w.width
+		current.size = max(current.size, w.size)
+	}
+	if len(current.words) > 0 {
+		lines = append(lines, current)
+	}
+
+	// a line break at the end doesn't add an empty line, empty lines between breaks keep their height
+	for i := range lines {
+		if lines[i].size == 0 {
+			lines[i].size = b.fallbackSize()
+		}
+	}
+	return lines
+}
+
+func (b *textBlock) fallbackSize() float64 {
+	for _, r := range b.runs {
+		if r.size > 0 {
+			return r.size
+		}
+	}
+	return 10
+}
+
+func (b *textBlock) isEmpty() bool {
+	for _, r := range b.runs {
+		if strings.TrimSpace(r.text) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *textBlock) measure(width float64) float64 {
+	height := b.spaceBefore + b.spaceAfter
+	for _, l := range b.lines(width) {
+		height += l.size * lineSpacing
+	}
+	return height
+}
+
+func (b *textBlock) draw(page *Page, x, y, width float64) {
+	y += b.spaceBefore
+	for _, l := range b.lines(width) {
+		lineX := x
+		switch b.align {
+		case alignRight:
+			lineX = x + width - l.width
+		case alignCenter:
+			lineX = x + (width-l.width)/2
+		}
+		baseline := y + l.size
+		for _, w := range l.words {
+			if w.space {
+				lineX += TextWidth(w.font, w.size, " ")
+			}
+			page.Text(lineX, baseline, w.font, w.size, w.text)
+			lineX += w.width
+		}
+		y += l.size * lineSpacing
+	}
+}
+
+// ruleBlock is a horizontal line across the width
+type ruleBlock struct{}
+
+func (b *ruleBlock) measure(width float64) float64 {
+	return 10
+}
+
+func (b *ruleBlock) draw(page *Page, x, y, width float64) {
+	page.Line(x, y+5, x+width, y+5, 0.75)
+}
+
+// stackBlock draws blocks below each other, it is the content of a table cell
+type stackBlock struct {
+	blocks []block
+}
+
+func (b *stackBlock) measure(width float64) float64 {
+	height := 0.0
+	for _, child := range b.blocks {
+		height += child.measure(width)
+	}
+	return height
+}
+
+func (b *stackBlock) draw(page *Page, x, y, width float64) {
+	for _, child := range b.blocks {
+		child.draw(page, x, y, width)
+		y += child.measure(width)
+	}
+}
+
+type tableCell struct {
+	content  stackBlock
+	colspan  int
+	widthPct float64
+	shade    float64
+}
+
+type tableRow struct {
+	cells  []tableCell
+	footer bool
+	shade  float64
+}
+
+type tableBlock struct {
+	rows       []*tableRow
+	border     bool
+	spaceAfter float64
+}
+
+// columns returns the width of every column, columns without a width share what the others leave
+func (t *tableBlock) columns(width float64) []float64 {
+	count := 0
+	for _, row := range t.rows {
+		n := 0
+		for _, cell := range row.cells {
+			n += cell.colspan
+		}
+		count = max(count, n)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	widths := make([]float64, count)
+	if len(t.rows) > 0 {
+		col := 0
+		for _, cell := range t.rows[0].cells {
+			if cell.colspan == 1 && cell.widthPct > 0 && col < count {
+				widths[col] = width * cell.widthPct / 100
+			}
+			col += cell.colspan
+		}
+	}
+	used, free := 0.0, 0
+	for _, w := range widths {
+		if w == 0 {
+			free++
+		}
+		used += w
+	}
+	for i := range widths {
+		if widths[i] == 0 {
+			widths[i] = max(width-used, 0) / float64(free)
+		}
+	}
+	return widths
+}
+
+func (t *tableBlock) rowHeight(row *tableRow, widths []float64) float64 {
+	height := 0.0
+	col := 0
+	for _, cell := range row.cells {
+		cellWidth := spanWidth(widths, col, cell.colspan)
+		height = max(height, cell.content.measure(cellWidth-2*cellPaddingX))
+		col += cell.colspan
+	}
+	return height + 2*cellPaddingY
+}
+
+func (t *tableBlock) drawRow(page *Page, row *tableRow, widths []float64, x, y, width float64) {
+	height := t.rowHeight(row, widths)
+	if isShaded(row.shade) {
+		page.FillRect(x, y, width, height, row.shade)
+	}
+	col := 0
+	cellX := x
+	for _, cell := range row.cells {
+		cellWidth := spanWidth(widths, col, cell.colspan)
+		if isShaded(cell.shade) {
+			page.FillRect(cellX, y, cellWidth, height, cell.shade)
+		}
+		cell.content.draw(page, cellX+cellPaddingX, y+cellPaddingY, cellWidth-2*cellPaddingX)
+		cellX += cellWidth
+		col += cell.colspan
+	}
+	if row.footer {
+		page.Line(x, y, x+width, y, 0.75)
+	}
+	if t.border {
+		page.Line(x, y+height, x+width, y+height, 0.25)
+	}
+}
+
+func (t *tableBlock) measure(width float64) float64 {
+	widths := t.columns(width)
+	height := t.spaceAfter
+	for _, row := range t.rows {
+		height += t.rowHeight(row, widths)
+	}
+	return height
+}
+
+func (t *tableBlock) draw(page *Page, x, y, width float64) {
+	widths := t.columns(width)
+	for _, row := range t.rows {
+		t.drawRow(page, row, widths, x, y, width)
+		y += t.rowHeight(row, widths)
+	}
+}
+
+// rowBlock is one row of a top level table, placed on its own so tables can continue on the next page
+type rowBlock struct {
+	table *tableBlock
+	row   *tableRow
+}
+
+func (b *rowBlock) measure(width float64) float64 {
+	return b.table.rowHeight(b.row, b.table.columns(width))
+}
+
+func (b *rowBlock) draw(page *Page, x, y, width float64) {
+	b.table.drawRow(page, b.row, b.table.columns(width), x, y, width)
+}
+
+// isShaded reports whether a gray needs filling, 0 is no background and white is the page itself
+func isShaded(gray float64) bool {
+	return gray > 0 && gray < 1
+}
+
+func spanWidth(widths []float64, col, colspan int) float64 {
+	total := 0.0
+	for i := col; i < col+colspan && i < len(widths); i++ {
+		total += widths[i]
+	}
+	return total
+}
+
+// headings and paragraphs: font size, bold, space before and after
+var blockStyles = map[string]struct {
+	size        float64
+	bold        bool
+	spaceBefore float64
+	spaceAfter  float64
+}{
+	"h1": {16, true, 0, 4},
+	"h2": {13, true, 6, 4},
+	"h3": {11, true, 4, 2},
+	"p":  {0, false, 0, 6},
+}
+
+// buildBlocks turns the children of n into blocks, inline content between block elements becomes a paragraph
+func buildBlocks(n *html.Node, st textStyle) []block {
+	blocks := []block{}
+	inline := []run{}
+	flush := func() {
+		paragraph := &textBlock{runs: inline, align: st.align}
+		if !paragraph.isEmpty() {
+			blocks = append(blocks, paragraph)
+		}
+		inline = []run{}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch c.Type {
+		case html.TextNode:
+			inline = append(inline, run{text: c.Data, font: st.font, size: st.size})
+		case html.ElementNode:
+			switch c.Data {
+			case "head", "style", "script", "title", "img":
+			case "br", "b", "strong", "small", "span", "em", "i", "u", "a", "font", "big":
+				inline = append(inline, inlineRuns(c, st)...)
+			case "hr":
+				flush()
+				blocks = append(blocks, &ruleBlock{})
+			case "table":
+				flush()
+				blocks = append(blocks, buildTable(c, st))
+			case "h1", "h2", "h3", "p":
+				flush()
+				bs := blockStyles[c.Data]
+				childStyle := textStyle{font: st.font, size: st.size, align: alignOf(c, st.align)}
+				if bs.size > 0 {
+					childStyle.size = bs.size
+				}
+				if bs.bold {
+					childStyle.font = FontBold
+				}
+				paragraph := &textBlock{
+					runs:        inlineRuns(c, childStyle),
+					align:       childStyle.align,
+					spaceBefore: bs.spaceBefore,
+					spaceAfter:  bs.spaceAfter,
+				}
+				if !paragraph.isEmpty() {
+					blocks = append(blocks, paragraph)
+				}
+			default:
+				flush()
+				childStyle := st
+				childStyle.align = alignOf(c, st.align)
+				blocks = append(blocks, buildBlocks(c, childStyle)...)
+			}
+		}
+	}
+	flush()
+	return blocks
+}
+
+func inlineRuns(n *html.Node, st textStyle) []run {
+	switch n.Data {
+	case "br":
+		return []run{{text: "\n", size: st.size}}
+	case "b", "strong":
+		st.font = FontBold
+	case "small":
+		st.size *= 0.85
+	case "big":
+		st.size *= 1.2
+	}
+	runs := []run{}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch c.Type {
+		case html.TextNode:
+			runs = append(runs, run{text: c.Data, font: st.font, size: st.size})
+		case html.ElementNode:
+			runs = append(runs, inlineRuns(c, st)...)
+		}
+	}
+	return runs
+}
+
+func buildTable(n *html.Node, st textStyle) *tableBlock {
+	border := attr(n, "border")
+	t := &tableBlock{border: border != "" && border != "0", spaceAfter: 8}
+
+	var addRows func(parent *html.Node, footer bool)
+	addRows = func(parent *html.Node, footer bool) {
+		for c := parent.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			switch c.Data {
+			case "thead", "tbody":
+				addRows(c, false)
+			case "tfoot":
+				addRows(c, true)
+			case "tr":
+				t.rows = append(t.rows, buildRow(c, st, footer))
+			}
+		}
+	}
+	addRows(n, false)
+	return t
+}
+
+func buildRow(tr *html.Node, st textStyle, footer bool) *tableRow {
+	row := &tableRow{footer: footer, shade: shadeOf(tr, 0)}
+	rowAlign := alignOf(tr, st.align)
+	for c := tr.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || (c.Data != "td" && c.Data != "th") {
+			continue
+		}
+		cellStyle := textStyle{font: st.font, size: st.size, align: alignOf(c, rowAlign)}
+		defaultShade := 0.0
+		if c.Data == "th" || footer {
+			cellStyle.font = FontBold
+		}
+		if c.Data == "th" {
+			defaultShade = 0.9
+		}
+
+		colspan, err := strconv.Atoi(attr(c, "colspan"))
+		if err != nil || colspan < 1 {
+			colspan = 1
+		}
+		widthPct, _ := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(attr(c, "width")), "%"), 64)
+		row.cells = append(row.cells, tableCell{
+			content:  stackBlock{blocks: buildBlocks(c, cellStyle)},
+			colspan:  colspan,
+			widthPct: widthPct,
+			shade:    shadeOf(c, defaultShade),
+		})
+	}
+	return row
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// styleValue returns a property of the style attribute, e.g. text-align of style="text-align: right"
+func styleValue(n *html.Node, property string) string {
+	for _, declaration := range strings.Split(attr(n, "style"), ";") {
+		name, value, ok := strings.Cut(declaration, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(name), property) {
+			return strings.ToLower(strings.TrimSpace(value))
+		}
+	}
+	return ""
+}
+
+func alignOf(n *html.Node, fallback string) string {
+	for _, value := range []string{strings.ToLower(attr(n, "align")), styleValue(n, "text-align")} {
+		switch value {
+		case alignLeft, alignRight, alignCenter:
+			return value
+		}
+	}
+	return fallback
+}
+
+// shadeOf is the gray of the bgcolor or background color of an element, colors are turned into their lightness
+func shadeOf(n *html.Node, fallback float64) float64 {
+	for _, value := range []string{attr(n, "bgcolor"), styleValue(n, "background-color"), styleValue(n, "background")} {
+		if gray, ok := parseGray(value); ok {
+			return gray
+		}
+	}
+	return fallback
+}
+
+func parseGray(color string) (float64, bool) {
+	color = strings.TrimPrefix(strings.TrimSpace(color), "#")
+	if len(color) == 3 {
+		color = string([]byte{color[0], color[0], color[1], color[1], color[2], color[2]})
+	}
+	if len(color) != 6 {
+		return 0, false
+	}
+	rgb, err := strconv.ParseUint(color, 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	r, g, b := float64(rgb>>16&0xff), float64(rgb>>8&0xff), float64(rgb&0xff)
+	return (0.299*r + 0.587*g + 0.114*b) / 255, true
+}
@@ -0,0 +1,64 @@
+package pdf
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromHTML(t *testing.T) {
+	doc, err := FromHTML(strings.NewReader(`
+		<html><head><title>ignored</title><style>h1 { color: red }</style></head>
+		<body>
+			<h1>PT Maju Jaya</h1>
+			<p align="right">No. <b>12</b></p>
+			<hr>
+			<table border="1">
+				<thead><tr><th width="70%">Earnings</th><th style="text-align: right">Amount</th></tr></thead>
+				<tbody><tr bgcolor="#eeeeee"><td>Attendance</td><td align="right">5.000.000</td></tr></tbody>
+				<tfoot><tr><td colspan="2">Total</td></tr></tfoot>
+			</table>
+		</body></html>`))
+	assert.NoError(t, err)
+	assert.Len(t, doc.pages, 1)
+	content := doc.pages[0].content.String()
+
+	assert.NotContains(t, content, "ignored")
+	assert.NotContains(t, content, "color")
+	assert.Contains(t, content, "BT /F2 16 Tf 40 785.89 Td (PT) Tj ET")
+	// right aligned text ends at the right margin
+	noWidth := TextWidth(FontRegular, 10, "No.")
+	boldWidth := TextWidth(FontBold, 10, "12")
+	space := TextWidth(FontBold, 10, " ")
+	assert.Contains(t, content, "Td (No.) Tj ET")
+	assert.Contains(t, content, "/F2 10 Tf "+number(PageWidth-htmlMargin-boldWidth)+" ")
+	assert.Equal(t, number(PageWidth-htmlMargin-boldWidth-space-noWidth), regexp.MustCompile(`/F1 10 Tf ([\d.]+) [\d.]+ Td \(No\.\)`).FindStringSubmatch(content)[1])
+	// th cells are shaded and bold, rows take their bgcolor
+	assert.Contains(t, content, "q 0.9 g 40 ")
+	assert.Contains(t, content, "q 0.93 g 40 ")
+	assert.Contains(t, content, "(Earnings) Tj")
+	assert.Regexp(t, `/F2 10 Tf [\d.]+ [\d.]+ Td \(Total\) Tj`, content)
+	// the first column takes 70% of the width
+	assert.Contains(t, content, "q 0.9 g 40 727.29 "+number((PageWidth-2*htmlMargin)*0.7)+" 19.5 re f Q")
+}
+
+func TestFromHTML_PageBreak(t *testing.T) {
+	rows := strings.Repeat("<tr><td>Reimbursement</td><td align=\"right\">1.000</td></tr>", 50)
+	doc, err := FromHTML(strings.NewReader("<h1>Title</h1><table>" + rows + "</table><p>After</p>"))
+	assert.NoError(t, err)
+	assert.Len(t, doc.pages, 2)
+	// the table continues on the second page and the paragraph follows it
+	assert.Contains(t, doc.pages[1].content.String(), "(Reimbursement)")
+	assert.Contains(t, doc.pages[1].content.String(), "(After)")
+}
+
+func TestFromHTML_Wrap(t *testing.T) {
+	long := strings.Repeat("word ", 200)
+	doc, err := FromHTML(strings.NewReader("<p>" + long + "</p>"))
+	assert.NoError(t, err)
+	lines := regexp.MustCompile(`BT /F1 10 Tf 40 [\d.]+ Td \(word\)`).FindAllString(doc.pages[0].content.String(), -1)
+	// every wrapped line starts at the left margin again
+	assert.Greater(t, len(lines), 5)
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	attendance "payslip-generation-system/internal/entity/attendance"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	reflect "reflect"
 	time "time"
 
@@ -36,6 +37,20 @@ func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
 	return m.recorder
 }
 
+// DeleteAttendance mocks base method.
+func (m *MockdbRepoProvider) DeleteAttendance(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendance", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendance indicates an expected call of DeleteAttendance.
+func (mr *MockdbRepoProviderMockRecorder) DeleteAttendance(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendance", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteAttendance), ctx, id)
+}
+
 // GetAttendance mocks base method.
 func (m *MockdbRepoProvider) GetAttendance(ctx context.Context, userID, periodID int, date time.Time) (attendance.Attendance, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,51 @@ func (mr *MockdbRepoProviderMockRecorder) GetAttendance(ctx, userID, periodID, d
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttendance), ctx, userID, periodID, date)
 }
 
+// GetAttendanceByID mocks base method.
+func (m *MockdbRepoProvider) GetAttendanceByID(ctx context.Context, id int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceByID", ctx, id)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceByID indicates an expected call of GetAttendanceByID.
+func (mr *MockdbRepoProviderMockRecorder) GetAttendanceByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttendanceByID), ctx, id)
+}
+
+// GetAttendanceCorrectionByID mocks base method.
+func (m *MockdbRepoProvider) GetAttendanceCorrectionByID(ctx context.Context, id int) (attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceCorrectionByID", ctx, id)
+	ret0, _ := ret[0].(attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceCorrectionByID indicates an expected call of GetAttendanceCorrectionByID.
+func (mr *MockdbRepoProviderMockRecorder) GetAttendanceCorrectionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceCorrectionByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttendanceCorrectionByID), ctx, id)
+}
+
+// GetAttendanceCorrectionsByStatus mocks base method.
+func (m *MockdbRepoProvider) GetAttendanceCorrectionsByStatus(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceCorrectionsByStatus", ctx, status)
+	ret0, _ := ret[0].([]attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceCorrectionsByStatus indicates an expected call of GetAttendanceCorrectionsByStatus.
+func (mr *MockdbRepoProviderMockRecorder) GetAttendanceCorrectionsByStatus(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceCorrectionsByStatus", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttendanceCorrectionsByStatus), ctx, status)
+}
+
 // GetAttendancePeriodByID mocks base method.
 func (m *MockdbRepoProvider) GetAttendancePeriodByID(ctx context.Context, id int) (attendance.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
@@ -66,19 +126,65 @@ func (mr *MockdbRepoProviderMockRecorder) GetAttendancePeriodByID(ctx, id interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancePeriodByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttendancePeriodByID), ctx, id)
 }
 
+// GetAttendancesByUserID mocks base method.
+func (m *MockdbRepoProvider) GetAttendancesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendancesByUserID", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]attendance.Attendance)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttendancesByUserID indicates an expected call of GetAttendancesByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetAttendancesByUserID(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancesByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttendancesByUserID), ctx, userID, periodID, page)
+}
+
 // GetEmployeeAttendanceSummary mocks base method.
-func (m *MockdbRepoProvider) GetEmployeeAttendanceSummary(ctx context.Context, periodID int) ([]attendance.EmployeeAttendanceSummary, error) {
+func (m *MockdbRepoProvider) GetEmployeeAttendanceSummary(ctx context.Context, periodID, minWorkedMinutes int) ([]attendance.EmployeeAttendanceSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEmployeeAttendanceSummary", ctx, periodID)
+	ret := m.ctrl.Call(m, "GetEmployeeAttendanceSummary", ctx, periodID, minWorkedMinutes)
 	ret0, _ := ret[0].([]attendance.EmployeeAttendanceSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEmployeeAttendanceSummary indicates an expected call of GetEmployeeAttendanceSummary.
-func (mr *MockdbRepoProviderMockRecorder) GetEmployeeAttendanceSummary(ctx, periodID interface{}) *gomock.Call {
+func (mr *MockdbRepoProviderMockRecorder) GetEmployeeAttendanceSummary(ctx, periodID, minWorkedMinutes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeAttendanceSummary", reflect.TypeOf((*MockdbRepoProvider)(nil).GetEmployeeAttendanceSummary), ctx, periodID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeAttendanceSummary", reflect.TypeOf((*MockdbRepoProvider)(nil).GetEmployeeAttendanceSummary), ctx, periodID, minWorkedMinutes)
+}
+
+// GetOpenAttendance mocks base method.
+func (m *MockdbRepoProvider) GetOpenAttendance(ctx context.Context, userID, periodID int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenAttendance", ctx, userID, periodID)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenAttendance indicates an expected call of GetOpenAttendance.
+func (mr *MockdbRepoProviderMockRecorder) GetOpenAttendance(ctx, userID, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenAttendance", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOpenAttendance), ctx, userID, periodID)
+}
+
+// GetPendingAttendanceCorrection mocks base method.
+func (m *MockdbRepoProvider) GetPendingAttendanceCorrection(ctx context.Context, userID, periodID int, date time.Time) (attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingAttendanceCorrection", ctx, userID, periodID, date)
+	ret0, _ := ret[0].(attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingAttendanceCorrection indicates an expected call of GetPendingAttendanceCorrection.
+func (mr *MockdbRepoProviderMockRecorder) GetPendingAttendanceCorrection(ctx, userID, periodID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingAttendanceCorrection", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPendingAttendanceCorrection), ctx, userID, periodID, date)
 }
 
 // InsertAttendance mocks base method.
@@ -96,6 +202,21 @@ func (mr *MockdbRepoProviderMockRecorder) InsertAttendance(ctx, attendance inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttendance", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertAttendance), ctx, attendance)
 }
 
+// InsertAttendanceCorrection mocks base method.
+func (m *MockdbRepoProvider) InsertAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAttendanceCorrection", ctx, correction)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAttendanceCorrection indicates an expected call of InsertAttendanceCorrection.
+func (mr *MockdbRepoProviderMockRecorder) InsertAttendanceCorrection(ctx, correction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttendanceCorrection", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertAttendanceCorrection), ctx, correction)
+}
+
 // InsertAttendancePeriod mocks base method.
 func (m *MockdbRepoProvider) InsertAttendancePeriod(ctx context.Context, attendancePeriod attendance.AttendancePeriod) (int, error) {
 	m.ctrl.T.Helper()
@@ -110,3 +231,86 @@ func (mr *MockdbRepoProviderMockRecorder) InsertAttendancePeriod(ctx, attendance
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttendancePeriod", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertAttendancePeriod), ctx, attendancePeriod)
 }
+
+// UpdateAttendance mocks base method.
+func (m *MockdbRepoProvider) UpdateAttendance(ctx context.Context, a attendance.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendance", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendance indicates an expected call of UpdateAttendance.
+func (mr *MockdbRepoProviderMockRecorder) UpdateAttendance(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendance", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateAttendance), ctx, a)
+}
+
+// UpdateAttendanceClockOut mocks base method.
+func (m *MockdbRepoProvider) UpdateAttendanceClockOut(ctx context.Context, a attendance.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceClockOut", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceClockOut indicates an expected call of UpdateAttendanceClockOut.
+func (mr *MockdbRepoProviderMockRecorder) UpdateAttendanceClockOut(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceClockOut", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateAttendanceClockOut), ctx, a)
+}
+
+// UpdateAttendanceCorrectionReview mocks base method.
+func (m *MockdbRepoProvider) UpdateAttendanceCorrectionReview(ctx context.Context, correction attendance.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceCorrectionReview", ctx, correction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceCorrectionReview indicates an expected call of UpdateAttendanceCorrectionReview.
+func (mr *MockdbRepoProviderMockRecorder) UpdateAttendanceCorrectionReview(ctx, correction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceCorrectionReview", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateAttendanceCorrectionReview), ctx, correction)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
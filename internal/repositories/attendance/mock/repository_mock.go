@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	attendance "payslip-generation-system/internal/entity/attendance"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	reflect "reflect"
 	time "time"
 
@@ -36,6 +37,20 @@ func (m *MockAttendanceRepositoryProvider) EXPECT() *MockAttendanceRepositoryPro
 	return m.recorder
 }
 
+// DeleteAttendance mocks base method.
+func (m *MockAttendanceRepositoryProvider) DeleteAttendance(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendance", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendance indicates an expected call of DeleteAttendance.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) DeleteAttendance(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendance", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).DeleteAttendance), ctx, id)
+}
+
 // GetAttendance mocks base method.
 func (m *MockAttendanceRepositoryProvider) GetAttendance(ctx context.Context, userID, periodID int, date time.Time) (attendance.Attendance, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,51 @@ func (mr *MockAttendanceRepositoryProviderMockRecorder) GetAttendance(ctx, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetAttendance), ctx, userID, periodID, date)
 }
 
+// GetAttendanceByID mocks base method.
+func (m *MockAttendanceRepositoryProvider) GetAttendanceByID(ctx context.Context, id int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceByID", ctx, id)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceByID indicates an expected call of GetAttendanceByID.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetAttendanceByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceByID", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetAttendanceByID), ctx, id)
+}
+
+// GetAttendanceCorrectionByID mocks base method.
+func (m *MockAttendanceRepositoryProvider) GetAttendanceCorrectionByID(ctx context.Context, id int) (attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceCorrectionByID", ctx, id)
+	ret0, _ := ret[0].(attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceCorrectionByID indicates an expected call of GetAttendanceCorrectionByID.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetAttendanceCorrectionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceCorrectionByID", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetAttendanceCorrectionByID), ctx, id)
+}
+
+// GetAttendanceCorrectionsByStatus mocks base method.
+func (m *MockAttendanceRepositoryProvider) GetAttendanceCorrectionsByStatus(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceCorrectionsByStatus", ctx, status)
+	ret0, _ := ret[0].([]attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceCorrectionsByStatus indicates an expected call of GetAttendanceCorrectionsByStatus.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetAttendanceCorrectionsByStatus(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceCorrectionsByStatus", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetAttendanceCorrectionsByStatus), ctx, status)
+}
+
 // GetAttendancePeriodByID mocks base method.
 func (m *MockAttendanceRepositoryProvider) GetAttendancePeriodByID(ctx context.Context, id int) (attendance.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
@@ -66,19 +126,65 @@ func (mr *MockAttendanceRepositoryProviderMockRecorder) GetAttendancePeriodByID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancePeriodByID", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetAttendancePeriodByID), ctx, id)
 }
 
+// GetAttendancesByUserID mocks base method.
+func (m *MockAttendanceRepositoryProvider) GetAttendancesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendancesByUserID", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]attendance.Attendance)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttendancesByUserID indicates an expected call of GetAttendancesByUserID.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetAttendancesByUserID(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancesByUserID", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetAttendancesByUserID), ctx, userID, periodID, page)
+}
+
 // GetEmployeeAttendanceSummary mocks base method.
-func (m *MockAttendanceRepositoryProvider) GetEmployeeAttendanceSummary(ctx context.Context, periodID int) ([]attendance.EmployeeAttendanceSummary, error) {
+func (m *MockAttendanceRepositoryProvider) GetEmployeeAttendanceSummary(ctx context.Context, periodID, minWorkedMinutes int) ([]attendance.EmployeeAttendanceSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEmployeeAttendanceSummary", ctx, periodID)
+	ret := m.ctrl.Call(m, "GetEmployeeAttendanceSummary", ctx, periodID, minWorkedMinutes)
 	ret0, _ := ret[0].([]attendance.EmployeeAttendanceSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEmployeeAttendanceSummary indicates an expected call of GetEmployeeAttendanceSummary.
-func (mr *MockAttendanceRepositoryProviderMockRecorder) GetEmployeeAttendanceSummary(ctx, periodID interface{}) *gomock.Call {
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetEmployeeAttendanceSummary(ctx, periodID, minWorkedMinutes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeAttendanceSummary", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetEmployeeAttendanceSummary), ctx, periodID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeAttendanceSummary", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetEmployeeAttendanceSummary), ctx, periodID, minWorkedMinutes)
+}
+
+// GetOpenAttendance mocks base method.
+func (m *MockAttendanceRepositoryProvider) GetOpenAttendance(ctx context.Context, userID, periodID int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenAttendance", ctx, userID, periodID)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenAttendance indicates an expected call of GetOpenAttendance.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetOpenAttendance(ctx, userID, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenAttendance", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetOpenAttendance), ctx, userID, periodID)
+}
+
+// GetPendingAttendanceCorrection mocks base method.
+func (m *MockAttendanceRepositoryProvider) GetPendingAttendanceCorrection(ctx context.Context, userID, periodID int, date time.Time) (attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingAttendanceCorrection", ctx, userID, periodID, date)
+	ret0, _ := ret[0].(attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingAttendanceCorrection indicates an expected call of GetPendingAttendanceCorrection.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) GetPendingAttendanceCorrection(ctx, userID, periodID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingAttendanceCorrection", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).GetPendingAttendanceCorrection), ctx, userID, periodID, date)
 }
 
 // InsertAttendance mocks base method.
@@ -96,6 +202,21 @@ func (mr *MockAttendanceRepositoryProviderMockRecorder) InsertAttendance(ctx, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttendance", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).InsertAttendance), ctx, a)
 }
 
+// InsertAttendanceCorrection mocks base method.
+func (m *MockAttendanceRepositoryProvider) InsertAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAttendanceCorrection", ctx, correction)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAttendanceCorrection indicates an expected call of InsertAttendanceCorrection.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) InsertAttendanceCorrection(ctx, correction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttendanceCorrection", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).InsertAttendanceCorrection), ctx, correction)
+}
+
 // InsertAttendancePeriod mocks base method.
 func (m *MockAttendanceRepositoryProvider) InsertAttendancePeriod(ctx context.Context, attendancePeriod attendance.AttendancePeriod) (int, error) {
 	m.ctrl.T.Helper()
@@ -110,3 +231,45 @@ func (mr *MockAttendanceRepositoryProviderMockRecorder) InsertAttendancePeriod(c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAttendancePeriod", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).InsertAttendancePeriod), ctx, attendancePeriod)
 }
+
+// UpdateAttendance mocks base method.
+func (m *MockAttendanceRepositoryProvider) UpdateAttendance(ctx context.Context, a attendance.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendance", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendance indicates an expected call of UpdateAttendance.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) UpdateAttendance(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendance", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).UpdateAttendance), ctx, a)
+}
+
+// UpdateAttendanceClockOut mocks base method.
+func (m *MockAttendanceRepositoryProvider) UpdateAttendanceClockOut(ctx context.Context, a attendance.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceClockOut", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceClockOut indicates an expected call of UpdateAttendanceClockOut.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) UpdateAttendanceClockOut(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceClockOut", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).UpdateAttendanceClockOut), ctx, a)
+}
+
+// UpdateAttendanceCorrectionReview mocks base method.
+func (m *MockAttendanceRepositoryProvider) UpdateAttendanceCorrectionReview(ctx context.Context, correction attendance.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceCorrectionReview", ctx, correction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceCorrectionReview indicates an expected call of UpdateAttendanceCorrectionReview.
+func (mr *MockAttendanceRepositoryProviderMockRecorder) UpdateAttendanceCorrectionReview(ctx, correction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceCorrectionReview", reflect.TypeOf((*MockAttendanceRepositoryProvider)(nil).UpdateAttendanceCorrectionReview), ctx, correction)
+}
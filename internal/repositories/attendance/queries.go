@@ -25,11 +25,21 @@ const (
 		INSERT INTO attendances (
 			user_id,
 			period_id,
-			date
+			date,
+			clock_in,
+			clock_out,
+			worked_minutes,
+			late_minutes,
+			is_extra_day
 		) VALUES (
 			$1,
 			$2,
-			$3
+			$3,
+			$4,
+			$5,
+			$6,
+			$7,
+			$8
 		) RETURNING id;
 	`
 	queryGetAttendance = `
@@ -38,42 +48,215 @@ const (
 			user_id,
 			period_id,
 			date,
+			clock_in,
+			clock_out,
+			worked_minutes,
+			late_minutes,
+			is_extra_day,
 			created_at,
 			updated_at
 		FROM attendances
 		WHERE user_id = $1 AND period_id = $2 AND date = $3;
 	`
 
+	queryGetOpenAttendance = `
+		SELECT 
+			id,
+			user_id,
+			period_id,
+			date,
+			clock_in,
+			clock_out,
+			worked_minutes,
+			late_minutes,
+			is_extra_day,
+			created_at,
+			updated_at
+		FROM attendances
+		WHERE user_id = $1 AND period_id = $2 AND clock_in IS NOT NULL AND clock_out IS NULL
+		ORDER BY clock_in DESC
+		LIMIT 1;
+	`
+
+	queryUpdateAttendanceClockOut = `
+		UPDATE attendances
+		SET
+			clock_out = $2,
+			worked_minutes = $3,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+
 	queryGetEmployeeAttendanceSummary = `
 		WITH attendance_count AS (
 		SELECT user_id, COUNT(*) AS present_days
 		FROM attendances
 		WHERE period_id = $1
+			AND (clock_in IS NULL OR (clock_out IS NOT NULL AND worked_minutes >= $2))
 		GROUP BY user_id
 		),
 		overtime_sum AS (
-		SELECT user_id, COALESCE(SUM(hours), 0) AS overtime_hours
+		SELECT
+			user_id,
+			COALESCE(SUM(minutes), 0) AS overtime_minutes,
+			COALESCE(SUM(minutes) FILTER (WHERE type = 'rest_day'), 0) AS rest_day_overtime_minutes,
+			COALESCE(SUM(minutes) FILTER (WHERE type = 'public_holiday'), 0) AS public_holiday_overtime_minutes
 		FROM overtimes
-		WHERE period_id = $1
-		GROUP BY user_id
-		),
-		reimbursement_sum AS (
-		SELECT user_id, COALESCE(SUM(amount), 0) AS reimbursement_total
-		FROM reimbursements
-		WHERE period_id = $1
+		WHERE period_id = $1 AND status = 'approved'
 		GROUP BY user_id
 		)
 		SELECT
 		u.id AS user_id,
 		u.salary AS base_salary,
 		COALESCE(a.present_days, 0) AS present_days,
-		COALESCE(o.overtime_hours, 0) AS overtime_hours,
-		COALESCE(r.reimbursement_total, 0) AS reimbursement_total
+		COALESCE(o.overtime_minutes, 0) AS overtime_minutes,
+		COALESCE(o.rest_day_overtime_minutes, 0) AS rest_day_overtime_minutes,
+		COALESCE(o.public_holiday_overtime_minutes, 0) AS public_holiday_overtime_minutes
 		FROM users u
 		LEFT JOIN attendance_count a ON a.user_id = u.id
 		LEFT JOIN overtime_sum o ON o.user_id = u.id
-		LEFT JOIN reimbursement_sum r ON r.user_id = u.id
 		WHERE u.is_admin = false
 		ORDER BY u.id;
 		`
-)
\ No newline at end of file
+
+	queryDeleteAttendance = `
+		DELETE FROM attendances
+		WHERE id = $1;
+	`
+
+	queryInsertAttendanceCorrection = `
+		INSERT INTO attendance_corrections (
+			user_id,
+			period_id,
+			date,
+			action,
+			reason,
+			status
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6
+		) RETURNING id;
+	`
+
+	queryGetAttendanceCorrectionByID = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			date,
+			action,
+			reason,
+			status,
+			reviewed_by,
+			review_note,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM attendance_corrections
+		WHERE id = $1;
+	`
+
+	queryGetPendingAttendanceCorrection = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			date,
+			action,
+			reason,
+			status,
+			reviewed_by,
+			review_note,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM attendance_corrections
+		WHERE user_id = $1 AND period_id = $2 AND date = $3 AND status = 'pending';
+	`
+
+	queryGetAttendanceCorrectionsByStatus = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			date,
+			action,
+			reason,
+			status,
+			reviewed_by,
+			review_note,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM attendance_corrections
+		WHERE status = $1
+		ORDER BY created_at, id;
+	`
+
+	queryUpdateAttendanceCorrectionReview = `
+		UPDATE attendance_corrections
+		SET
+			status = $2,
+			reviewed_by = $3,
+			review_note = $4,
+			reviewed_at = $5,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	// $2 is optional, period 0 lists every period
+	queryCountAttendancesByUserID = `
+		SELECT COUNT(*)
+		FROM attendances
+		WHERE user_id = $1 AND ($2::int = 0 OR period_id = $2);
+	`
+
+	queryGetAttendancesByUserID = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			date,
+			clock_in,
+			clock_out,
+			worked_minutes,
+			late_minutes,
+			is_extra_day,
+			created_at,
+			updated_at
+		FROM attendances
+		WHERE user_id = $1 AND ($2::int = 0 OR period_id = $2)
+		ORDER BY date DESC, id DESC
+		LIMIT $3 OFFSET $4;
+	`
+
+	queryGetAttendanceByID = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			date,
+			clock_in,
+			clock_out,
+			worked_minutes,
+			late_minutes,
+			is_extra_day,
+			created_at,
+			updated_at
+		FROM attendances
+		WHERE id = $1;
+	`
+
+	queryUpdateAttendance = `
+		UPDATE attendances
+		SET
+			date = $2,
+			is_extra_day = $3,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/postgres"
 )
 
@@ -14,7 +15,18 @@ type AttendanceRepositoryProvider interface {
 	GetAttendancePeriodByID(ctx context.Context, id int) (attendance.AttendancePeriod, error)
 	InsertAttendance(ctx context.Context, a attendance.Attendance) (int, error)
 	GetAttendance(ctx context.Context, userID, periodID int, date time.Time) (attendance.Attendance, error)
-	GetEmployeeAttendanceSummary(ctx context.Context, periodID int) ([]attendance.EmployeeAttendanceSummary, error)
+	GetOpenAttendance(ctx context.Context, userID, periodID int) (attendance.Attendance, error)
+	UpdateAttendanceClockOut(ctx context.Context, a attendance.Attendance) error
+	GetEmployeeAttendanceSummary(ctx context.Context, periodID, minWorkedMinutes int) ([]attendance.EmployeeAttendanceSummary, error)
+	DeleteAttendance(ctx context.Context, id int) error
+	InsertAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection) (int, error)
+	GetAttendanceCorrectionByID(ctx context.Context, id int) (attendance.AttendanceCorrection, error)
+	GetPendingAttendanceCorrection(ctx context.Context, userID, periodID int, date time.Time) (attendance.AttendanceCorrection, error)
+	GetAttendanceCorrectionsByStatus(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error)
+	UpdateAttendanceCorrectionReview(ctx context.Context, correction attendance.AttendanceCorrection) error
+	GetAttendancesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error)
+	GetAttendanceByID(ctx context.Context, id int) (attendance.Attendance, error)
+	UpdateAttendance(ctx context.Context, a attendance.Attendance) error
 }
 
 type attendanceRepository struct {
@@ -67,11 +79,110 @@ func (r *attendanceRepository) GetAttendance(ctx context.Context, userID, period
 	return result, nil
 }
 
-func (r *attendanceRepository) GetEmployeeAttendanceSummary(ctx context.Context, periodID int) ([]attendance.EmployeeAttendanceSummary, error) {
-    result, err := r.db.GetEmployeeAttendanceSummary(ctx, periodID)
+func (r *attendanceRepository) GetOpenAttendance(ctx context.Context, userID, periodID int) (attendance.Attendance, error) {
+	result, err := r.db.GetOpenAttendance(ctx, userID, periodID)
+	if err != nil {
+		return attendance.Attendance{}, err
+	}
+
+	return result, nil
+}
+
+func (r *attendanceRepository) UpdateAttendanceClockOut(ctx context.Context, a attendance.Attendance) error {
+	err := r.db.UpdateAttendanceClockOut(ctx, a)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *attendanceRepository) GetEmployeeAttendanceSummary(ctx context.Context, periodID, minWorkedMinutes int) ([]attendance.EmployeeAttendanceSummary, error) {
+    result, err := r.db.GetEmployeeAttendanceSummary(ctx, periodID, minWorkedMinutes)
     if err != nil {
         return []attendance.EmployeeAttendanceSummary{}, err
     }
 
     return result, nil
 }
+
+func (r *attendanceRepository) DeleteAttendance(ctx context.Context, id int) error {
+	err := r.db.DeleteAttendance(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *attendanceRepository) InsertAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection) (int, error) {
+	id, err := r.db.InsertAttendanceCorrection(ctx, correction)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *attendanceRepository) GetAttendanceCorrectionByID(ctx context.Context, id int) (attendance.AttendanceCorrection, error) {
+	result, err := r.db.GetAttendanceCorrectionByID(ctx, id)
+	if err != nil {
+		return attendance.AttendanceCorrection{}, err
+	}
+
+	return result, nil
+}
+
+func (r *attendanceRepository) GetPendingAttendanceCorrection(ctx context.Context, userID, periodID int, date time.Time) (attendance.AttendanceCorrection, error) {
+	result, err := r.db.GetPendingAttendanceCorrection(ctx, userID, periodID, date)
+	if err != nil {
+		return attendance.AttendanceCorrection{}, err
+	}
+
+	return result, nil
+}
+
+func (r *attendanceRepository) GetAttendanceCorrectionsByStatus(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error) {
+	result, err := r.db.GetAttendanceCorrectionsByStatus(ctx, status)
+	if err != nil {
+		return []attendance.AttendanceCorrection{}, err
+	}
+
+	return result, nil
+}
+
+func (r *attendanceRepository) UpdateAttendanceCorrectionReview(ctx context.Context, correction attendance.AttendanceCorrection) error {
+	err := r.db.UpdateAttendanceCorrectionReview(ctx, correction)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *attendanceRepository) GetAttendancesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error) {
+	result, total, err := r.db.GetAttendancesByUserID(ctx, userID, periodID, page)
+	if err != nil {
+		return []attendance.Attendance{}, 0, err
+	}
+
+	return result, total, nil
+}
+
+func (r *attendanceRepository) GetAttendanceByID(ctx context.Context, id int) (attendance.Attendance, error) {
+	result, err := r.db.GetAttendanceByID(ctx, id)
+	if err != nil {
+		return attendance.Attendance{}, err
+	}
+
+	return result, nil
+}
+
+func (r *attendanceRepository) UpdateAttendance(ctx context.Context, a attendance.Attendance) error {
+	err := r.db.UpdateAttendance(ctx, a)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
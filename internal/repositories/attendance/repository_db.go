@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/postgres"
 	"time"
 )
@@ -14,7 +15,18 @@ type dbRepoProvider interface {
 	GetAttendancePeriodByID(ctx context.Context, id int) (attendance.AttendancePeriod, error) 
 	InsertAttendance(ctx context.Context, attendance attendance.Attendance) (int, error) 
 	GetAttendance(ctx context.Context, userID, periodID int, date time.Time) (attendance.Attendance, error)
-	GetEmployeeAttendanceSummary(ctx context.Context, periodID int) ([]attendance.EmployeeAttendanceSummary, error)
+	GetOpenAttendance(ctx context.Context, userID, periodID int) (attendance.Attendance, error)
+	UpdateAttendanceClockOut(ctx context.Context, a attendance.Attendance) error
+	GetEmployeeAttendanceSummary(ctx context.Context, periodID, minWorkedMinutes int) ([]attendance.EmployeeAttendanceSummary, error)
+	DeleteAttendance(ctx context.Context, id int) error
+	InsertAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection) (int, error)
+	GetAttendanceCorrectionByID(ctx context.Context, id int) (attendance.AttendanceCorrection, error)
+	GetPendingAttendanceCorrection(ctx context.Context, userID, periodID int, date time.Time) (attendance.AttendanceCorrection, error)
+	GetAttendanceCorrectionsByStatus(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error)
+	UpdateAttendanceCorrectionReview(ctx context.Context, correction attendance.AttendanceCorrection) error
+	GetAttendancesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error)
+	GetAttendanceByID(ctx context.Context, id int) (attendance.Attendance, error)
+	UpdateAttendance(ctx context.Context, a attendance.Attendance) error
 }
 
 type dbRepo struct {
@@ -67,6 +79,11 @@ func (r *dbRepo) InsertAttendance(ctx context.Context, attendance attendance.Att
 		attendance.UserID,
 		attendance.PeriodID,
 		attendance.Date,
+		attendance.ClockIn,
+		attendance.ClockOut,
+		attendance.WorkedMinutes,
+		attendance.LateMinutes,
+		attendance.IsExtraDay,
 	).Scan(&id)
 	if err != nil {
 		return 0, err
@@ -83,6 +100,11 @@ func (r *dbRepo) GetAttendance(ctx context.Context, userID, periodID int, date t
 		&a.UserID,
 		&a.PeriodID,
 		&a.Date,
+		&a.ClockIn,
+		&a.ClockOut,
+		&a.WorkedMinutes,
+		&a.LateMinutes,
+		&a.IsExtraDay,
 		&a.CreatedAt,
 		&a.UpdatedAt,
 	)
@@ -95,8 +117,48 @@ func (r *dbRepo) GetAttendance(ctx context.Context, userID, periodID int, date t
 	return a, nil
 }
 
-func (r *dbRepo) GetEmployeeAttendanceSummary(ctx context.Context, periodID int) ([]attendance.EmployeeAttendanceSummary, error) {
-    rows, err := r.db.DB.QueryContext(ctx, queryGetEmployeeAttendanceSummary, periodID)
+func (r *dbRepo) GetOpenAttendance(ctx context.Context, userID, periodID int) (attendance.Attendance, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetOpenAttendance, userID, periodID)
+
+	var a attendance.Attendance
+	err := row.Scan(
+		&a.ID,
+		&a.UserID,
+		&a.PeriodID,
+		&a.Date,
+		&a.ClockIn,
+		&a.ClockOut,
+		&a.WorkedMinutes,
+		&a.LateMinutes,
+		&a.IsExtraDay,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return attendance.Attendance{}, nil
+		}
+		return attendance.Attendance{}, err
+	}
+	return a, nil
+}
+
+func (r *dbRepo) UpdateAttendanceClockOut(ctx context.Context, a attendance.Attendance) error {
+	_, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateAttendanceClockOut,
+		a.ID,
+		a.ClockOut,
+		a.WorkedMinutes,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *dbRepo) GetEmployeeAttendanceSummary(ctx context.Context, periodID, minWorkedMinutes int) ([]attendance.EmployeeAttendanceSummary, error) {
+    rows, err := r.db.DB.QueryContext(ctx, queryGetEmployeeAttendanceSummary, periodID, minWorkedMinutes)
     if err != nil {
         return nil, err
     }
@@ -109,8 +171,9 @@ func (r *dbRepo) GetEmployeeAttendanceSummary(ctx context.Context, periodID int)
             &eas.UserID,
             &eas.BaseSalary,
             &eas.PresentDays,
-            &eas.OvertimeHours,
-            &eas.ReimbursementTotal,
+            &eas.OvertimeMinutes,
+            &eas.RestDayOvertimeMinutes,
+            &eas.PublicHolidayOvertimeMinutes,
         ); err != nil {
             return nil, err
         }
@@ -123,3 +186,179 @@ func (r *dbRepo) GetEmployeeAttendanceSummary(ctx context.Context, periodID int)
     return results, nil
 }
 
+func (r *dbRepo) DeleteAttendance(ctx context.Context, id int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryDeleteAttendance, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *dbRepo) InsertAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryInsertAttendanceCorrection,
+		correction.UserID,
+		correction.PeriodID,
+		correction.Date,
+		correction.Action,
+		correction.Reason,
+		correction.Status,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetAttendanceCorrectionByID(ctx context.Context, id int) (attendance.AttendanceCorrection, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetAttendanceCorrectionByID, id)
+
+	c, err := scanAttendanceCorrection(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return attendance.AttendanceCorrection{}, nil
+		}
+		return attendance.AttendanceCorrection{}, err
+	}
+	return c, nil
+}
+
+func (r *dbRepo) GetPendingAttendanceCorrection(ctx context.Context, userID, periodID int, date time.Time) (attendance.AttendanceCorrection, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetPendingAttendanceCorrection, userID, periodID, date)
+
+	c, err := scanAttendanceCorrection(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return attendance.AttendanceCorrection{}, nil
+		}
+		return attendance.AttendanceCorrection{}, err
+	}
+	return c, nil
+}
+
+func (r *dbRepo) GetAttendanceCorrectionsByStatus(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetAttendanceCorrectionsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []attendance.AttendanceCorrection{}
+	for rows.Next() {
+		c, err := scanAttendanceCorrection(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *dbRepo) UpdateAttendanceCorrectionReview(ctx context.Context, correction attendance.AttendanceCorrection) error {
+	_, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateAttendanceCorrectionReview,
+		correction.ID,
+		correction.Status,
+		correction.ReviewedBy,
+		correction.ReviewNote,
+		correction.ReviewedAt,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func (r *dbRepo) GetAttendancesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error) {
+	var total int
+	err := r.db.DB.QueryRowContext(ctx, queryCountAttendancesByUserID, userID, periodID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.DB.QueryContext(ctx, queryGetAttendancesByUserID, userID, periodID, page.Limit(), page.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	attendances := []attendance.Attendance{}
+	for rows.Next() {
+		a, err := scanAttendance(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		attendances = append(attendances, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return attendances, total, nil
+}
+
+func (r *dbRepo) GetAttendanceByID(ctx context.Context, id int) (attendance.Attendance, error) {
+	a, err := scanAttendance(r.db.DB.QueryRowContext(ctx, queryGetAttendanceByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return attendance.Attendance{}, nil
+		}
+		return attendance.Attendance{}, err
+	}
+	return a, nil
+}
+
+func (r *dbRepo) UpdateAttendance(ctx context.Context, a attendance.Attendance) error {
+	_, err := r.db.DB.ExecContext(ctx, queryUpdateAttendance, a.ID, a.Date, a.IsExtraDay)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func scanAttendance(row rowScanner) (attendance.Attendance, error) {
+	var a attendance.Attendance
+	err := row.Scan(
+		&a.ID,
+		&a.UserID,
+		&a.PeriodID,
+		&a.Date,
+		&a.ClockIn,
+		&a.ClockOut,
+		&a.WorkedMinutes,
+		&a.LateMinutes,
+		&a.IsExtraDay,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+	return a, err
+}
+
+func scanAttendanceCorrection(row rowScanner) (attendance.AttendanceCorrection, error) {
+	var c attendance.AttendanceCorrection
+	err := row.Scan(
+		&c.ID,
+		&c.UserID,
+		&c.PeriodID,
+		&c.Date,
+		&c.Action,
+		&c.Reason,
+		&c.Status,
+		&c.ReviewedBy,
+		&c.ReviewNote,
+		&c.ReviewedAt,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	return c, err
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/postgres"
 	"reflect"
 	"regexp"
@@ -322,15 +323,24 @@ func Test_dbRepo_InsertAttendance(t *testing.T) {
 						mockAttendance.UserID,
 						mockAttendance.PeriodID,
 						mockAttendance.Date,
+						mockAttendance.ClockIn,
+						mockAttendance.ClockOut,
+						mockAttendance.WorkedMinutes,
+						mockAttendance.LateMinutes,
+						mockAttendance.IsExtraDay,
 					).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockAttendance.ID))
 			},
 			args: args{
 				ctx: context.Background(),
 				data: attendance.Attendance{
-					UserID:   mockAttendance.UserID,
-					PeriodID: mockAttendance.PeriodID,
-					Date:     mockAttendance.Date,
+					UserID:      mockAttendance.UserID,
+					PeriodID:    mockAttendance.PeriodID,
+					Date:        mockAttendance.Date,
+					ClockIn:     mockAttendance.ClockIn,
+					ClockOut:    mockAttendance.ClockOut,
+					LateMinutes: mockAttendance.LateMinutes,
+					IsExtraDay:  mockAttendance.IsExtraDay,
 				},
 			},
 			want:    1,
@@ -347,15 +357,24 @@ func Test_dbRepo_InsertAttendance(t *testing.T) {
 						mockAttendance.UserID,
 						mockAttendance.PeriodID,
 						mockAttendance.Date,
+						mockAttendance.ClockIn,
+						mockAttendance.ClockOut,
+						mockAttendance.WorkedMinutes,
+						mockAttendance.LateMinutes,
+						mockAttendance.IsExtraDay,
 					).
 					WillReturnError(sql.ErrConnDone)
 			},
 			args: args{
 				ctx: context.Background(),
 				data: attendance.Attendance{
-					UserID:   mockAttendance.UserID,
-					PeriodID: mockAttendance.PeriodID,
-					Date:     mockAttendance.Date,
+					UserID:      mockAttendance.UserID,
+					PeriodID:    mockAttendance.PeriodID,
+					Date:        mockAttendance.Date,
+					ClockIn:     mockAttendance.ClockIn,
+					ClockOut:    mockAttendance.ClockOut,
+					LateMinutes: mockAttendance.LateMinutes,
+					IsExtraDay:  mockAttendance.IsExtraDay,
 				},
 			},
 			want:    0,
@@ -482,13 +501,16 @@ func Test_dbRepo_GetAttendance(t *testing.T) {
 }
 
 func getMockAttendance(mocktime time.Time) attendance.Attendance {
+	clockIn := time.Date(2025, 6, 10, 2, 15, 0, 0, time.UTC)
 	return attendance.Attendance{
-		ID:        1,
-		UserID:    101,
-		PeriodID:  202,
-		Date:      time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
-		CreatedAt: mocktime,
-		UpdatedAt: mocktime,
+		ID:          1,
+		UserID:      101,
+		PeriodID:    202,
+		Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		ClockIn:     &clockIn,
+		LateMinutes: 15,
+		CreatedAt:   mocktime,
+		UpdatedAt:   mocktime,
 	}
 }
 
@@ -501,6 +523,11 @@ func getMockAttendanceExpectedRows(mocktime time.Time) *sqlmock.Rows {
 		"user_id",
 		"period_id",
 		"date",
+		"clock_in",
+		"clock_out",
+		"worked_minutes",
+		"late_minutes",
+		"is_extra_day",
 		"created_at",
 		"updated_at",
 	})
@@ -510,6 +537,11 @@ func getMockAttendanceExpectedRows(mocktime time.Time) *sqlmock.Rows {
 		mockAtt.UserID,
 		mockAtt.PeriodID,
 		mockAtt.Date,
+		*mockAtt.ClockIn,
+		nil,
+		mockAtt.WorkedMinutes,
+		mockAtt.LateMinutes,
+		mockAtt.IsExtraDay,
 		mockAtt.CreatedAt,
 		mockAtt.UpdatedAt,
 	)
@@ -517,6 +549,174 @@ func getMockAttendanceExpectedRows(mocktime time.Time) *sqlmock.Rows {
 	return rows
 }
 
+func Test_dbRepo_GetOpenAttendance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockAttendance := getMockAttendance(mocktimenow)
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx      context.Context
+		userID   int
+		periodID int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		want    attendance.Attendance
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			fields: fields{
+				db: &postgres.Postgres{DB: db},
+			},
+			mock: func() {
+				expectedRows := getMockAttendanceExpectedRows(mocktimenow)
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOpenAttendance)).
+					WithArgs(mockAttendance.UserID, mockAttendance.PeriodID).
+					WillReturnRows(expectedRows)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userID:   mockAttendance.UserID,
+				periodID: mockAttendance.PeriodID,
+			},
+			want:    mockAttendance,
+			wantErr: false,
+		},
+		{
+			name: "Error - no rows",
+			fields: fields{
+				db: &postgres.Postgres{DB: db},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOpenAttendance)).
+					WithArgs(mockAttendance.UserID, mockAttendance.PeriodID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userID:   mockAttendance.UserID,
+				periodID: mockAttendance.PeriodID,
+			},
+			want:    attendance.Attendance{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			fields: fields{
+				db: &postgres.Postgres{DB: db},
+			},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOpenAttendance)).
+					WithArgs(mockAttendance.UserID, mockAttendance.PeriodID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args: args{
+				ctx:      context.Background(),
+				userID:   mockAttendance.UserID,
+				periodID: mockAttendance.PeriodID,
+			},
+			want:    attendance.Attendance{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			got, err := r.GetOpenAttendance(tt.args.ctx, tt.args.userID, tt.args.periodID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got, "GetOpenAttendance() got = %v, want %v", got, tt.want)
+		})
+	}
+}
+
+func Test_dbRepo_UpdateAttendanceClockOut(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockAttendance := getMockAttendance(mocktimenow)
+	clockOut := mockAttendance.ClockIn.Add(8 * time.Hour)
+	mockAttendance.ClockOut = &clockOut
+	mockAttendance.WorkedMinutes = 480
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx  context.Context
+		data attendance.Attendance
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateAttendanceClockOut)).
+					WithArgs(mockAttendance.ID, mockAttendance.ClockOut, mockAttendance.WorkedMinutes).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			args:    args{ctx: context.Background(), data: mockAttendance},
+			wantErr: false,
+		},
+		{
+			name:   "Error Update",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateAttendanceClockOut)).
+					WithArgs(mockAttendance.ID, mockAttendance.ClockOut, mockAttendance.WorkedMinutes).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args:    args{ctx: context.Background(), data: mockAttendance},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			err := r.UpdateAttendanceClockOut(tt.args.ctx, tt.args.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -525,14 +725,16 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 	defer db.Close()
 
 	mockPeriodID := 123
+	mockMinWorkedMinutes := 480
 	mockData := getMockEmployeeAttendanceSummaryData()
 
 	type fields struct {
 		db *postgres.Postgres
 	}
 	type args struct {
-		ctx      context.Context
-		periodID int
+		ctx              context.Context
+		periodID         int
+		minWorkedMinutes int
 	}
 	tests := []struct {
 		name    string
@@ -548,10 +750,10 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 			mock: func() {
 				rows := getMockEmployeeAttendanceSummaryRows(mockData)
 				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeAttendanceSummary)).
-					WithArgs(mockPeriodID).
+					WithArgs(mockPeriodID, mockMinWorkedMinutes).
 					WillReturnRows(rows)
 			},
-			args:    args{ctx: context.Background(), periodID: mockPeriodID},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, minWorkedMinutes: mockMinWorkedMinutes},
 			want:    mockData,
 			wantErr: false,
 		},
@@ -559,12 +761,12 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 			name:   "Happy Path - No Rows",
 			fields: fields{db: &postgres.Postgres{DB: db}},
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"user_id", "base_salary", "present_days", "overtime_hours", "reimbursement_total"})
+				rows := sqlmock.NewRows([]string{"user_id", "base_salary", "present_days", "overtime_minutes", "rest_day_overtime_minutes", "public_holiday_overtime_minutes"})
 				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeAttendanceSummary)).
-					WithArgs(mockPeriodID).
+					WithArgs(mockPeriodID, mockMinWorkedMinutes).
 					WillReturnRows(rows)
 			},
-			args:    args{ctx: context.Background(), periodID: mockPeriodID},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, minWorkedMinutes: mockMinWorkedMinutes},
 			want:    []attendance.EmployeeAttendanceSummary{}, // Expecting an empty slice, not nil
 			wantErr: false,
 		},
@@ -573,10 +775,10 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 			fields: fields{db: &postgres.Postgres{DB: db}},
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeAttendanceSummary)).
-					WithArgs(mockPeriodID).
+					WithArgs(mockPeriodID, mockMinWorkedMinutes).
 					WillReturnError(sql.ErrConnDone)
 			},
-			args:    args{ctx: context.Background(), periodID: mockPeriodID},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, minWorkedMinutes: mockMinWorkedMinutes},
 			want:    nil,
 			wantErr: true,
 		},
@@ -584,15 +786,15 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 			name:   "Error - Scan Failed",
 			fields: fields{db: &postgres.Postgres{DB: db}},
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"user_id", "base_salary", "present_days", "overtime_hours", "reimbursement_total"}).
-					AddRow(mockData[0].UserID, mockData[0].BaseSalary, mockData[0].PresentDays, mockData[0].OvertimeHours, mockData[0].ReimbursementTotal).
-					AddRow("invalid_user_id", "invalid_salary", "invalid_days", "invalid_hours", "invalid_reimbursement") // Bad data to cause scan error
+				rows := sqlmock.NewRows([]string{"user_id", "base_salary", "present_days", "overtime_minutes", "rest_day_overtime_minutes", "public_holiday_overtime_minutes"}).
+					AddRow(mockData[0].UserID, mockData[0].BaseSalary, mockData[0].PresentDays, mockData[0].OvertimeMinutes, mockData[0].RestDayOvertimeMinutes, mockData[0].PublicHolidayOvertimeMinutes).
+					AddRow("invalid_user_id", "invalid_salary", "invalid_days", "invalid_hours", "invalid_hours", "invalid_hours") // Bad data to cause scan error
 
 				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeAttendanceSummary)).
-					WithArgs(mockPeriodID).
+					WithArgs(mockPeriodID, mockMinWorkedMinutes).
 					WillReturnRows(rows)
 			},
-			args:    args{ctx: context.Background(), periodID: mockPeriodID},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, minWorkedMinutes: mockMinWorkedMinutes},
 			want:    nil,
 			wantErr: true,
 		},
@@ -603,7 +805,7 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 			r := &dbRepo{
 				db: tt.fields.db,
 			}
-			got, err := r.GetEmployeeAttendanceSummary(tt.args.ctx, tt.args.periodID)
+			got, err := r.GetEmployeeAttendanceSummary(tt.args.ctx, tt.args.periodID, tt.args.minWorkedMinutes)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -623,18 +825,17 @@ func Test_dbRepo_GetEmployeeAttendanceSummary(t *testing.T) {
 func getMockEmployeeAttendanceSummaryData() []attendance.EmployeeAttendanceSummary {
 	return []attendance.EmployeeAttendanceSummary{
 		{
-			UserID:             101,
-			BaseSalary:         5000000,
-			PresentDays:        20,
-			OvertimeHours:      3, 
-			ReimbursementTotal: 150000,
+			UserID:          101,
+			BaseSalary:      5000000,
+			PresentDays:     20,
+			OvertimeMinutes: 195,
 		},
 		{
-			UserID:             102,
-			BaseSalary:         7500000,
-			PresentDays:        22,
-			OvertimeHours:      3, 
-			ReimbursementTotal: 50000,
+			UserID:                 102,
+			BaseSalary:             7500000,
+			PresentDays:            22,
+			OvertimeMinutes:        660,
+			RestDayOvertimeMinutes: 480,
 		},
 	}
 }
@@ -644,11 +845,532 @@ func getMockEmployeeAttendanceSummaryRows(data []attendance.EmployeeAttendanceSu
 		"user_id",
 		"base_salary",
 		"present_days",
-		"overtime_hours",
-		"reimbursement_total",
+		"overtime_minutes",
+		"rest_day_overtime_minutes",
+		"public_holiday_overtime_minutes",
 	})
 	for _, item := range data {
-		rows.AddRow(item.UserID, item.BaseSalary, item.PresentDays, item.OvertimeHours, item.ReimbursementTotal)
+		rows.AddRow(item.UserID, item.BaseSalary, item.PresentDays, item.OvertimeMinutes, item.RestDayOvertimeMinutes, item.PublicHolidayOvertimeMinutes)
 	}
 	return rows
-}
\ No newline at end of file
+}
+
+func Test_dbRepo_DeleteAttendance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryDeleteAttendance)).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error Delete",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryDeleteAttendance)).
+					WithArgs(1).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.DeleteAttendance(context.Background(), 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_InsertAttendanceCorrection(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockCorrection := getMockAttendanceCorrection(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertAttendanceCorrection)).
+					WithArgs(
+						mockCorrection.UserID,
+						mockCorrection.PeriodID,
+						mockCorrection.Date,
+						mockCorrection.Action,
+						mockCorrection.Reason,
+						mockCorrection.Status,
+					).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Error Insert",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertAttendanceCorrection)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.InsertAttendanceCorrection(context.Background(), mockCorrection)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetAttendanceCorrectionByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockCorrection := getMockAttendanceCorrection(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    attendance.AttendanceCorrection
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceCorrectionByID)).
+					WithArgs(mockCorrection.ID).
+					WillReturnRows(getMockAttendanceCorrectionExpectedRows(mocktimenow))
+			},
+			want:    mockCorrection,
+			wantErr: false,
+		},
+		{
+			name: "Error - no rows",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceCorrectionByID)).
+					WithArgs(mockCorrection.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    attendance.AttendanceCorrection{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceCorrectionByID)).
+					WithArgs(mockCorrection.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    attendance.AttendanceCorrection{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetAttendanceCorrectionByID(context.Background(), mockCorrection.ID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetAttendanceCorrectionsByStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockCorrection := getMockAttendanceCorrection(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []attendance.AttendanceCorrection
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceCorrectionsByStatus)).
+					WithArgs(attendance.CorrectionStatusPending).
+					WillReturnRows(getMockAttendanceCorrectionExpectedRows(mocktimenow))
+			},
+			want:    []attendance.AttendanceCorrection{mockCorrection},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceCorrectionsByStatus)).
+					WithArgs(attendance.CorrectionStatusPending).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetAttendanceCorrectionsByStatus(context.Background(), attendance.CorrectionStatusPending)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_UpdateAttendanceCorrectionReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockCorrection := getMockAttendanceCorrection(mocktimenow)
+	reviewerID := 1
+	mockCorrection.Status = attendance.CorrectionStatusApproved
+	mockCorrection.ReviewedBy = &reviewerID
+	mockCorrection.ReviewNote = "confirmed with team lead"
+	mockCorrection.ReviewedAt = &mocktimenow
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateAttendanceCorrectionReview)).
+					WithArgs(mockCorrection.ID, mockCorrection.Status, mockCorrection.ReviewedBy, mockCorrection.ReviewNote, mockCorrection.ReviewedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error Update",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateAttendanceCorrectionReview)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateAttendanceCorrectionReview(context.Background(), mockCorrection)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func getMockAttendanceCorrection(mocktime time.Time) attendance.AttendanceCorrection {
+	return attendance.AttendanceCorrection{
+		ID:        3,
+		UserID:    101,
+		PeriodID:  202,
+		Date:      time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC),
+		Action:    attendance.CorrectionActionAdd,
+		Reason:    "forgot to clock in, was at the client site",
+		Status:    attendance.CorrectionStatusPending,
+		CreatedAt: mocktime,
+		UpdatedAt: mocktime,
+	}
+}
+
+func getMockAttendanceCorrectionExpectedRows(mocktime time.Time) *sqlmock.Rows {
+	c := getMockAttendanceCorrection(mocktime)
+
+	return sqlmock.NewRows([]string{
+		"id",
+		"user_id",
+		"period_id",
+		"date",
+		"action",
+		"reason",
+		"status",
+		"reviewed_by",
+		"review_note",
+		"reviewed_at",
+		"created_at",
+		"updated_at",
+	}).AddRow(
+		c.ID,
+		c.UserID,
+		c.PeriodID,
+		c.Date,
+		c.Action,
+		c.Reason,
+		c.Status,
+		nil,
+		c.ReviewNote,
+		nil,
+		c.CreatedAt,
+		c.UpdatedAt,
+	)
+}
+
+func Test_dbRepo_GetAttendancesByUserID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockAttendance := getMockAttendance(mocktimenow)
+	page := pagination.NewRequest(2, 10)
+
+	tests := []struct {
+		name      string
+		mock      func()
+		want      []attendance.Attendance
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryCountAttendancesByUserID)).
+					WithArgs(mockAttendance.UserID, mockAttendance.PeriodID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendancesByUserID)).
+					WithArgs(mockAttendance.UserID, mockAttendance.PeriodID, 10, 10).
+					WillReturnRows(getMockAttendanceExpectedRows(mocktimenow))
+			},
+			want:      []attendance.Attendance{mockAttendance},
+			wantTotal: 11,
+			wantErr:   false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryCountAttendancesByUserID)).
+					WithArgs(mockAttendance.UserID, mockAttendance.PeriodID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:      nil,
+			wantTotal: 0,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, total, err := r.GetAttendancesByUserID(context.Background(), mockAttendance.UserID, mockAttendance.PeriodID, page)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetAttendanceByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockAttendance := getMockAttendance(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    attendance.Attendance
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceByID)).
+					WithArgs(mockAttendance.ID).
+					WillReturnRows(getMockAttendanceExpectedRows(mocktimenow))
+			},
+			want:    mockAttendance,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceByID)).
+					WithArgs(mockAttendance.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    attendance.Attendance{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttendanceByID)).
+					WithArgs(mockAttendance.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    attendance.Attendance{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetAttendanceByID(context.Background(), mockAttendance.ID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateAttendance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockAttendance := getMockAttendance(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateAttendance)).
+					WithArgs(mockAttendance.ID, mockAttendance.Date, mockAttendance.IsExtraDay).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error Update",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateAttendance)).
+					WithArgs(mockAttendance.ID, mockAttendance.Date, mockAttendance.IsExtraDay).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateAttendance(context.Background(), mockAttendance)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository_db.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	bankaccount "payslip-generation-system/internal/entity/bankaccount"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// DeleteAllocation mocks base method.
+func (m *MockdbRepoProvider) DeleteAllocation(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllocation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllocation indicates an expected call of DeleteAllocation.
+func (mr *MockdbRepoProviderMockRecorder) DeleteAllocation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllocation", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteAllocation), ctx, id)
+}
+
+// GetAllocationByID mocks base method.
+func (m *MockdbRepoProvider) GetAllocationByID(ctx context.Context, id int) (bankaccount.Allocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocationByID", ctx, id)
+	ret0, _ := ret[0].(bankaccount.Allocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocationByID indicates an expected call of GetAllocationByID.
+func (mr *MockdbRepoProviderMockRecorder) GetAllocationByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocationByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAllocationByID), ctx, id)
+}
+
+// GetAllocations mocks base method.
+func (m *MockdbRepoProvider) GetAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocations", ctx, userID)
+	ret0, _ := ret[0].([]bankaccount.Allocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocations indicates an expected call of GetAllocations.
+func (mr *MockdbRepoProviderMockRecorder) GetAllocations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocations", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAllocations), ctx, userID)
+}
+
+// GetBankAccountByID mocks base method.
+func (m *MockdbRepoProvider) GetBankAccountByID(ctx context.Context, id int) (bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccountByID", ctx, id)
+	ret0, _ := ret[0].(bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccountByID indicates an expected call of GetBankAccountByID.
+func (mr *MockdbRepoProviderMockRecorder) GetBankAccountByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccountByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetBankAccountByID), ctx, id)
+}
+
+// GetBankAccounts mocks base method.
+func (m *MockdbRepoProvider) GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccounts", ctx, userID, status)
+	ret0, _ := ret[0].([]bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccounts indicates an expected call of GetBankAccounts.
+func (mr *MockdbRepoProviderMockRecorder) GetBankAccounts(ctx, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccounts", reflect.TypeOf((*MockdbRepoProvider)(nil).GetBankAccounts), ctx, userID, status)
+}
+
+// GetPrimaryAccounts mocks base method.
+func (m *MockdbRepoProvider) GetPrimaryAccounts(ctx context.Context, date time.Time) ([]bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrimaryAccounts", ctx, date)
+	ret0, _ := ret[0].([]bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrimaryAccounts indicates an expected call of GetPrimaryAccounts.
+func (mr *MockdbRepoProviderMockRecorder) GetPrimaryAccounts(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryAccounts", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPrimaryAccounts), ctx, date)
+}
+
+// InsertBankAccount mocks base method.
+func (m *MockdbRepoProvider) InsertBankAccount(ctx context.Context, account bankaccount.BankAccount) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBankAccount", ctx, account)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertBankAccount indicates an expected call of InsertBankAccount.
+func (mr *MockdbRepoProviderMockRecorder) InsertBankAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBankAccount", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertBankAccount), ctx, account)
+}
+
+// UpdateBankAccountReview mocks base method.
+func (m *MockdbRepoProvider) UpdateBankAccountReview(ctx context.Context, account bankaccount.BankAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBankAccountReview", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBankAccountReview indicates an expected call of UpdateBankAccountReview.
+func (mr *MockdbRepoProviderMockRecorder) UpdateBankAccountReview(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankAccountReview", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateBankAccountReview), ctx, account)
+}
+
+// UpsertAllocation mocks base method.
+func (m *MockdbRepoProvider) UpsertAllocation(ctx context.Context, allocation bankaccount.Allocation) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAllocation", ctx, allocation)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAllocation indicates an expected call of UpsertAllocation.
+func (mr *MockdbRepoProviderMockRecorder) UpsertAllocation(ctx, allocation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAllocation", reflect.TypeOf((*MockdbRepoProvider)(nil).UpsertAllocation), ctx, allocation)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
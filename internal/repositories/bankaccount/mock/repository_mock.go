@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	bankaccount "payslip-generation-system/internal/entity/bankaccount"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBankAccountRepositoryProvider is a mock of BankAccountRepositoryProvider interface.
+type MockBankAccountRepositoryProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankAccountRepositoryProviderMockRecorder
+}
+
+// MockBankAccountRepositoryProviderMockRecorder is the mock recorder for MockBankAccountRepositoryProvider.
+type MockBankAccountRepositoryProviderMockRecorder struct {
+	mock *MockBankAccountRepositoryProvider
+}
+
+// NewMockBankAccountRepositoryProvider creates a new mock instance.
+func NewMockBankAccountRepositoryProvider(ctrl *gomock.Controller) *MockBankAccountRepositoryProvider {
+	mock := &MockBankAccountRepositoryProvider{ctrl: ctrl}
+	mock.recorder = &MockBankAccountRepositoryProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankAccountRepositoryProvider) EXPECT() *MockBankAccountRepositoryProviderMockRecorder {
+	return m.recorder
+}
+
+// DeleteAllocation mocks base method.
+func (m *MockBankAccountRepositoryProvider) DeleteAllocation(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllocation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllocation indicates an expected call of DeleteAllocation.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) DeleteAllocation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllocation", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).DeleteAllocation), ctx, id)
+}
+
+// GetAllocationByID mocks base method.
+func (m *MockBankAccountRepositoryProvider) GetAllocationByID(ctx context.Context, id int) (bankaccount.Allocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocationByID", ctx, id)
+	ret0, _ := ret[0].(bankaccount.Allocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocationByID indicates an expected call of GetAllocationByID.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) GetAllocationByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocationByID", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).GetAllocationByID), ctx, id)
+}
+
+// GetAllocations mocks base method.
+func (m *MockBankAccountRepositoryProvider) GetAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllocations", ctx, userID)
+	ret0, _ := ret[0].([]bankaccount.Allocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllocations indicates an expected call of GetAllocations.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) GetAllocations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllocations", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).GetAllocations), ctx, userID)
+}
+
+// GetBankAccountByID mocks base method.
+func (m *MockBankAccountRepositoryProvider) GetBankAccountByID(ctx context.Context, id int) (bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccountByID", ctx, id)
+	ret0, _ := ret[0].(bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccountByID indicates an expected call of GetBankAccountByID.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) GetBankAccountByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccountByID", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).GetBankAccountByID), ctx, id)
+}
+
+// GetBankAccounts mocks base method.
+func (m *MockBankAccountRepositoryProvider) GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccounts", ctx, userID, status)
+	ret0, _ := ret[0].([]bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccounts indicates an expected call of GetBankAccounts.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) GetBankAccounts(ctx, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccounts", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).GetBankAccounts), ctx, userID, status)
+}
+
+// GetPrimaryAccounts mocks base method.
+func (m *MockBankAccountRepositoryProvider) GetPrimaryAccounts(ctx context.Context, date time.Time) ([]bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrimaryAccounts", ctx, date)
+	ret0, _ := ret[0].([]bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrimaryAccounts indicates an expected call of GetPrimaryAccounts.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) GetPrimaryAccounts(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryAccounts", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).GetPrimaryAccounts), ctx, date)
+}
+
+// InsertBankAccount mocks base method.
+func (m *MockBankAccountRepositoryProvider) InsertBankAccount(ctx context.Context, account bankaccount.BankAccount) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBankAccount", ctx, account)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertBankAccount indicates an expected call of InsertBankAccount.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) InsertBankAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBankAccount", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).InsertBankAccount), ctx, account)
+}
+
+// UpdateBankAccountReview mocks base method.
+func (m *MockBankAccountRepositoryProvider) UpdateBankAccountReview(ctx context.Context, account bankaccount.BankAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBankAccountReview", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBankAccountReview indicates an expected call of UpdateBankAccountReview.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) UpdateBankAccountReview(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBankAccountReview", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).UpdateBankAccountReview), ctx, account)
+}
+
+// UpsertAllocation mocks base method.
+func (m *MockBankAccountRepositoryProvider) UpsertAllocation(ctx context.Context, allocation bankaccount.Allocation) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAllocation", ctx, allocation)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertAllocation indicates an expected call of UpsertAllocation.
+func (mr *MockBankAccountRepositoryProviderMockRecorder) UpsertAllocation(ctx, allocation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAllocation", reflect.TypeOf((*MockBankAccountRepositoryProvider)(nil).UpsertAllocation), ctx, allocation)
+}
@@ -0,0 +1,74 @@
+package bankaccount
+
+const (
+	queryInsertBankAccount = `
+		INSERT INTO employee_bank_accounts (user_id, bank_code, account_number, account_holder, is_primary, effective_date, status, requested_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id;
+	`
+
+	queryGetBankAccountByID = `
+		SELECT id, user_id, bank_code, account_number, account_holder, is_primary, effective_date, status,
+			requested_by, verified_by, verified_at, review_note, created_at, updated_at
+		FROM employee_bank_accounts
+		WHERE id = $1;
+	`
+
+	// 0 and '' leave out the user and status filters
+	queryGetBankAccounts = `
+		SELECT id, user_id, bank_code, account_number, account_holder, is_primary, effective_date, status,
+			requested_by, verified_by, verified_at, review_note, created_at, updated_at
+		FROM employee_bank_accounts
+		WHERE ($1 = 0 OR user_id = $1)
+			AND ($2 = '' OR status = $2)
+		ORDER BY user_id, effective_date DESC, id DESC;
+	`
+
+	queryUpdateBankAccountReview = `
+		UPDATE employee_bank_accounts
+		SET status = $2, verified_by = $3, verified_at = $4, review_note = $5, updated_at = NOW()
+		WHERE id = $1 AND status = 'pending';
+	`
+
+	// the verified primary account with the latest effective date reached on the payment date, per employee
+	queryGetPrimaryAccounts = `
+		SELECT DISTINCT ON (user_id) id, user_id, bank_code, account_number, account_holder, is_primary, effective_date, status,
+			requested_by, verified_by, verified_at, review_note, created_at, updated_at
+		FROM employee_bank_accounts
+		WHERE status = 'verified' AND is_primary AND effective_date <= $1
+		ORDER BY user_id, effective_date DESC, verified_at DESC;
+	`
+
+	// setting the allocation of an account again changes it, so an account has one allocation
+	queryUpsertAllocation = `
+		INSERT INTO salary_allocations (user_id, bank_account_id, method, amount, percentage, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (bank_account_id) DO UPDATE
+		SET method = EXCLUDED.method, amount = EXCLUDED.amount, percentage = EXCLUDED.percentage, updated_at = NOW()
+		RETURNING id;
+	`
+
+	// allocations with the account they pay to, 0 lists the allocations of everyone
+	queryGetAllocations = `
+		SELECT s.id, s.user_id, s.bank_account_id, s.method, s.amount, s.percentage, s.created_by, s.created_at, s.updated_at,
+			a.id, a.user_id, a.bank_code, a.account_number, a.account_holder, a.is_primary, a.effective_date, a.status,
+			a.requested_by, a.verified_by, a.verified_at, a.review_note, a.created_at, a.updated_at
+		FROM salary_allocations s
+		JOIN employee_bank_accounts a ON a.id = s.bank_account_id
+		WHERE ($1 = 0 OR s.user_id = $1)
+		ORDER BY s.user_id, s.id;
+	`
+
+	queryGetAllocationByID = `
+		SELECT s.id, s.user_id, s.bank_account_id, s.method, s.amount, s.percentage, s.created_by, s.created_at, s.updated_at,
+			a.id, a.user_id, a.bank_code, a.account_number, a.account_holder, a.is_primary, a.effective_date, a.status,
+			a.requested_by, a.verified_by, a.verified_at, a.review_note, a.created_at, a.updated_at
+		FROM salary_allocations s
+		JOIN employee_bank_accounts a ON a.id = s.bank_account_id
+		WHERE s.id = $1;
+	`
+
+	queryDeleteAllocation = `
+		DELETE FROM salary_allocations WHERE id = $1;
+	`
+)
@@ -0,0 +1,108 @@
+package bankaccount
+
+import (
+	"context"
+	"time"
+
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/postgres"
+)
+
+//go:generate mockgen -source=repository.go -package=mock -destination=mock/repository_mock.go
+type BankAccountRepositoryProvider interface {
+	InsertBankAccount(ctx context.Context, account bankaccount.BankAccount) (int, error)
+	GetBankAccountByID(ctx context.Context, id int) (bankaccount.BankAccount, error)
+	GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error)
+	UpdateBankAccountReview(ctx context.Context, account bankaccount.BankAccount) error
+	GetPrimaryAccounts(ctx context.Context, date time.Time) ([]bankaccount.BankAccount, error)
+	UpsertAllocation(ctx context.Context, allocation bankaccount.Allocation) (int, error)
+	GetAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error)
+	GetAllocationByID(ctx context.Context, id int) (bankaccount.Allocation, error)
+	DeleteAllocation(ctx context.Context, id int) error
+}
+
+type bankAccountRepository struct {
+	db dbRepoProvider
+}
+
+func NewBankAccountRepository(
+	db *postgres.Postgres,
+) BankAccountRepositoryProvider {
+	return &bankAccountRepository{
+		db: newDBRepo(
+			db,
+		),
+	}
+}
+
+func (r *bankAccountRepository) InsertBankAccount(ctx context.Context, account bankaccount.BankAccount) (int, error) {
+	id, err := r.db.InsertBankAccount(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *bankAccountRepository) GetBankAccountByID(ctx context.Context, id int) (bankaccount.BankAccount, error) {
+	result, err := r.db.GetBankAccountByID(ctx, id)
+	if err != nil {
+		return bankaccount.BankAccount{}, err
+	}
+	return result, nil
+}
+
+func (r *bankAccountRepository) GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error) {
+	result, err := r.db.GetBankAccounts(ctx, userID, status)
+	if err != nil {
+		return []bankaccount.BankAccount{}, err
+	}
+	return result, nil
+}
+
+func (r *bankAccountRepository) UpdateBankAccountReview(ctx context.Context, account bankaccount.BankAccount) error {
+	err := r.db.UpdateBankAccountReview(ctx, account)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *bankAccountRepository) GetPrimaryAccounts(ctx context.Context, date time.Time) ([]bankaccount.BankAccount, error) {
+	result, err := r.db.GetPrimaryAccounts(ctx, date)
+	if err != nil {
+		return []bankaccount.BankAccount{}, err
+	}
+	return result, nil
+}
+
+func (r *bankAccountRepository) UpsertAllocation(ctx context.Context, allocation bankaccount.Allocation) (int, error) {
+	id, err := r.db.UpsertAllocation(ctx, allocation)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *bankAccountRepository) GetAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error) {
+	result, err := r.db.GetAllocations(ctx, userID)
+	if err != nil {
+		return []bankaccount.Allocation{}, err
+	}
+	return result, nil
+}
+
+func (r *bankAccountRepository) GetAllocationByID(ctx context.Context, id int) (bankaccount.Allocation, error) {
+	result, err := r.db.GetAllocationByID(ctx, id)
+	if err != nil {
+		return bankaccount.Allocation{}, err
+	}
+	return result, nil
+}
+
+func (r *bankAccountRepository) DeleteAllocation(ctx context.Context, id int) error {
+	err := r.db.DeleteAllocation(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
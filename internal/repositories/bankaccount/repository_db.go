@@ -0,0 +1,246 @@
+package bankaccount
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/postgres"
+	"time"
+)
+
+//go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
+type dbRepoProvider interface {
+	InsertBankAccount(ctx context.Context, account bankaccount.BankAccount) (int, error)
+	GetBankAccountByID(ctx context.Context, id int) (bankaccount.BankAccount, error)
+	GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error)
+	UpdateBankAccountReview(ctx context.Context, account bankaccount.BankAccount) error
+	GetPrimaryAccounts(ctx context.Context, date time.Time) ([]bankaccount.BankAccount, error)
+	UpsertAllocation(ctx context.Context, allocation bankaccount.Allocation) (int, error)
+	GetAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error)
+	GetAllocationByID(ctx context.Context, id int) (bankaccount.Allocation, error)
+	DeleteAllocation(ctx context.Context, id int) error
+}
+
+type dbRepo struct {
+	db *postgres.Postgres
+}
+
+func newDBRepo(
+	db *postgres.Postgres,
+) dbRepoProvider {
+	return &dbRepo{
+		db: db,
+	}
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBankAccount(row rowScanner) (bankaccount.BankAccount, error) {
+	var a bankaccount.BankAccount
+	var verifiedBy sql.NullInt32
+	var verifiedAt sql.NullTime
+	err := row.Scan(bankAccountFields(&a, &verifiedBy, &verifiedAt)...)
+	if err != nil {
+		return bankaccount.BankAccount{}, err
+	}
+	setVerification(&a, verifiedBy, verifiedAt)
+	return a, nil
+}
+
+// scanAllocation scans an allocation followed by the account it pays to
+func scanAllocation(row rowScanner) (bankaccount.Allocation, error) {
+	var s bankaccount.Allocation
+	var verifiedBy sql.NullInt32
+	var verifiedAt sql.NullTime
+	fields := []any{
+		&s.ID,
+		&s.UserID,
+		&s.BankAccountID,
+		&s.Method,
+		&s.Amount,
+		&s.Percentage,
+		&s.CreatedBy,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	}
+	err := row.Scan(append(fields, bankAccountFields(&s.Account, &verifiedBy, &verifiedAt)...)...)
+	if err != nil {
+		return bankaccount.Allocation{}, err
+	}
+	setVerification(&s.Account, verifiedBy, verifiedAt)
+	return s, nil
+}
+
+func bankAccountFields(a *bankaccount.BankAccount, verifiedBy *sql.NullInt32, verifiedAt *sql.NullTime) []any {
+	return []any{
+		&a.ID,
+		&a.UserID,
+		&a.BankCode,
+		&a.AccountNumber,
+		&a.AccountHolder,
+		&a.IsPrimary,
+		&a.EffectiveDate,
+		&a.Status,
+		&a.RequestedBy,
+		verifiedBy,
+		verifiedAt,
+		&a.ReviewNote,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	}
+}
+
+func setVerification(a *bankaccount.BankAccount, verifiedBy sql.NullInt32, verifiedAt sql.NullTime) {
+	if verifiedBy.Valid {
+		id := int(verifiedBy.Int32)
+		a.VerifiedBy = &id
+	}
+	if verifiedAt.Valid {
+		a.VerifiedAt = &verifiedAt.Time
+	}
+}
+
+func (r *dbRepo) InsertBankAccount(ctx context.Context, account bankaccount.BankAccount) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryInsertBankAccount,
+		account.UserID,
+		account.BankCode,
+		account.AccountNumber,
+		account.AccountHolder,
+		account.IsPrimary,
+		account.EffectiveDate,
+		account.Status,
+		account.RequestedBy,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetBankAccountByID(ctx context.Context, id int) (bankaccount.BankAccount, error) {
+	a, err := scanBankAccount(r.db.DB.QueryRowContext(ctx, queryGetBankAccountByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return bankaccount.BankAccount{}, nil
+		}
+		return bankaccount.BankAccount{}, err
+	}
+	return a, nil
+}
+
+func (r *dbRepo) GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error) {
+	return r.queryBankAccounts(ctx, queryGetBankAccounts, userID, status)
+}
+
+// UpdateBankAccountReview records the review of a pending account, an account reviewed in the meantime is not changed
+func (r *dbRepo) UpdateBankAccountReview(ctx context.Context, account bankaccount.BankAccount) error {
+	result, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateBankAccountReview,
+		account.ID,
+		account.Status,
+		account.VerifiedBy,
+		account.VerifiedAt,
+		account.ReviewNote,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("bank account has already been reviewed")
+	}
+	return nil
+}
+
+func (r *dbRepo) GetPrimaryAccounts(ctx context.Context, date time.Time) ([]bankaccount.BankAccount, error) {
+	return r.queryBankAccounts(ctx, queryGetPrimaryAccounts, date)
+}
+
+func (r *dbRepo) queryBankAccounts(ctx context.Context, query string, args ...any) ([]bankaccount.BankAccount, error) {
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := []bankaccount.BankAccount{}
+	for rows.Next() {
+		a, err := scanBankAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (r *dbRepo) UpsertAllocation(ctx context.Context, allocation bankaccount.Allocation) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryUpsertAllocation,
+		allocation.UserID,
+		allocation.BankAccountID,
+		allocation.Method,
+		allocation.Amount,
+		allocation.Percentage,
+		allocation.CreatedBy,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetAllocations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	allocations := []bankaccount.Allocation{}
+	for rows.Next() {
+		s, err := scanAllocation(rows)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return allocations, nil
+}
+
+func (r *dbRepo) GetAllocationByID(ctx context.Context, id int) (bankaccount.Allocation, error) {
+	s, err := scanAllocation(r.db.DB.QueryRowContext(ctx, queryGetAllocationByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return bankaccount.Allocation{}, nil
+		}
+		return bankaccount.Allocation{}, err
+	}
+	return s, nil
+}
+
+func (r *dbRepo) DeleteAllocation(ctx context.Context, id int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryDeleteAllocation, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
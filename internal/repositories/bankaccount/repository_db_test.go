@@ -0,0 +1,448 @@
+package bankaccount
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/postgres"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var bankAccountColumns = []string{"id", "user_id", "bank_code", "account_number", "account_holder", "is_primary", "effective_date", "status",
+	"requested_by", "verified_by", "verified_at", "review_note", "created_at", "updated_at"}
+
+func getMockBankAccount() bankaccount.BankAccount {
+	verifiedBy := 1
+	verifiedAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	return bankaccount.BankAccount{
+		ID:            7,
+		UserID:        3,
+		BankCode:      "014",
+		AccountNumber: "1234567890",
+		AccountHolder: "BUDI SANTOSO",
+		IsPrimary:     true,
+		EffectiveDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		Status:        bankaccount.StatusVerified,
+		RequestedBy:   3,
+		VerifiedBy:    &verifiedBy,
+		VerifiedAt:    &verifiedAt,
+		ReviewNote:    "checked with the employee",
+		CreatedAt:     time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func bankAccountRow(rows *sqlmock.Rows, a bankaccount.BankAccount) *sqlmock.Rows {
+	var verifiedBy, verifiedAt any
+	if a.VerifiedBy != nil {
+		verifiedBy = *a.VerifiedBy
+	}
+	if a.VerifiedAt != nil {
+		verifiedAt = *a.VerifiedAt
+	}
+	return rows.AddRow(a.ID, a.UserID, a.BankCode, a.AccountNumber, a.AccountHolder, a.IsPrimary, a.EffectiveDate, a.Status,
+		a.RequestedBy, verifiedBy, verifiedAt, a.ReviewNote, a.CreatedAt, a.UpdatedAt)
+}
+
+func Test_newDBRepo(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	want := &dbRepo{db: &postgres.Postgres{DB: db}}
+	if got := newDBRepo(&postgres.Postgres{DB: db}); !reflect.DeepEqual(got, want) {
+		t.Errorf("newDBRepo() = %v, want %v", got, want)
+	}
+}
+
+func Test_dbRepo_InsertBankAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockBankAccount()
+	account.Status = bankaccount.StatusPending
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertBankAccount)).
+					WithArgs(account.UserID, account.BankCode, account.AccountNumber, account.AccountHolder, account.IsPrimary, account.EffectiveDate, account.Status, account.RequestedBy).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+			},
+			want: 7,
+		},
+		{
+			name: "Error Insert",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertBankAccount)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.InsertBankAccount(context.Background(), account)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetBankAccountByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockBankAccount()
+	pending := getMockBankAccount()
+	pending.Status = bankaccount.StatusPending
+	pending.VerifiedBy = nil
+	pending.VerifiedAt = nil
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    bankaccount.BankAccount
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - Verified",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetBankAccountByID)).
+					WithArgs(7).
+					WillReturnRows(bankAccountRow(sqlmock.NewRows(bankAccountColumns), account))
+			},
+			want: account,
+		},
+		{
+			name: "Happy Path - Pending",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetBankAccountByID)).
+					WithArgs(7).
+					WillReturnRows(bankAccountRow(sqlmock.NewRows(bankAccountColumns), pending))
+			},
+			want: pending,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetBankAccountByID)).
+					WithArgs(7).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want: bankaccount.BankAccount{},
+		},
+		{
+			name: "Database Error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetBankAccountByID)).
+					WithArgs(7).
+					WillReturnError(errors.New("connection error"))
+			},
+			want:    bankaccount.BankAccount{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.GetBankAccountByID(context.Background(), 7)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetBankAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockBankAccount()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetBankAccounts)).
+		WithArgs(3, bankaccount.StatusVerified).
+		WillReturnRows(bankAccountRow(sqlmock.NewRows(bankAccountColumns), account))
+	got, err := r.GetBankAccounts(context.Background(), 3, bankaccount.StatusVerified)
+	assert.NoError(t, err)
+	assert.Equal(t, []bankaccount.BankAccount{account}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetBankAccounts)).
+		WithArgs(0, "").
+		WillReturnError(sql.ErrConnDone)
+	_, err = r.GetBankAccounts(context.Background(), 0, "")
+	assert.Error(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_UpdateBankAccountReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockBankAccount()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateBankAccountReview)).
+					WithArgs(account.ID, account.Status, account.VerifiedBy, account.VerifiedAt, account.ReviewNote).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "Already Reviewed",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateBankAccountReview)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Database Error",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateBankAccountReview)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			err := r.UpdateBankAccountReview(context.Background(), account)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetPrimaryAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockBankAccount()
+	date := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPrimaryAccounts)).
+		WithArgs(date).
+		WillReturnRows(bankAccountRow(sqlmock.NewRows(bankAccountColumns), account))
+	got, err := r.GetPrimaryAccounts(context.Background(), date)
+	assert.NoError(t, err)
+	assert.Equal(t, []bankaccount.BankAccount{account}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPrimaryAccounts)).
+		WithArgs(date).
+		WillReturnRows(sqlmock.NewRows(bankAccountColumns))
+	got, err = r.GetPrimaryAccounts(context.Background(), date)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+var allocationColumns = append([]string{"id", "user_id", "bank_account_id", "method", "amount", "percentage", "created_by", "created_at", "updated_at"},
+	bankAccountColumns...)
+
+func getMockAllocation() bankaccount.Allocation {
+	account := getMockBankAccount()
+	account.ID = 8
+	account.BankCode = "008"
+	account.AccountNumber = "1230004567890"
+	account.IsPrimary = false
+	return bankaccount.Allocation{
+		ID:            2,
+		UserID:        3,
+		BankAccountID: 8,
+		Method:        bankaccount.AllocationPercentage,
+		Percentage:    20,
+		CreatedBy:     3,
+		CreatedAt:     time.Date(2025, 6, 3, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2025, 6, 3, 8, 0, 0, 0, time.UTC),
+		Account:       account,
+	}
+}
+
+func allocationRow(rows *sqlmock.Rows, s bankaccount.Allocation) *sqlmock.Rows {
+	a := s.Account
+	return rows.AddRow(s.ID, s.UserID, s.BankAccountID, s.Method, s.Amount, s.Percentage, s.CreatedBy, s.CreatedAt, s.UpdatedAt,
+		a.ID, a.UserID, a.BankCode, a.AccountNumber, a.AccountHolder, a.IsPrimary, a.EffectiveDate, a.Status,
+		a.RequestedBy, *a.VerifiedBy, *a.VerifiedAt, a.ReviewNote, a.CreatedAt, a.UpdatedAt)
+}
+
+func Test_dbRepo_UpsertAllocation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	allocation := getMockAllocation()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpsertAllocation)).
+		WithArgs(allocation.UserID, allocation.BankAccountID, allocation.Method, allocation.Amount, allocation.Percentage, allocation.CreatedBy).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	got, err := r.UpsertAllocation(context.Background(), allocation)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpsertAllocation)).
+		WillReturnError(sql.ErrConnDone)
+	got, err = r.UpsertAllocation(context.Background(), allocation)
+	assert.Error(t, err)
+	assert.Equal(t, 0, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetAllocations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	allocation := getMockAllocation()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetAllocations)).
+		WithArgs(3).
+		WillReturnRows(allocationRow(sqlmock.NewRows(allocationColumns), allocation))
+	got, err := r.GetAllocations(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []bankaccount.Allocation{allocation}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetAllocations)).
+		WithArgs(0).
+		WillReturnError(sql.ErrConnDone)
+	_, err = r.GetAllocations(context.Background(), 0)
+	assert.Error(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetAllocationByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	allocation := getMockAllocation()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetAllocationByID)).
+		WithArgs(2).
+		WillReturnRows(allocationRow(sqlmock.NewRows(allocationColumns), allocation))
+	got, err := r.GetAllocationByID(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, allocation, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetAllocationByID)).
+		WithArgs(2).
+		WillReturnError(sql.ErrNoRows)
+	got, err = r.GetAllocationByID(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, bankaccount.Allocation{}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_DeleteAllocation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectExec(regexp.QuoteMeta(queryDeleteAllocation)).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, r.DeleteAllocation(context.Background(), 2))
+
+	mock.ExpectExec(regexp.QuoteMeta(queryDeleteAllocation)).
+		WithArgs(2).
+		WillReturnError(sql.ErrConnDone)
+	assert.Error(t, r.DeleteAllocation(context.Background(), 2))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
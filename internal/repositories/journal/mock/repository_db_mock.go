@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository_db.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	journal "payslip-generation-system/internal/entity/journal"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// GetJournalAccount mocks base method.
+func (m *MockdbRepoProvider) GetJournalAccount(ctx context.Context, component, costCenter string) (journal.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalAccount", ctx, component, costCenter)
+	ret0, _ := ret[0].(journal.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalAccount indicates an expected call of GetJournalAccount.
+func (mr *MockdbRepoProviderMockRecorder) GetJournalAccount(ctx, component, costCenter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalAccount", reflect.TypeOf((*MockdbRepoProvider)(nil).GetJournalAccount), ctx, component, costCenter)
+}
+
+// GetJournalAccounts mocks base method.
+func (m *MockdbRepoProvider) GetJournalAccounts(ctx context.Context) ([]journal.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalAccounts", ctx)
+	ret0, _ := ret[0].([]journal.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalAccounts indicates an expected call of GetJournalAccounts.
+func (mr *MockdbRepoProviderMockRecorder) GetJournalAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalAccounts", reflect.TypeOf((*MockdbRepoProvider)(nil).GetJournalAccounts), ctx)
+}
+
+// UpsertJournalAccount mocks base method.
+func (m *MockdbRepoProvider) UpsertJournalAccount(ctx context.Context, account journal.Account) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertJournalAccount", ctx, account)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertJournalAccount indicates an expected call of UpsertJournalAccount.
+func (mr *MockdbRepoProviderMockRecorder) UpsertJournalAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertJournalAccount", reflect.TypeOf((*MockdbRepoProvider)(nil).UpsertJournalAccount), ctx, account)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
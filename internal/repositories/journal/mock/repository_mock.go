@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	journal "payslip-generation-system/internal/entity/journal"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJournalRepositoryProvider is a mock of JournalRepositoryProvider interface.
+type MockJournalRepositoryProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalRepositoryProviderMockRecorder
+}
+
+// MockJournalRepositoryProviderMockRecorder is the mock recorder for MockJournalRepositoryProvider.
+type MockJournalRepositoryProviderMockRecorder struct {
+	mock *MockJournalRepositoryProvider
+}
+
+// NewMockJournalRepositoryProvider creates a new mock instance.
+func NewMockJournalRepositoryProvider(ctrl *gomock.Controller) *MockJournalRepositoryProvider {
+	mock := &MockJournalRepositoryProvider{ctrl: ctrl}
+	mock.recorder = &MockJournalRepositoryProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournalRepositoryProvider) EXPECT() *MockJournalRepositoryProviderMockRecorder {
+	return m.recorder
+}
+
+// GetJournalAccount mocks base method.
+func (m *MockJournalRepositoryProvider) GetJournalAccount(ctx context.Context, component, costCenter string) (journal.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalAccount", ctx, component, costCenter)
+	ret0, _ := ret[0].(journal.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalAccount indicates an expected call of GetJournalAccount.
+func (mr *MockJournalRepositoryProviderMockRecorder) GetJournalAccount(ctx, component, costCenter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalAccount", reflect.TypeOf((*MockJournalRepositoryProvider)(nil).GetJournalAccount), ctx, component, costCenter)
+}
+
+// GetJournalAccounts mocks base method.
+func (m *MockJournalRepositoryProvider) GetJournalAccounts(ctx context.Context) ([]journal.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalAccounts", ctx)
+	ret0, _ := ret[0].([]journal.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalAccounts indicates an expected call of GetJournalAccounts.
+func (mr *MockJournalRepositoryProviderMockRecorder) GetJournalAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalAccounts", reflect.TypeOf((*MockJournalRepositoryProvider)(nil).GetJournalAccounts), ctx)
+}
+
+// UpsertJournalAccount mocks base method.
+func (m *MockJournalRepositoryProvider) UpsertJournalAccount(ctx context.Context, account journal.Account) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertJournalAccount", ctx, account)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertJournalAccount indicates an expected call of UpsertJournalAccount.
+func (mr *MockJournalRepositoryProviderMockRecorder) UpsertJournalAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertJournalAccount", reflect.TypeOf((*MockJournalRepositoryProvider)(nil).UpsertJournalAccount), ctx, account)
+}
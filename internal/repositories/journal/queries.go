@@ -0,0 +1,24 @@
+package journal
+
+const (
+	queryGetJournalAccounts = `
+		SELECT id, component, cost_center, account_code, account_name, updated_by, created_at, updated_at
+		FROM journal_accounts
+		ORDER BY cost_center, component;
+	`
+
+	queryGetJournalAccount = `
+		SELECT id, component, cost_center, account_code, account_name, updated_by, created_at, updated_at
+		FROM journal_accounts
+		WHERE component = $1 AND cost_center = $2;
+	`
+
+	// mapping a component of a cost center again changes its account, so it has one account
+	queryUpsertJournalAccount = `
+		INSERT INTO journal_accounts (component, cost_center, account_code, account_name, updated_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (component, cost_center) DO UPDATE
+		SET account_code = EXCLUDED.account_code, account_name = EXCLUDED.account_name, updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		RETURNING id;
+	`
+)
@@ -0,0 +1,53 @@
+package journal
+
+import (
+	"context"
+
+	"payslip-generation-system/internal/entity/journal"
+	"payslip-generation-system/internal/postgres"
+)
+
+//go:generate mockgen -source=repository.go -package=mock -destination=mock/repository_mock.go
+type JournalRepositoryProvider interface {
+	GetJournalAccounts(ctx context.Context) ([]journal.Account, error)
+	GetJournalAccount(ctx context.Context, component, costCenter string) (journal.Account, error)
+	UpsertJournalAccount(ctx context.Context, account journal.Account) (int, error)
+}
+
+type journalRepository struct {
+	db dbRepoProvider
+}
+
+func NewJournalRepository(
+	db *postgres.Postgres,
+) JournalRepositoryProvider {
+	return &journalRepository{
+		db: newDBRepo(
+			db,
+		),
+	}
+}
+
+func (r *journalRepository) GetJournalAccounts(ctx context.Context) ([]journal.Account, error) {
+	result, err := r.db.GetJournalAccounts(ctx)
+	if err != nil {
+		return []journal.Account{}, err
+	}
+	return result, nil
+}
+
+func (r *journalRepository) GetJournalAccount(ctx context.Context, component, costCenter string) (journal.Account, error) {
+	result, err := r.db.GetJournalAccount(ctx, component, costCenter)
+	if err != nil {
+		return journal.Account{}, err
+	}
+	return result, nil
+}
+
+func (r *journalRepository) UpsertJournalAccount(ctx context.Context, account journal.Account) (int, error) {
+	id, err := r.db.UpsertJournalAccount(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
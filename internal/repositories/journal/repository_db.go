@@ -0,0 +1,103 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"payslip-generation-system/internal/entity/journal"
+	"payslip-generation-system/internal/postgres"
+)
+
+//go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
+type dbRepoProvider interface {
+	GetJournalAccounts(ctx context.Context) ([]journal.Account, error)
+	GetJournalAccount(ctx context.Context, component, costCenter string) (journal.Account, error)
+	UpsertJournalAccount(ctx context.Context, account journal.Account) (int, error)
+}
+
+type dbRepo struct {
+	db *postgres.Postgres
+}
+
+func newDBRepo(
+	db *postgres.Postgres,
+) dbRepoProvider {
+	return &dbRepo{
+		db: db,
+	}
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccount(row rowScanner) (journal.Account, error) {
+	var a journal.Account
+	var updatedBy sql.NullInt32
+	err := row.Scan(
+		&a.ID,
+		&a.Component,
+		&a.CostCenter,
+		&a.Code,
+		&a.Name,
+		&updatedBy,
+		&a.CreatedAt,
+		&a.UpdatedAt,
+	)
+	if err != nil {
+		return journal.Account{}, err
+	}
+	if updatedBy.Valid {
+		id := int(updatedBy.Int32)
+		a.UpdatedBy = &id
+	}
+	return a, nil
+}
+
+func (r *dbRepo) GetJournalAccounts(ctx context.Context) ([]journal.Account, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetJournalAccounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accounts := []journal.Account{}
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (r *dbRepo) GetJournalAccount(ctx context.Context, component, costCenter string) (journal.Account, error) {
+	a, err := scanAccount(r.db.DB.QueryRowContext(ctx, queryGetJournalAccount, component, costCenter))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return journal.Account{}, nil
+		}
+		return journal.Account{}, err
+	}
+	return a, nil
+}
+
+func (r *dbRepo) UpsertJournalAccount(ctx context.Context, account journal.Account) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryUpsertJournalAccount,
+		account.Component,
+		account.CostCenter,
+		account.Code,
+		account.Name,
+		account.UpdatedBy,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
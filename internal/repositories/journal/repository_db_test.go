@@ -0,0 +1,222 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"payslip-generation-system/internal/entity/journal"
+	"payslip-generation-system/internal/postgres"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var accountColumns = []string{"id", "component", "cost_center", "account_code", "account_name", "updated_by", "created_at", "updated_at"}
+
+func getMockAccount() journal.Account {
+	updatedBy := 1
+	return journal.Account{
+		ID:         4,
+		Component:  journal.ComponentSalary,
+		CostCenter: "OPS",
+		Code:       "6101",
+		Name:       "Salary expense - operations",
+		UpdatedBy:  &updatedBy,
+		CreatedAt:  time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func Test_newDBRepo(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	want := &dbRepo{db: &postgres.Postgres{DB: db}}
+	if got := newDBRepo(&postgres.Postgres{DB: db}); !reflect.DeepEqual(got, want) {
+		t.Errorf("newDBRepo() = %v, want %v", got, want)
+	}
+}
+
+func Test_dbRepo_GetJournalAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockAccount()
+	seeded := journal.Account{
+		ID:        1,
+		Component: journal.ComponentNetPay,
+		Code:      "2100",
+		Name:      "Net pay payable",
+		CreatedAt: time.Date(2025, 5, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 5, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []journal.Account
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetJournalAccounts)).
+					WillReturnRows(sqlmock.NewRows(accountColumns).
+						AddRow(seeded.ID, seeded.Component, seeded.CostCenter, seeded.Code, seeded.Name, nil, seeded.CreatedAt, seeded.UpdatedAt).
+						AddRow(account.ID, account.Component, account.CostCenter, account.Code, account.Name, *account.UpdatedBy, account.CreatedAt, account.UpdatedAt))
+			},
+			want: []journal.Account{seeded, account},
+		},
+		{
+			name: "Error Query",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetJournalAccounts)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.GetJournalAccounts(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetJournalAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockAccount()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    journal.Account
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetJournalAccount)).
+					WithArgs(account.Component, account.CostCenter).
+					WillReturnRows(sqlmock.NewRows(accountColumns).
+						AddRow(account.ID, account.Component, account.CostCenter, account.Code, account.Name, *account.UpdatedBy, account.CreatedAt, account.UpdatedAt))
+			},
+			want: account,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetJournalAccount)).
+					WithArgs(account.Component, account.CostCenter).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want: journal.Account{},
+		},
+		{
+			name: "Error Query",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetJournalAccount)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    journal.Account{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.GetJournalAccount(context.Background(), account.Component, account.CostCenter)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpsertJournalAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	account := getMockAccount()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryUpsertJournalAccount)).
+					WithArgs(account.Component, account.CostCenter, account.Code, account.Name, account.UpdatedBy).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+			},
+			want: 4,
+		},
+		{
+			name: "Error Upsert",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryUpsertJournalAccount)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.UpsertJournalAccount(context.Background(), account)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	overtime "payslip-generation-system/internal/entity/overtime"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	reflect "reflect"
 	time "time"
 
@@ -36,6 +37,35 @@ func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
 	return m.recorder
 }
 
+// DeleteOvertime mocks base method.
+func (m *MockdbRepoProvider) DeleteOvertime(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOvertime", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOvertime indicates an expected call of DeleteOvertime.
+func (mr *MockdbRepoProviderMockRecorder) DeleteOvertime(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOvertime", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteOvertime), ctx, id)
+}
+
+// GetOverlappingOvertime mocks base method.
+func (m *MockdbRepoProvider) GetOverlappingOvertime(ctx context.Context, userID int, startAt, endAt time.Time, excludeID int) (overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverlappingOvertime", ctx, userID, startAt, endAt, excludeID)
+	ret0, _ := ret[0].(overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverlappingOvertime indicates an expected call of GetOverlappingOvertime.
+func (mr *MockdbRepoProviderMockRecorder) GetOverlappingOvertime(ctx, userID, startAt, endAt, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverlappingOvertime", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOverlappingOvertime), ctx, userID, startAt, endAt, excludeID)
+}
+
 // GetOvertime mocks base method.
 func (m *MockdbRepoProvider) GetOvertime(ctx context.Context, userID, periodID int, date time.Time) (overtime.Overtime, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +81,67 @@ func (mr *MockdbRepoProviderMockRecorder) GetOvertime(ctx, userID, periodID, dat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertime", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOvertime), ctx, userID, periodID, date)
 }
 
+// GetOvertimeMinutesInRange mocks base method.
+func (m *MockdbRepoProvider) GetOvertimeMinutesInRange(ctx context.Context, userID int, otType string, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimeMinutesInRange", ctx, userID, otType, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimeMinutesInRange indicates an expected call of GetOvertimeMinutesInRange.
+func (mr *MockdbRepoProviderMockRecorder) GetOvertimeMinutesInRange(ctx, userID, otType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimeMinutesInRange", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOvertimeMinutesInRange), ctx, userID, otType, from, to)
+}
+
+// GetOvertimesByIDs mocks base method.
+func (m *MockdbRepoProvider) GetOvertimesByIDs(ctx context.Context, ids []int) ([]overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimesByIDs", ctx, ids)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimesByIDs indicates an expected call of GetOvertimesByIDs.
+func (mr *MockdbRepoProviderMockRecorder) GetOvertimesByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimesByIDs", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOvertimesByIDs), ctx, ids)
+}
+
+// GetOvertimesByStatus mocks base method.
+func (m *MockdbRepoProvider) GetOvertimesByStatus(ctx context.Context, status string, periodID, managerID int) ([]overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimesByStatus", ctx, status, periodID, managerID)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimesByStatus indicates an expected call of GetOvertimesByStatus.
+func (mr *MockdbRepoProviderMockRecorder) GetOvertimesByStatus(ctx, status, periodID, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimesByStatus", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOvertimesByStatus), ctx, status, periodID, managerID)
+}
+
+// GetOvertimesByUserID mocks base method.
+func (m *MockdbRepoProvider) GetOvertimesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimesByUserID", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOvertimesByUserID indicates an expected call of GetOvertimesByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetOvertimesByUserID(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimesByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetOvertimesByUserID), ctx, userID, periodID, page)
+}
+
 // InsertOvertime mocks base method.
 func (m *MockdbRepoProvider) InsertOvertime(ctx context.Context, ot overtime.Overtime) (int, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +156,72 @@ func (mr *MockdbRepoProviderMockRecorder) InsertOvertime(ctx, ot interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOvertime", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertOvertime), ctx, ot)
 }
+
+// UpdateOvertime mocks base method.
+func (m *MockdbRepoProvider) UpdateOvertime(ctx context.Context, ot overtime.Overtime) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOvertime", ctx, ot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOvertime indicates an expected call of UpdateOvertime.
+func (mr *MockdbRepoProviderMockRecorder) UpdateOvertime(ctx, ot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOvertime", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateOvertime), ctx, ot)
+}
+
+// UpdateOvertimeReviews mocks base method.
+func (m *MockdbRepoProvider) UpdateOvertimeReviews(ctx context.Context, overtimes []overtime.Overtime) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOvertimeReviews", ctx, overtimes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOvertimeReviews indicates an expected call of UpdateOvertimeReviews.
+func (mr *MockdbRepoProviderMockRecorder) UpdateOvertimeReviews(ctx, overtimes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOvertimeReviews", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateOvertimeReviews), ctx, overtimes)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	overtime "payslip-generation-system/internal/entity/overtime"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	reflect "reflect"
 	time "time"
 
@@ -36,6 +37,35 @@ func (m *MockOvertimeRepositoryProvider) EXPECT() *MockOvertimeRepositoryProvide
 	return m.recorder
 }
 
+// DeleteOvertime mocks base method.
+func (m *MockOvertimeRepositoryProvider) DeleteOvertime(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOvertime", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOvertime indicates an expected call of DeleteOvertime.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) DeleteOvertime(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOvertime", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).DeleteOvertime), ctx, id)
+}
+
+// GetOverlappingOvertime mocks base method.
+func (m *MockOvertimeRepositoryProvider) GetOverlappingOvertime(ctx context.Context, userID int, startAt, endAt time.Time, excludeID int) (overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverlappingOvertime", ctx, userID, startAt, endAt, excludeID)
+	ret0, _ := ret[0].(overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverlappingOvertime indicates an expected call of GetOverlappingOvertime.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) GetOverlappingOvertime(ctx, userID, startAt, endAt, excludeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverlappingOvertime", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).GetOverlappingOvertime), ctx, userID, startAt, endAt, excludeID)
+}
+
 // GetOvertime mocks base method.
 func (m *MockOvertimeRepositoryProvider) GetOvertime(ctx context.Context, userID, periodID int, date time.Time) (overtime.Overtime, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +81,67 @@ func (mr *MockOvertimeRepositoryProviderMockRecorder) GetOvertime(ctx, userID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertime", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).GetOvertime), ctx, userID, periodID, date)
 }
 
+// GetOvertimeMinutesInRange mocks base method.
+func (m *MockOvertimeRepositoryProvider) GetOvertimeMinutesInRange(ctx context.Context, userID int, otType string, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimeMinutesInRange", ctx, userID, otType, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimeMinutesInRange indicates an expected call of GetOvertimeMinutesInRange.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) GetOvertimeMinutesInRange(ctx, userID, otType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimeMinutesInRange", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).GetOvertimeMinutesInRange), ctx, userID, otType, from, to)
+}
+
+// GetOvertimesByIDs mocks base method.
+func (m *MockOvertimeRepositoryProvider) GetOvertimesByIDs(ctx context.Context, ids []int) ([]overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimesByIDs", ctx, ids)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimesByIDs indicates an expected call of GetOvertimesByIDs.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) GetOvertimesByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimesByIDs", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).GetOvertimesByIDs), ctx, ids)
+}
+
+// GetOvertimesByStatus mocks base method.
+func (m *MockOvertimeRepositoryProvider) GetOvertimesByStatus(ctx context.Context, status string, periodID, managerID int) ([]overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimesByStatus", ctx, status, periodID, managerID)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimesByStatus indicates an expected call of GetOvertimesByStatus.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) GetOvertimesByStatus(ctx, status, periodID, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimesByStatus", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).GetOvertimesByStatus), ctx, status, periodID, managerID)
+}
+
+// GetOvertimesByUserID mocks base method.
+func (m *MockOvertimeRepositoryProvider) GetOvertimesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimesByUserID", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOvertimesByUserID indicates an expected call of GetOvertimesByUserID.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) GetOvertimesByUserID(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimesByUserID", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).GetOvertimesByUserID), ctx, userID, periodID, page)
+}
+
 // InsertOvertime mocks base method.
 func (m *MockOvertimeRepositoryProvider) InsertOvertime(ctx context.Context, ot overtime.Overtime) (int, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +156,31 @@ func (mr *MockOvertimeRepositoryProviderMockRecorder) InsertOvertime(ctx, ot int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOvertime", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).InsertOvertime), ctx, ot)
 }
+
+// UpdateOvertime mocks base method.
+func (m *MockOvertimeRepositoryProvider) UpdateOvertime(ctx context.Context, ot overtime.Overtime) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOvertime", ctx, ot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOvertime indicates an expected call of UpdateOvertime.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) UpdateOvertime(ctx, ot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOvertime", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).UpdateOvertime), ctx, ot)
+}
+
+// UpdateOvertimeReviews mocks base method.
+func (m *MockOvertimeRepositoryProvider) UpdateOvertimeReviews(ctx context.Context, overtimes []overtime.Overtime) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOvertimeReviews", ctx, overtimes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOvertimeReviews indicates an expected call of UpdateOvertimeReviews.
+func (mr *MockOvertimeRepositoryProviderMockRecorder) UpdateOvertimeReviews(ctx, overtimes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOvertimeReviews", reflect.TypeOf((*MockOvertimeRepositoryProvider)(nil).UpdateOvertimeReviews), ctx, overtimes)
+}
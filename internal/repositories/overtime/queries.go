@@ -6,12 +6,24 @@ const (
 			user_id,
 			period_id,
 			date,
-			hours
+			start_at,
+			end_at,
+			minutes,
+			type,
+			status,
+			limit_overridden_by,
+			limit_override_reason
 		) VALUES (
 			$1,
 			$2,
 			$3,
-			$4
+			$4,
+			$5,
+			$6,
+			$7,
+			$8,
+			$9,
+			$10
 		) RETURNING id;
 	`
 
@@ -21,10 +33,168 @@ const (
 			user_id,
 			period_id,
 			date,
-			hours,
+			start_at,
+			end_at,
+			minutes,
+			type,
+			status,
+			limit_overridden_by,
+			limit_override_reason,
+			reviewed_by,
+			review_note,
+			reviewed_at,
 			created_at,
 			updated_at
 		FROM overtimes
 		WHERE user_id = $1 AND period_id = $2 AND date = $3;
 	`
-)
\ No newline at end of file
+
+	queryGetOvertimesByIDs = `
+		SELECT 
+			id,
+			user_id,
+			period_id,
+			date,
+			start_at,
+			end_at,
+			minutes,
+			type,
+			status,
+			limit_overridden_by,
+			limit_override_reason,
+			reviewed_by,
+			review_note,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM overtimes
+		WHERE id = ANY($1)
+		ORDER BY id;
+	`
+
+	// $2 and $3 are optional filters, 0 matches every period / every manager
+	queryGetOvertimesByStatus = `
+		SELECT 
+			o.id,
+			o.user_id,
+			o.period_id,
+			o.date,
+			o.start_at,
+			o.end_at,
+			o.minutes,
+			o.type,
+			o.status,
+			o.limit_overridden_by,
+			o.limit_override_reason,
+			o.reviewed_by,
+			o.review_note,
+			o.reviewed_at,
+			o.created_at,
+			o.updated_at
+		FROM overtimes o
+		JOIN users u ON u.id = o.user_id
+		WHERE o.status = $1
+			AND ($2::int = 0 OR o.period_id = $2)
+			AND ($3::int = 0 OR u.manager_id = $3)
+		ORDER BY o.date, o.id;
+	`
+
+	queryGetOvertimeMinutesInRange = `
+		SELECT COALESCE(SUM(minutes), 0)
+		FROM overtimes
+		WHERE user_id = $1
+			AND type = $2
+			AND status <> 'rejected'
+			AND date BETWEEN $3 AND $4;
+	`
+
+	// rejected overtime does not block the time range, entries recorded without start and end never overlap
+	// $4 excludes the overtime being amended, 0 when the overtime is new
+	queryGetOverlappingOvertime = `
+		SELECT 
+			id,
+			user_id,
+			period_id,
+			date,
+			start_at,
+			end_at,
+			minutes,
+			type,
+			status,
+			limit_overridden_by,
+			limit_override_reason,
+			reviewed_by,
+			review_note,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM overtimes
+		WHERE user_id = $1
+			AND status <> 'rejected'
+			AND start_at < $3
+			AND end_at > $2
+			AND id <> $4
+		ORDER BY start_at
+		LIMIT 1;
+	`
+
+	queryUpdateOvertimeReview = `
+		UPDATE overtimes
+		SET
+			status = $2,
+			reviewed_by = $3,
+			review_note = $4,
+			reviewed_at = $5,
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'pending';
+	`
+
+	// $2 is optional, period 0 lists every period
+	queryCountOvertimesByUserID = `
+		SELECT COUNT(*)
+		FROM overtimes
+		WHERE user_id = $1 AND ($2::int = 0 OR period_id = $2);
+	`
+
+	queryGetOvertimesByUserID = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			date,
+			start_at,
+			end_at,
+			minutes,
+			type,
+			status,
+			limit_overridden_by,
+			limit_override_reason,
+			reviewed_by,
+			review_note,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM overtimes
+		WHERE user_id = $1 AND ($2::int = 0 OR period_id = $2)
+		ORDER BY date DESC, id DESC
+		LIMIT $3 OFFSET $4;
+	`
+
+	// only the times, and so the date and duration, of pending overtime can be amended
+	queryUpdateOvertime = `
+		UPDATE overtimes
+		SET
+			date = $2,
+			start_at = $3,
+			end_at = $4,
+			minutes = $5,
+			type = $6,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	queryDeleteOvertime = `
+		DELETE FROM overtimes
+		WHERE id = $1;
+	`
+)
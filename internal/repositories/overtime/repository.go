@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/postgres"
 )
 
@@ -12,6 +13,14 @@ import (
 type OvertimeRepositoryProvider interface {
 	InsertOvertime(ctx context.Context, ot overtime.Overtime) (int, error) 
 	GetOvertime(ctx context.Context, userID, periodID int, date time.Time) (overtime.Overtime, error)
+	GetOvertimeMinutesInRange(ctx context.Context, userID int, otType string, from, to time.Time) (int, error)
+	GetOverlappingOvertime(ctx context.Context, userID int, startAt, endAt time.Time, excludeID int) (overtime.Overtime, error)
+	GetOvertimesByIDs(ctx context.Context, ids []int) ([]overtime.Overtime, error)
+	GetOvertimesByStatus(ctx context.Context, status string, periodID, managerID int) ([]overtime.Overtime, error)
+	UpdateOvertimeReviews(ctx context.Context, overtimes []overtime.Overtime) error
+	GetOvertimesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error)
+	UpdateOvertime(ctx context.Context, ot overtime.Overtime) error
+	DeleteOvertime(ctx context.Context, id int) error
 }
 
 type overtimeRepository struct {
@@ -44,3 +53,67 @@ func (r *overtimeRepository) GetOvertime(ctx context.Context, userID, periodID i
 	}
 	return result, nil
 }
+
+func (r *overtimeRepository) GetOvertimeMinutesInRange(ctx context.Context, userID int, otType string, from, to time.Time) (int, error) {
+	minutes, err := r.db.GetOvertimeMinutesInRange(ctx, userID, otType, from, to)
+	if err != nil {
+		return 0, err
+	}
+	return minutes, nil
+}
+
+func (r *overtimeRepository) GetOverlappingOvertime(ctx context.Context, userID int, startAt, endAt time.Time, excludeID int) (overtime.Overtime, error) {
+	result, err := r.db.GetOverlappingOvertime(ctx, userID, startAt, endAt, excludeID)
+	if err != nil {
+		return overtime.Overtime{}, err
+	}
+	return result, nil
+}
+
+func (r *overtimeRepository) GetOvertimesByIDs(ctx context.Context, ids []int) ([]overtime.Overtime, error) {
+	result, err := r.db.GetOvertimesByIDs(ctx, ids)
+	if err != nil {
+		return []overtime.Overtime{}, err
+	}
+	return result, nil
+}
+
+func (r *overtimeRepository) GetOvertimesByStatus(ctx context.Context, status string, periodID, managerID int) ([]overtime.Overtime, error) {
+	result, err := r.db.GetOvertimesByStatus(ctx, status, periodID, managerID)
+	if err != nil {
+		return []overtime.Overtime{}, err
+	}
+	return result, nil
+}
+
+func (r *overtimeRepository) UpdateOvertimeReviews(ctx context.Context, overtimes []overtime.Overtime) error {
+	err := r.db.UpdateOvertimeReviews(ctx, overtimes)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *overtimeRepository) GetOvertimesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error) {
+	result, total, err := r.db.GetOvertimesByUserID(ctx, userID, periodID, page)
+	if err != nil {
+		return []overtime.Overtime{}, 0, err
+	}
+	return result, total, nil
+}
+
+func (r *overtimeRepository) UpdateOvertime(ctx context.Context, ot overtime.Overtime) error {
+	err := r.db.UpdateOvertime(ctx, ot)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *overtimeRepository) DeleteOvertime(ctx context.Context, id int) error {
+	err := r.db.DeleteOvertime(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
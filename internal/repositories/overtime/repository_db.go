@@ -3,15 +3,27 @@ package overtime
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/postgres"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 //go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
 type dbRepoProvider interface {
 	InsertOvertime(ctx context.Context, ot overtime.Overtime) (int, error) 
 	GetOvertime(ctx context.Context, userID, periodID int, date time.Time) (overtime.Overtime, error)
+	GetOvertimeMinutesInRange(ctx context.Context, userID int, otType string, from, to time.Time) (int, error)
+	GetOverlappingOvertime(ctx context.Context, userID int, startAt, endAt time.Time, excludeID int) (overtime.Overtime, error)
+	GetOvertimesByIDs(ctx context.Context, ids []int) ([]overtime.Overtime, error)
+	GetOvertimesByStatus(ctx context.Context, status string, periodID, managerID int) ([]overtime.Overtime, error)
+	UpdateOvertimeReviews(ctx context.Context, overtimes []overtime.Overtime) error
+	GetOvertimesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error)
+	UpdateOvertime(ctx context.Context, ot overtime.Overtime) error
+	DeleteOvertime(ctx context.Context, id int) error
 }
 
 type dbRepo struct {
@@ -34,7 +46,13 @@ func (r *dbRepo) InsertOvertime(ctx context.Context, ot overtime.Overtime) (int,
 		ot.UserID,
 		ot.PeriodID,
 		ot.Date,
-		ot.Hours,
+		ot.StartAt,
+		ot.EndAt,
+		ot.Minutes,
+		ot.Type,
+		ot.Status,
+		ot.LimitOverriddenBy,
+		ot.LimitOverrideReason,
 	).Scan(&id)
 	if err != nil {
 		return 0, err
@@ -45,22 +63,173 @@ func (r *dbRepo) InsertOvertime(ctx context.Context, ot overtime.Overtime) (int,
 func (r *dbRepo) GetOvertime(ctx context.Context, userID, periodID int, date time.Time) (overtime.Overtime, error) {
 	row := r.db.DB.QueryRowContext(ctx, queryGetOvertime, userID, periodID, date)
 
+	ot, err := scanOvertime(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return overtime.Overtime{}, nil
+		}
+		return overtime.Overtime{}, err
+	}
+
+	return ot, nil
+}
+
+func (r *dbRepo) GetOvertimeMinutesInRange(ctx context.Context, userID int, otType string, from, to time.Time) (int, error) {
+	var minutes int
+	err := r.db.DB.QueryRowContext(ctx, queryGetOvertimeMinutesInRange, userID, otType, from, to).Scan(&minutes)
+	if err != nil {
+		return 0, err
+	}
+	return minutes, nil
+}
+
+func (r *dbRepo) GetOverlappingOvertime(ctx context.Context, userID int, startAt, endAt time.Time, excludeID int) (overtime.Overtime, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetOverlappingOvertime, userID, startAt, endAt, excludeID)
+
+	ot, err := scanOvertime(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return overtime.Overtime{}, nil
+		}
+		return overtime.Overtime{}, err
+	}
+
+	return ot, nil
+}
+
+func (r *dbRepo) GetOvertimesByIDs(ctx context.Context, ids []int) ([]overtime.Overtime, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetOvertimesByIDs, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	return scanOvertimes(rows)
+}
+
+func (r *dbRepo) GetOvertimesByStatus(ctx context.Context, status string, periodID, managerID int) ([]overtime.Overtime, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetOvertimesByStatus, status, periodID, managerID)
+	if err != nil {
+		return nil, err
+	}
+	return scanOvertimes(rows)
+}
+
+// UpdateOvertimeReviews records the review of a batch of overtime in one transaction. Only overtime that is still
+// pending is changed, so if any entry was withdrawn or reviewed in the meantime none of the batch is
+func (r *dbRepo) UpdateOvertimeReviews(ctx context.Context, overtimes []overtime.Overtime) error {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, ot := range overtimes {
+		result, err := tx.ExecContext(
+			ctx,
+			queryUpdateOvertimeReview,
+			ot.ID,
+			ot.Status,
+			ot.ReviewedBy,
+			ot.ReviewNote,
+			ot.ReviewedAt,
+		)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected != 1 {
+			return fmt.Errorf("overtime %d is no longer pending", ot.ID)
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *dbRepo) GetOvertimesByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error) {
+	var total int
+	err := r.db.DB.QueryRowContext(ctx, queryCountOvertimesByUserID, userID, periodID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.DB.QueryContext(ctx, queryGetOvertimesByUserID, userID, periodID, page.Limit(), page.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	overtimes, err := scanOvertimes(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return overtimes, total, nil
+}
+
+func (r *dbRepo) UpdateOvertime(ctx context.Context, ot overtime.Overtime) error {
+	_, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateOvertime,
+		ot.ID,
+		ot.Date,
+		ot.StartAt,
+		ot.EndAt,
+		ot.Minutes,
+		ot.Type,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *dbRepo) DeleteOvertime(ctx context.Context, id int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryDeleteOvertime, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanOvertime(row rowScanner) (overtime.Overtime, error) {
 	var ot overtime.Overtime
 	err := row.Scan(
 		&ot.ID,
 		&ot.UserID,
 		&ot.PeriodID,
 		&ot.Date,
-		&ot.Hours,
+		&ot.StartAt,
+		&ot.EndAt,
+		&ot.Minutes,
+		&ot.Type,
+		&ot.Status,
+		&ot.LimitOverriddenBy,
+		&ot.LimitOverrideReason,
+		&ot.ReviewedBy,
+		&ot.ReviewNote,
+		&ot.ReviewedAt,
 		&ot.CreatedAt,
 		&ot.UpdatedAt,
 	)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return overtime.Overtime{}, nil
+	return ot, err
+}
+
+func scanOvertimes(rows *sql.Rows) ([]overtime.Overtime, error) {
+	defer rows.Close()
+
+	results := []overtime.Overtime{}
+	for rows.Next() {
+		ot, err := scanOvertime(rows)
+		if err != nil {
+			return nil, err
 		}
-		return overtime.Overtime{}, err
+		results = append(results, ot)
 	}
 
-	return ot, nil
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/postgres"
 	"reflect"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -82,7 +84,7 @@ func Test_dbRepo_InsertOvertime(t *testing.T) {
 			fields: fields{db: &postgres.Postgres{DB: db}},
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(queryInsertOvertime)).
-					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID, mockOvertime.Date, mockOvertime.Hours).
+					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID, mockOvertime.Date, mockOvertime.StartAt, mockOvertime.EndAt, mockOvertime.Minutes, mockOvertime.Type, mockOvertime.Status, mockOvertime.LimitOverriddenBy, mockOvertime.LimitOverrideReason).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockOvertime.ID))
 			},
 			args: args{
@@ -91,7 +93,11 @@ func Test_dbRepo_InsertOvertime(t *testing.T) {
 					UserID:   mockOvertime.UserID,
 					PeriodID: mockOvertime.PeriodID,
 					Date:     mockOvertime.Date,
-					Hours:    mockOvertime.Hours,
+					StartAt:  mockOvertime.StartAt,
+					EndAt:    mockOvertime.EndAt,
+					Minutes:  mockOvertime.Minutes,
+					Type:     mockOvertime.Type,
+					Status:   mockOvertime.Status,
 				},
 			},
 			want:    1,
@@ -102,7 +108,7 @@ func Test_dbRepo_InsertOvertime(t *testing.T) {
 			fields: fields{db: &postgres.Postgres{DB: db}},
 			mock: func() {
 				mock.ExpectQuery(regexp.QuoteMeta(queryInsertOvertime)).
-					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID, mockOvertime.Date, mockOvertime.Hours).
+					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID, mockOvertime.Date, mockOvertime.StartAt, mockOvertime.EndAt, mockOvertime.Minutes, mockOvertime.Type, mockOvertime.Status, mockOvertime.LimitOverriddenBy, mockOvertime.LimitOverrideReason).
 					WillReturnError(sql.ErrConnDone)
 			},
 			args: args{
@@ -111,7 +117,11 @@ func Test_dbRepo_InsertOvertime(t *testing.T) {
 					UserID:   mockOvertime.UserID,
 					PeriodID: mockOvertime.PeriodID,
 					Date:     mockOvertime.Date,
-					Hours:    mockOvertime.Hours,
+					StartAt:  mockOvertime.StartAt,
+					EndAt:    mockOvertime.EndAt,
+					Minutes:  mockOvertime.Minutes,
+					Type:     mockOvertime.Type,
+					Status:   mockOvertime.Status,
 				},
 			},
 			want:    0,
@@ -234,12 +244,18 @@ func Test_dbRepo_GetOvertime(t *testing.T) {
 
 
 func getMockOvertime(mocktime time.Time) overtime.Overtime {
+	startAt := time.Date(2025, 6, 15, 17, 0, 0, 0, time.UTC)
+	endAt := time.Date(2025, 6, 15, 19, 15, 0, 0, time.UTC)
 	return overtime.Overtime{
 		ID:        1,
 		UserID:    101,
 		PeriodID:  202,
 		Date:      time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC),
-		Hours:     2,
+		StartAt:   &startAt,
+		EndAt:     &endAt,
+		Minutes:   135,
+		Type:      overtime.TypeRegular,
+		Status:    overtime.StatusPending,
 		CreatedAt: mocktime,
 		UpdatedAt: mocktime,
 	}
@@ -254,7 +270,16 @@ func getMockOvertimeExpectedRows(mocktime time.Time) *sqlmock.Rows {
 		"user_id",
 		"period_id",
 		"date",
-		"hours",
+		"start_at",
+		"end_at",
+		"minutes",
+		"type",
+		"status",
+		"limit_overridden_by",
+		"limit_override_reason",
+		"reviewed_by",
+		"review_note",
+		"reviewed_at",
 		"created_at",
 		"updated_at",
 	})
@@ -264,10 +289,496 @@ func getMockOvertimeExpectedRows(mocktime time.Time) *sqlmock.Rows {
 		mockOt.UserID,
 		mockOt.PeriodID,
 		mockOt.Date,
-		mockOt.Hours,
+		*mockOt.StartAt,
+		*mockOt.EndAt,
+		mockOt.Minutes,
+		mockOt.Type,
+		mockOt.Status,
+		nil,
+		mockOt.LimitOverrideReason,
+		nil,
+		mockOt.ReviewNote,
+		nil,
 		mockOt.CreatedAt,
 		mockOt.UpdatedAt,
 	)
 
 	return rows
-}
\ No newline at end of file
+}
+
+func Test_dbRepo_GetOvertimeMinutesInRange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockUserID := 101
+	mockFrom := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	mockTo := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimeMinutesInRange)).
+					WithArgs(mockUserID, overtime.TypeRegular, mockFrom, mockTo).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(285))
+			},
+			want:    285,
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimeMinutesInRange)).
+					WithArgs(mockUserID, overtime.TypeRegular, mockFrom, mockTo).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetOvertimeMinutesInRange(context.Background(), mockUserID, overtime.TypeRegular, mockFrom, mockTo)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetOverlappingOvertime(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Now()
+	mockOvertime := getMockOvertime(mocktimenow)
+	startAt := mockOvertime.StartAt.Add(time.Hour)
+	endAt := mockOvertime.EndAt.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    overtime.Overtime
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOverlappingOvertime)).
+					WithArgs(mockOvertime.UserID, startAt, endAt, 0).
+					WillReturnRows(getMockOvertimeExpectedRows(mocktimenow))
+			},
+			want:    mockOvertime,
+			wantErr: false,
+		},
+		{
+			name: "No Overlap",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOverlappingOvertime)).
+					WithArgs(mockOvertime.UserID, startAt, endAt, 0).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    overtime.Overtime{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOverlappingOvertime)).
+					WithArgs(mockOvertime.UserID, startAt, endAt, 0).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    overtime.Overtime{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetOverlappingOvertime(context.Background(), mockOvertime.UserID, startAt, endAt, 0)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetOvertimesByIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockOvertime := getMockOvertime(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []overtime.Overtime
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimesByIDs)).
+					WithArgs(pq.Array([]int{mockOvertime.ID})).
+					WillReturnRows(getMockOvertimeExpectedRows(mocktimenow))
+			},
+			want:    []overtime.Overtime{mockOvertime},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimesByIDs)).
+					WithArgs(pq.Array([]int{mockOvertime.ID})).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetOvertimesByIDs(context.Background(), []int{mockOvertime.ID})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetOvertimesByStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockOvertime := getMockOvertime(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []overtime.Overtime
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimesByStatus)).
+					WithArgs(overtime.StatusPending, mockOvertime.PeriodID, 7).
+					WillReturnRows(getMockOvertimeExpectedRows(mocktimenow))
+			},
+			want:    []overtime.Overtime{mockOvertime},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimesByStatus)).
+					WithArgs(overtime.StatusPending, mockOvertime.PeriodID, 7).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetOvertimesByStatus(context.Background(), overtime.StatusPending, mockOvertime.PeriodID, 7)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_UpdateOvertimeReviews(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockOvertime := getMockOvertime(mocktimenow)
+	reviewerID := 7
+	mockOvertime.Status = overtime.StatusApproved
+	mockOvertime.ReviewedBy = &reviewerID
+	mockOvertime.ReviewedAt = &mocktimenow
+	secondOvertime := mockOvertime
+	secondOvertime.ID = mockOvertime.ID + 1
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertimeReview)).
+					WithArgs(mockOvertime.ID, mockOvertime.Status, mockOvertime.ReviewedBy, mockOvertime.ReviewNote, mockOvertime.ReviewedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertimeReview)).
+					WithArgs(secondOvertime.ID, secondOvertime.Status, secondOvertime.ReviewedBy, secondOvertime.ReviewNote, secondOvertime.ReviewedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "No Longer Pending Rolls Back The Batch",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertimeReview)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertimeReview)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error Update",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertimeReview)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateOvertimeReviews(context.Background(), []overtime.Overtime{mockOvertime, secondOvertime})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetOvertimesByUserID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockOvertime := getMockOvertime(mocktimenow)
+	page := pagination.NewRequest(2, 10)
+
+	tests := []struct {
+		name      string
+		mock      func()
+		want      []overtime.Overtime
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryCountOvertimesByUserID)).
+					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetOvertimesByUserID)).
+					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID, 10, 10).
+					WillReturnRows(getMockOvertimeExpectedRows(mocktimenow))
+			},
+			want:      []overtime.Overtime{mockOvertime},
+			wantTotal: 11,
+			wantErr:   false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryCountOvertimesByUserID)).
+					WithArgs(mockOvertime.UserID, mockOvertime.PeriodID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:      nil,
+			wantTotal: 0,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, total, err := r.GetOvertimesByUserID(context.Background(), mockOvertime.UserID, mockOvertime.PeriodID, page)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateOvertime(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockOvertime := getMockOvertime(mocktimenow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertime)).
+					WithArgs(mockOvertime.ID, mockOvertime.Date, mockOvertime.StartAt, mockOvertime.EndAt, mockOvertime.Minutes, mockOvertime.Type).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error Update",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateOvertime)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateOvertime(context.Background(), mockOvertime)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_DeleteOvertime(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryDeleteOvertime)).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error Delete",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryDeleteOvertime)).
+					WithArgs(1).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.DeleteOvertime(context.Background(), 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
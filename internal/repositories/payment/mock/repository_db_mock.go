@@ -0,0 +1,226 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository_db.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	payment "payslip-generation-system/internal/entity/payment"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// GetPaymentByID mocks base method.
+func (m *MockdbRepoProvider) GetPaymentByID(ctx context.Context, id int) (payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByID", ctx, id)
+	ret0, _ := ret[0].(payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByID indicates an expected call of GetPaymentByID.
+func (mr *MockdbRepoProviderMockRecorder) GetPaymentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPaymentByID), ctx, id)
+}
+
+// GetPayments mocks base method.
+func (m *MockdbRepoProvider) GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayments", ctx, periodID, status)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayments indicates an expected call of GetPayments.
+func (mr *MockdbRepoProviderMockRecorder) GetPayments(ctx, periodID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayments", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPayments), ctx, periodID, status)
+}
+
+// GetStatementLineByID mocks base method.
+func (m *MockdbRepoProvider) GetStatementLineByID(ctx context.Context, id int) (payment.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementLineByID", ctx, id)
+	ret0, _ := ret[0].(payment.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementLineByID indicates an expected call of GetStatementLineByID.
+func (mr *MockdbRepoProviderMockRecorder) GetStatementLineByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementLineByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetStatementLineByID), ctx, id)
+}
+
+// GetStatementLines mocks base method.
+func (m *MockdbRepoProvider) GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementLines", ctx, statementID, status)
+	ret0, _ := ret[0].([]payment.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementLines indicates an expected call of GetStatementLines.
+func (mr *MockdbRepoProviderMockRecorder) GetStatementLines(ctx, statementID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementLines", reflect.TypeOf((*MockdbRepoProvider)(nil).GetStatementLines), ctx, statementID, status)
+}
+
+// ImportStatement mocks base method.
+func (m *MockdbRepoProvider) ImportStatement(ctx context.Context, statement payment.Statement, lines []payment.ImportedLine) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportStatement", ctx, statement, lines)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportStatement indicates an expected call of ImportStatement.
+func (mr *MockdbRepoProviderMockRecorder) ImportStatement(ctx, statement, lines interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportStatement", reflect.TypeOf((*MockdbRepoProvider)(nil).ImportStatement), ctx, statement, lines)
+}
+
+// MarkPaymentsSent mocks base method.
+func (m *MockdbRepoProvider) MarkPaymentsSent(ctx context.Context, periodID int) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaymentsSent", ctx, periodID)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaymentsSent indicates an expected call of MarkPaymentsSent.
+func (mr *MockdbRepoProviderMockRecorder) MarkPaymentsSent(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaymentsSent", reflect.TypeOf((*MockdbRepoProvider)(nil).MarkPaymentsSent), ctx, periodID)
+}
+
+// ReplacePendingPayments mocks base method.
+func (m *MockdbRepoProvider) ReplacePendingPayments(ctx context.Context, periodID int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePendingPayments", ctx, periodID, payments)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].([]payment.Payment)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReplacePendingPayments indicates an expected call of ReplacePendingPayments.
+func (mr *MockdbRepoProviderMockRecorder) ReplacePendingPayments(ctx, periodID, payments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePendingPayments", reflect.TypeOf((*MockdbRepoProvider)(nil).ReplacePendingPayments), ctx, periodID, payments)
+}
+
+// ResolveStatementLine mocks base method.
+func (m *MockdbRepoProvider) ResolveStatementLine(ctx context.Context, line payment.StatementLine, settlement *payment.Settlement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStatementLine", ctx, line, settlement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveStatementLine indicates an expected call of ResolveStatementLine.
+func (mr *MockdbRepoProviderMockRecorder) ResolveStatementLine(ctx, line, settlement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStatementLine", reflect.TypeOf((*MockdbRepoProvider)(nil).ResolveStatementLine), ctx, line, settlement)
+}
+
+// StatementExists mocks base method.
+func (m *MockdbRepoProvider) StatementExists(ctx context.Context, checksum string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementExists", ctx, checksum)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatementExists indicates an expected call of StatementExists.
+func (mr *MockdbRepoProviderMockRecorder) StatementExists(ctx, checksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementExists", reflect.TypeOf((*MockdbRepoProvider)(nil).StatementExists), ctx, checksum)
+}
+
+// UpdatePaymentStatus mocks base method.
+func (m *MockdbRepoProvider) UpdatePaymentStatus(ctx context.Context, id int, from []string, status, note string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", ctx, id, from, status, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
+func (mr *MockdbRepoProviderMockRecorder) UpdatePaymentStatus(ctx, id, from, status, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdatePaymentStatus), ctx, id, from, status, note)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
@@ -0,0 +1,185 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	payment "payslip-generation-system/internal/entity/payment"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPaymentRepositoryProvider is a mock of PaymentRepositoryProvider interface.
+type MockPaymentRepositoryProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryProviderMockRecorder
+}
+
+// MockPaymentRepositoryProviderMockRecorder is the mock recorder for MockPaymentRepositoryProvider.
+type MockPaymentRepositoryProviderMockRecorder struct {
+	mock *MockPaymentRepositoryProvider
+}
+
+// NewMockPaymentRepositoryProvider creates a new mock instance.
+func NewMockPaymentRepositoryProvider(ctrl *gomock.Controller) *MockPaymentRepositoryProvider {
+	mock := &MockPaymentRepositoryProvider{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepositoryProvider) EXPECT() *MockPaymentRepositoryProviderMockRecorder {
+	return m.recorder
+}
+
+// GetPaymentByID mocks base method.
+func (m *MockPaymentRepositoryProvider) GetPaymentByID(ctx context.Context, id int) (payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByID", ctx, id)
+	ret0, _ := ret[0].(payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByID indicates an expected call of GetPaymentByID.
+func (mr *MockPaymentRepositoryProviderMockRecorder) GetPaymentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByID", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).GetPaymentByID), ctx, id)
+}
+
+// GetPayments mocks base method.
+func (m *MockPaymentRepositoryProvider) GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayments", ctx, periodID, status)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayments indicates an expected call of GetPayments.
+func (mr *MockPaymentRepositoryProviderMockRecorder) GetPayments(ctx, periodID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayments", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).GetPayments), ctx, periodID, status)
+}
+
+// GetStatementLineByID mocks base method.
+func (m *MockPaymentRepositoryProvider) GetStatementLineByID(ctx context.Context, id int) (payment.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementLineByID", ctx, id)
+	ret0, _ := ret[0].(payment.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementLineByID indicates an expected call of GetStatementLineByID.
+func (mr *MockPaymentRepositoryProviderMockRecorder) GetStatementLineByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementLineByID", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).GetStatementLineByID), ctx, id)
+}
+
+// GetStatementLines mocks base method.
+func (m *MockPaymentRepositoryProvider) GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementLines", ctx, statementID, status)
+	ret0, _ := ret[0].([]payment.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementLines indicates an expected call of GetStatementLines.
+func (mr *MockPaymentRepositoryProviderMockRecorder) GetStatementLines(ctx, statementID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementLines", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).GetStatementLines), ctx, statementID, status)
+}
+
+// ImportStatement mocks base method.
+func (m *MockPaymentRepositoryProvider) ImportStatement(ctx context.Context, statement payment.Statement, lines []payment.ImportedLine) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportStatement", ctx, statement, lines)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportStatement indicates an expected call of ImportStatement.
+func (mr *MockPaymentRepositoryProviderMockRecorder) ImportStatement(ctx, statement, lines interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportStatement", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).ImportStatement), ctx, statement, lines)
+}
+
+// MarkPaymentsSent mocks base method.
+func (m *MockPaymentRepositoryProvider) MarkPaymentsSent(ctx context.Context, periodID int) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaymentsSent", ctx, periodID)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaymentsSent indicates an expected call of MarkPaymentsSent.
+func (mr *MockPaymentRepositoryProviderMockRecorder) MarkPaymentsSent(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaymentsSent", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).MarkPaymentsSent), ctx, periodID)
+}
+
+// ReplacePendingPayments mocks base method.
+func (m *MockPaymentRepositoryProvider) ReplacePendingPayments(ctx context.Context, periodID int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePendingPayments", ctx, periodID, payments)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].([]payment.Payment)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReplacePendingPayments indicates an expected call of ReplacePendingPayments.
+func (mr *MockPaymentRepositoryProviderMockRecorder) ReplacePendingPayments(ctx, periodID, payments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePendingPayments", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).ReplacePendingPayments), ctx, periodID, payments)
+}
+
+// ResolveStatementLine mocks base method.
+func (m *MockPaymentRepositoryProvider) ResolveStatementLine(ctx context.Context, line payment.StatementLine, settlement *payment.Settlement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStatementLine", ctx, line, settlement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveStatementLine indicates an expected call of ResolveStatementLine.
+func (mr *MockPaymentRepositoryProviderMockRecorder) ResolveStatementLine(ctx, line, settlement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStatementLine", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).ResolveStatementLine), ctx, line, settlement)
+}
+
+// StatementExists mocks base method.
+func (m *MockPaymentRepositoryProvider) StatementExists(ctx context.Context, checksum string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StatementExists", ctx, checksum)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StatementExists indicates an expected call of StatementExists.
+func (mr *MockPaymentRepositoryProviderMockRecorder) StatementExists(ctx, checksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StatementExists", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).StatementExists), ctx, checksum)
+}
+
+// UpdatePaymentStatus mocks base method.
+func (m *MockPaymentRepositoryProvider) UpdatePaymentStatus(ctx context.Context, id int, from []string, status, note string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", ctx, id, from, status, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
+func (mr *MockPaymentRepositoryProviderMockRecorder) UpdatePaymentStatus(ctx, id, from, status, note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockPaymentRepositoryProvider)(nil).UpdatePaymentStatus), ctx, id, from, status, note)
+}
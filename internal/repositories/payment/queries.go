@@ -0,0 +1,96 @@
+package payment
+
+const (
+	// payments that were never sent are replaced when the payments of a period are prepared again
+	queryDeletePendingPayments = `
+		DELETE FROM payslip_payments
+		WHERE period_id = $1 AND status = 'pending'
+		RETURNING id, payslip_id, period_id, user_id, reference, batch_reference, execution_date, bank_code, account_number, account_holder,
+			amount, status, note, sent_at, settled_at, retry_of, created_at, updated_at;
+	`
+
+	queryBulkInsertPayments = `
+		INSERT INTO payslip_payments (payslip_id, period_id, user_id, reference, batch_reference, execution_date, bank_code, account_number, account_holder, amount, status, retry_of)
+		VALUES 
+	`
+
+	queryBulkInsertPaymentsReturning = `
+		RETURNING id, payslip_id, period_id, user_id, reference, batch_reference, execution_date, bank_code, account_number, account_holder,
+			amount, status, note, sent_at, settled_at, retry_of, created_at, updated_at;
+	`
+
+	// 0 and '' leave out the period and status filters
+	queryGetPayments = `
+		SELECT id, payslip_id, period_id, user_id, reference, batch_reference, execution_date, bank_code, account_number, account_holder,
+			amount, status, note, sent_at, settled_at, retry_of, created_at, updated_at
+		FROM payslip_payments
+		WHERE ($1 = 0 OR period_id = $1)
+			AND ($2 = '' OR status = $2)
+		ORDER BY period_id DESC, user_id, id;
+	`
+
+	queryGetPaymentByID = `
+		SELECT id, payslip_id, period_id, user_id, reference, batch_reference, execution_date, bank_code, account_number, account_holder,
+			amount, status, note, sent_at, settled_at, retry_of, created_at, updated_at
+		FROM payslip_payments
+		WHERE id = $1;
+	`
+
+	queryMarkPaymentsSent = `
+		UPDATE payslip_payments
+		SET status = 'sent', sent_at = NOW(), updated_at = NOW()
+		WHERE period_id = $1 AND status = 'pending'
+		RETURNING id, payslip_id, period_id, user_id, reference, batch_reference, execution_date, bank_code, account_number, account_holder,
+			amount, status, note, sent_at, settled_at, retry_of, created_at, updated_at;
+	`
+
+	// $2 are the statuses the payment may still be in, a payment changed in the meantime is left alone
+	queryUpdatePaymentStatus = `
+		UPDATE payslip_payments
+		SET
+			status = $3,
+			note = $4,
+			sent_at = CASE WHEN $3 = 'sent' THEN NOW() ELSE sent_at END,
+			settled_at = CASE WHEN $3 = 'sent' THEN settled_at ELSE NOW() END,
+			updated_at = NOW()
+		WHERE id = $1 AND status = ANY($2);
+	`
+
+	queryStatementExists = `
+		SELECT EXISTS (SELECT 1 FROM bank_statements WHERE checksum = $1);
+	`
+
+	queryInsertStatement = `
+		INSERT INTO bank_statements (file_name, format, checksum, imported_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id;
+	`
+
+	queryInsertStatementLine = `
+		INSERT INTO bank_statement_lines (statement_id, line_number, value_date, mark, amount, account_number, reference, description, status, payment_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`
+
+	// 0 and '' leave out the statement and status filters
+	queryGetStatementLines = `
+		SELECT id, statement_id, line_number, value_date, mark, amount, account_number, reference, description,
+			status, payment_id, resolved_by, note, created_at, updated_at
+		FROM bank_statement_lines
+		WHERE ($1 = 0 OR statement_id = $1)
+			AND ($2 = '' OR status = $2)
+		ORDER BY statement_id DESC, line_number;
+	`
+
+	queryGetStatementLineByID = `
+		SELECT id, statement_id, line_number, value_date, mark, amount, account_number, reference, description,
+			status, payment_id, resolved_by, note, created_at, updated_at
+		FROM bank_statement_lines
+		WHERE id = $1;
+	`
+
+	queryResolveStatementLine = `
+		UPDATE bank_statement_lines
+		SET status = $2, payment_id = $3, resolved_by = $4, note = $5, updated_at = NOW()
+		WHERE id = $1 AND status = 'unmatched';
+	`
+)
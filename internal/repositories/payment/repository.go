@@ -0,0 +1,116 @@
+package payment
+
+import (
+	"context"
+
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/postgres"
+)
+
+//go:generate mockgen -source=repository.go -package=mock -destination=mock/repository_mock.go
+type PaymentRepositoryProvider interface {
+	ReplacePendingPayments(ctx context.Context, periodID int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error)
+	GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error)
+	GetPaymentByID(ctx context.Context, id int) (payment.Payment, error)
+	MarkPaymentsSent(ctx context.Context, periodID int) ([]payment.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, id int, from []string, status, note string) error
+	StatementExists(ctx context.Context, checksum string) (bool, error)
+	ImportStatement(ctx context.Context, statement payment.Statement, lines []payment.ImportedLine) (int, error)
+	GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error)
+	GetStatementLineByID(ctx context.Context, id int) (payment.StatementLine, error)
+	ResolveStatementLine(ctx context.Context, line payment.StatementLine, settlement *payment.Settlement) error
+}
+
+type paymentRepository struct {
+	db dbRepoProvider
+}
+
+func NewPaymentRepository(
+	db *postgres.Postgres,
+) PaymentRepositoryProvider {
+	return &paymentRepository{
+		db: newDBRepo(
+			db,
+		),
+	}
+}
+
+func (r *paymentRepository) ReplacePendingPayments(ctx context.Context, periodID int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error) {
+	removed, inserted, err := r.db.ReplacePendingPayments(ctx, periodID, payments)
+	if err != nil {
+		return nil, nil, err
+	}
+	return removed, inserted, nil
+}
+
+func (r *paymentRepository) GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error) {
+	result, err := r.db.GetPayments(ctx, periodID, status)
+	if err != nil {
+		return []payment.Payment{}, err
+	}
+	return result, nil
+}
+
+func (r *paymentRepository) GetPaymentByID(ctx context.Context, id int) (payment.Payment, error) {
+	result, err := r.db.GetPaymentByID(ctx, id)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	return result, nil
+}
+
+func (r *paymentRepository) MarkPaymentsSent(ctx context.Context, periodID int) ([]payment.Payment, error) {
+	result, err := r.db.MarkPaymentsSent(ctx, periodID)
+	if err != nil {
+		return []payment.Payment{}, err
+	}
+	return result, nil
+}
+
+func (r *paymentRepository) UpdatePaymentStatus(ctx context.Context, id int, from []string, status, note string) error {
+	err := r.db.UpdatePaymentStatus(ctx, id, from, status, note)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *paymentRepository) StatementExists(ctx context.Context, checksum string) (bool, error) {
+	exists, err := r.db.StatementExists(ctx, checksum)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *paymentRepository) ImportStatement(ctx context.Context, statement payment.Statement, lines []payment.ImportedLine) (int, error) {
+	id, err := r.db.ImportStatement(ctx, statement, lines)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *paymentRepository) GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error) {
+	result, err := r.db.GetStatementLines(ctx, statementID, status)
+	if err != nil {
+		return []payment.StatementLine{}, err
+	}
+	return result, nil
+}
+
+func (r *paymentRepository) GetStatementLineByID(ctx context.Context, id int) (payment.StatementLine, error) {
+	result, err := r.db.GetStatementLineByID(ctx, id)
+	if err != nil {
+		return payment.StatementLine{}, err
+	}
+	return result, nil
+}
+
+func (r *paymentRepository) ResolveStatementLine(ctx context.Context, line payment.StatementLine, settlement *payment.Settlement) error {
+	err := r.db.ResolveStatementLine(ctx, line, settlement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
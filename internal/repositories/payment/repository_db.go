@@ -0,0 +1,385 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/postgres"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+//go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
+type dbRepoProvider interface {
+	ReplacePendingPayments(ctx context.Context, periodID int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error)
+	GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error)
+	GetPaymentByID(ctx context.Context, id int) (payment.Payment, error)
+	MarkPaymentsSent(ctx context.Context, periodID int) ([]payment.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, id int, from []string, status, note string) error
+	StatementExists(ctx context.Context, checksum string) (bool, error)
+	ImportStatement(ctx context.Context, statement payment.Statement, lines []payment.ImportedLine) (int, error)
+	GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error)
+	GetStatementLineByID(ctx context.Context, id int) (payment.StatementLine, error)
+	ResolveStatementLine(ctx context.Context, line payment.StatementLine, settlement *payment.Settlement) error
+}
+
+type dbRepo struct {
+	db *postgres.Postgres
+}
+
+func newDBRepo(
+	db *postgres.Postgres,
+) dbRepoProvider {
+	return &dbRepo{
+		db: db,
+	}
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPayment(row rowScanner) (payment.Payment, error) {
+	var p payment.Payment
+	var sentAt, settledAt sql.NullTime
+	var retryOf sql.NullInt32
+	err := row.Scan(
+		&p.ID,
+		&p.PayslipID,
+		&p.PeriodID,
+		&p.UserID,
+		&p.Reference,
+		&p.BatchReference,
+		&p.ExecutionDate,
+		&p.BankCode,
+		&p.AccountNumber,
+		&p.AccountHolder,
+		&p.Amount,
+		&p.Status,
+		&p.Note,
+		&sentAt,
+		&settledAt,
+		&retryOf,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	if sentAt.Valid {
+		p.SentAt = &sentAt.Time
+	}
+	if settledAt.Valid {
+		p.SettledAt = &settledAt.Time
+	}
+	if retryOf.Valid {
+		id := int(retryOf.Int32)
+		p.RetryOf = &id
+	}
+	return p, nil
+}
+
+func scanStatementLine(row rowScanner) (payment.StatementLine, error) {
+	var l payment.StatementLine
+	var paymentID, resolvedBy sql.NullInt32
+	err := row.Scan(
+		&l.ID,
+		&l.StatementID,
+		&l.LineNumber,
+		&l.ValueDate,
+		&l.Mark,
+		&l.Amount,
+		&l.AccountNumber,
+		&l.Reference,
+		&l.Description,
+		&l.Status,
+		&paymentID,
+		&resolvedBy,
+		&l.Note,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	)
+	if err != nil {
+		return payment.StatementLine{}, err
+	}
+	if paymentID.Valid {
+		id := int(paymentID.Int32)
+		l.PaymentID = &id
+	}
+	if resolvedBy.Valid {
+		id := int(resolvedBy.Int32)
+		l.ResolvedBy = &id
+	}
+	return l, nil
+}
+
+// ReplacePendingPayments replaces the payments of a period that were never sent with the given ones in one
+// transaction, and returns the payments removed and the ones inserted
+func (r *dbRepo) ReplacePendingPayments(ctx context.Context, periodID int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error) {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, queryDeletePendingPayments, periodID)
+	if err != nil {
+		return nil, nil, err
+	}
+	removed, err := scanPaymentRows(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inserted := []payment.Payment{}
+	if len(payments) > 0 {
+		args := []interface{}{}
+		values := []string{}
+		for i, p := range payments {
+			start := i * 12
+			values = append(values, fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d)",
+				start+1, start+2, start+3, start+4, start+5, start+6,
+				start+7, start+8, start+9, start+10, start+11, start+12,
+			))
+			args = append(args,
+				p.PayslipID,
+				p.PeriodID,
+				p.UserID,
+				p.Reference,
+				p.BatchReference,
+				p.ExecutionDate,
+				p.BankCode,
+				p.AccountNumber,
+				p.AccountHolder,
+				p.Amount,
+				p.Status,
+				p.RetryOf,
+			)
+		}
+		rows, err = tx.QueryContext(ctx, queryBulkInsertPayments+strings.Join(values, ",")+queryBulkInsertPaymentsReturning, args...)
+		if err != nil {
+			return nil, nil, err
+		}
+		inserted, err = scanPaymentRows(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+	return removed, inserted, nil
+}
+
+// scanPaymentRows reads and closes the payments of rows
+func scanPaymentRows(rows *sql.Rows) ([]payment.Payment, error) {
+	defer rows.Close()
+
+	payments := []payment.Payment{}
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (r *dbRepo) GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetPayments, periodID, status)
+	if err != nil {
+		return nil, err
+	}
+	return scanPaymentRows(rows)
+}
+
+func (r *dbRepo) GetPaymentByID(ctx context.Context, id int) (payment.Payment, error) {
+	p, err := scanPayment(r.db.DB.QueryRowContext(ctx, queryGetPaymentByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payment.Payment{}, nil
+		}
+		return payment.Payment{}, err
+	}
+	return p, nil
+}
+
+// MarkPaymentsSent marks the pending payments of a period sent and returns them
+func (r *dbRepo) MarkPaymentsSent(ctx context.Context, periodID int) ([]payment.Payment, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryMarkPaymentsSent, periodID)
+	if err != nil {
+		return nil, err
+	}
+	return scanPaymentRows(rows)
+}
+
+// UpdatePaymentStatus changes the status of a payment that is still in one of the from statuses
+func (r *dbRepo) UpdatePaymentStatus(ctx context.Context, id int, from []string, status, note string) error {
+	result, err := r.db.DB.ExecContext(ctx, queryUpdatePaymentStatus, id, pq.Array(from), status, note)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("payment status has changed in the meantime")
+	}
+	return nil
+}
+
+func (r *dbRepo) StatementExists(ctx context.Context, checksum string) (bool, error) {
+	var exists bool
+	err := r.db.DB.QueryRowContext(ctx, queryStatementExists, checksum).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// ImportStatement inserts a statement and its lines and settles the payments the lines were matched to in one
+// transaction, so a failed import leaves nothing behind and can be retried. A settled payment notes the statement
+// and line that settled it, and the whole import fails when one of them was changed in the meantime
+func (r *dbRepo) ImportStatement(ctx context.Context, statement payment.Statement, lines []payment.ImportedLine) (int, error) {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var statementID int
+	err = tx.QueryRowContext(
+		ctx,
+		queryInsertStatement,
+		statement.FileName,
+		statement.Format,
+		statement.Checksum,
+		statement.ImportedBy,
+	).Scan(&statementID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, imported := range lines {
+		line := imported.Line
+		line.StatementID = statementID
+		if imported.Settlement != nil {
+			settlement := *imported.Settlement
+			settlement.Note = fmt.Sprintf("bank statement %d line %d", statementID, line.LineNumber)
+			err = settlePayment(ctx, tx, settlement)
+			if err != nil {
+				return 0, err
+			}
+			line.PaymentID = &settlement.PaymentID
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			queryInsertStatementLine,
+			line.StatementID,
+			line.LineNumber,
+			line.ValueDate,
+			line.Mark,
+			line.Amount,
+			line.AccountNumber,
+			line.Reference,
+			line.Description,
+			line.Status,
+			line.PaymentID,
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return statementID, nil
+}
+
+// settlePayment changes the status of a payment that is still in the status it was read with
+func settlePayment(ctx context.Context, tx *sql.Tx, settlement payment.Settlement) error {
+	result, err := tx.ExecContext(ctx, queryUpdatePaymentStatus, settlement.PaymentID, pq.Array([]string{settlement.From}), settlement.Status, settlement.Note)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("payment status has changed in the meantime")
+	}
+	return nil
+}
+
+func (r *dbRepo) GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetStatementLines, statementID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lines := []payment.StatementLine{}
+	for rows.Next() {
+		l, err := scanStatementLine(rows)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func (r *dbRepo) GetStatementLineByID(ctx context.Context, id int) (payment.StatementLine, error) {
+	l, err := scanStatementLine(r.db.DB.QueryRowContext(ctx, queryGetStatementLineByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payment.StatementLine{}, nil
+		}
+		return payment.StatementLine{}, err
+	}
+	return l, nil
+}
+
+// ResolveStatementLine records how an admin resolved an unmatched line and settles the payment it was matched
+// to, if any, in one transaction. A line resolved in the meantime is not changed
+func (r *dbRepo) ResolveStatementLine(ctx context.Context, line payment.StatementLine, settlement *payment.Settlement) error {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if settlement != nil {
+		err = settlePayment(ctx, tx, *settlement)
+		if err != nil {
+			return err
+		}
+	}
+	result, err := tx.ExecContext(ctx, queryResolveStatementLine, line.ID, line.Status, line.PaymentID, line.ResolvedBy, line.Note)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("statement line has already been resolved")
+	}
+	return tx.Commit()
+}
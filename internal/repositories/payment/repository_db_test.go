@@ -0,0 +1,474 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/postgres"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var paymentColumns = []string{"id", "payslip_id", "period_id", "user_id", "reference", "batch_reference", "execution_date", "bank_code", "account_number",
+	"account_holder", "amount", "status", "note", "sent_at", "settled_at", "retry_of", "created_at", "updated_at"}
+
+var statementLineColumns = []string{"id", "statement_id", "line_number", "value_date", "mark", "amount", "account_number", "reference",
+	"description", "status", "payment_id", "resolved_by", "note", "created_at", "updated_at"}
+
+func getMockPayment() payment.Payment {
+	sentAt := time.Date(2025, 6, 30, 9, 0, 0, 0, time.UTC)
+	return payment.Payment{
+		ID:             5,
+		PayslipID:      12,
+		PeriodID:       3,
+		UserID:         7,
+		Reference:      "PAYSLIP-12",
+		BatchReference: "PAYROLL-3",
+		ExecutionDate:  time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		BankCode:       "014",
+		AccountNumber:  "1234567890",
+		AccountHolder:  "BUDI SANTOSO",
+		Amount:         5293750,
+		Status:         payment.StatusSent,
+		SentAt:         &sentAt,
+		CreatedAt:      time.Date(2025, 6, 28, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2025, 6, 30, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func paymentRow(rows *sqlmock.Rows, p payment.Payment) *sqlmock.Rows {
+	var sentAt, settledAt, retryOf any
+	if p.RetryOf != nil {
+		retryOf = *p.RetryOf
+	}
+	if p.SentAt != nil {
+		sentAt = *p.SentAt
+	}
+	if p.SettledAt != nil {
+		settledAt = *p.SettledAt
+	}
+	return rows.AddRow(p.ID, p.PayslipID, p.PeriodID, p.UserID, p.Reference, p.BatchReference, p.ExecutionDate, p.BankCode, p.AccountNumber,
+		p.AccountHolder, p.Amount, p.Status, p.Note, sentAt, settledAt, retryOf, p.CreatedAt, p.UpdatedAt)
+}
+
+func getMockStatementLine() payment.StatementLine {
+	return payment.StatementLine{
+		ID:            9,
+		StatementID:   2,
+		LineNumber:    6,
+		ValueDate:     time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		Mark:          "C",
+		Amount:        5293750,
+		AccountNumber: "1234567890",
+		Reference:     "PAYSLIP-12",
+		Description:   "/EREF/PAYSLIP-12",
+		Status:        payment.LineStatusUnmatched,
+		CreatedAt:     time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2025, 7, 1, 8, 0, 0, 0, time.UTC),
+	}
+}
+
+func statementLineRow(rows *sqlmock.Rows, l payment.StatementLine) *sqlmock.Rows {
+	var paymentID, resolvedBy any
+	if l.PaymentID != nil {
+		paymentID = *l.PaymentID
+	}
+	if l.ResolvedBy != nil {
+		resolvedBy = *l.ResolvedBy
+	}
+	return rows.AddRow(l.ID, l.StatementID, l.LineNumber, l.ValueDate, l.Mark, l.Amount, l.AccountNumber, l.Reference,
+		l.Description, l.Status, paymentID, resolvedBy, l.Note, l.CreatedAt, l.UpdatedAt)
+}
+
+func Test_newDBRepo(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	want := &dbRepo{db: &postgres.Postgres{DB: db}}
+	if got := newDBRepo(&postgres.Postgres{DB: db}); !reflect.DeepEqual(got, want) {
+		t.Errorf("newDBRepo() = %v, want %v", got, want)
+	}
+}
+
+func Test_dbRepo_ReplacePendingPayments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	p := getMockPayment()
+	p.Status = payment.StatusPending
+	p.SentAt = nil
+	retryOf := 2
+	p.RetryOf = &retryOf
+	old := p
+	old.ID = 4
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	tests := []struct {
+		name        string
+		payments    []payment.Payment
+		mock        func()
+		wantRemoved []payment.Payment
+		want        []payment.Payment
+		wantErr     bool
+	}{
+		{
+			name:     "Happy Path",
+			payments: []payment.Payment{p},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryDeletePendingPayments)).
+					WithArgs(3).
+					WillReturnRows(paymentRow(sqlmock.NewRows(paymentColumns), old))
+				mock.ExpectQuery(regexp.QuoteMeta(queryBulkInsertPayments)).
+					WithArgs(p.PayslipID, p.PeriodID, p.UserID, p.Reference, p.BatchReference, p.ExecutionDate, p.BankCode, p.AccountNumber, p.AccountHolder, p.Amount, p.Status, p.RetryOf).
+					WillReturnRows(paymentRow(sqlmock.NewRows(paymentColumns), p))
+				mock.ExpectCommit()
+			},
+			wantRemoved: []payment.Payment{old},
+			want:        []payment.Payment{p},
+		},
+		{
+			name:     "Nothing To Pay",
+			payments: []payment.Payment{},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryDeletePendingPayments)).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows(paymentColumns))
+				mock.ExpectCommit()
+			},
+			wantRemoved: []payment.Payment{},
+			want:        []payment.Payment{},
+		},
+		{
+			name:     "Error Insert Keeps The Pending Payments",
+			payments: []payment.Payment{p},
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryDeletePendingPayments)).
+					WithArgs(3).
+					WillReturnRows(paymentRow(sqlmock.NewRows(paymentColumns), old))
+				mock.ExpectQuery(regexp.QuoteMeta(queryBulkInsertPayments)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			removed, got, err := r.ReplacePendingPayments(context.Background(), 3, tt.payments)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantRemoved, removed)
+				assert.Equal(t, tt.want, got)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetPayments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	p := getMockPayment()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPayments)).
+		WithArgs(3, payment.StatusSent).
+		WillReturnRows(paymentRow(sqlmock.NewRows(paymentColumns), p))
+	got, err := r.GetPayments(context.Background(), 3, payment.StatusSent)
+	assert.NoError(t, err)
+	assert.Equal(t, []payment.Payment{p}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPayments)).
+		WithArgs(0, "").
+		WillReturnError(sql.ErrConnDone)
+	_, err = r.GetPayments(context.Background(), 0, "")
+	assert.Error(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetPaymentByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	p := getMockPayment()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentByID)).
+		WithArgs(5).
+		WillReturnRows(paymentRow(sqlmock.NewRows(paymentColumns), p))
+	got, err := r.GetPaymentByID(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, p, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentByID)).
+		WithArgs(5).
+		WillReturnError(sql.ErrNoRows)
+	got, err = r.GetPaymentByID(context.Background(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, payment.Payment{}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetPaymentByID)).
+		WithArgs(5).
+		WillReturnError(errors.New("connection error"))
+	_, err = r.GetPaymentByID(context.Background(), 5)
+	assert.Error(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_MarkPaymentsSent(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	p := getMockPayment()
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryMarkPaymentsSent)).
+		WithArgs(3).
+		WillReturnRows(paymentRow(sqlmock.NewRows(paymentColumns), p))
+	got, err := r.MarkPaymentsSent(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []payment.Payment{p}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_UpdatePaymentStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	from := []string{payment.StatusSent}
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectExec(regexp.QuoteMeta(queryUpdatePaymentStatus)).
+		WithArgs(5, pq.Array(from), payment.StatusPaid, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, r.UpdatePaymentStatus(context.Background(), 5, from, payment.StatusPaid, ""))
+
+	// the payment was settled by someone else in the meantime
+	mock.ExpectExec(regexp.QuoteMeta(queryUpdatePaymentStatus)).
+		WithArgs(5, pq.Array(from), payment.StatusPaid, "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.ErrorContains(t, r.UpdatePaymentStatus(context.Background(), 5, from, payment.StatusPaid, ""), "changed in the meantime")
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_ImportStatement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+	statement := payment.Statement{FileName: "statement.sta", Format: "mt940", Checksum: "abc123", ImportedBy: 1}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryStatementExists)).
+		WithArgs("abc123").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	exists, err := r.StatementExists(context.Background(), "abc123")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	unmatched := getMockStatementLine()
+	unmatched.LineNumber = 4
+	matched := getMockStatementLine()
+	matched.Status = payment.LineStatusMatched
+	paymentID := 5
+	lines := []payment.ImportedLine{
+		{Line: unmatched},
+		{Line: matched, Settlement: &payment.Settlement{PaymentID: 5, From: payment.StatusSent, Status: payment.StatusPaid}},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertStatement)).
+					WithArgs("statement.sta", "mt940", "abc123", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta(queryInsertStatementLine)).
+					WithArgs(2, 4, unmatched.ValueDate, unmatched.Mark, unmatched.Amount, unmatched.AccountNumber, unmatched.Reference, unmatched.Description, payment.LineStatusUnmatched, nil).
+					WillReturnResult(sqlmock.NewResult(8, 1))
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdatePaymentStatus)).
+					WithArgs(5, pq.Array([]string{payment.StatusSent}), payment.StatusPaid, "bank statement 2 line 6").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(queryInsertStatementLine)).
+					WithArgs(2, 6, matched.ValueDate, matched.Mark, matched.Amount, matched.AccountNumber, matched.Reference, matched.Description, payment.LineStatusMatched, &paymentID).
+					WillReturnResult(sqlmock.NewResult(9, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Payment Settled In The Meantime Rolls Back The Import",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertStatement)).
+					WithArgs("statement.sta", "mt940", "abc123", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta(queryInsertStatementLine)).
+					WillReturnResult(sqlmock.NewResult(8, 1))
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdatePaymentStatus)).
+					WithArgs(5, pq.Array([]string{payment.StatusSent}), payment.StatusPaid, "bank statement 2 line 6").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error Insert Line",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertStatement)).
+					WithArgs("statement.sta", "mt940", "abc123", 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectExec(regexp.QuoteMeta(queryInsertStatementLine)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			id, err := r.ImportStatement(context.Background(), statement, lines)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, id)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetStatementLines(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	line := getMockStatementLine()
+	matched := getMockStatementLine()
+	paymentID := 5
+	matched.Status = payment.LineStatusMatched
+	matched.PaymentID = &paymentID
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetStatementLines)).
+		WithArgs(2, "").
+		WillReturnRows(statementLineRow(statementLineRow(sqlmock.NewRows(statementLineColumns), line), matched))
+	got, err := r.GetStatementLines(context.Background(), 2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []payment.StatementLine{line, matched}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetStatementLineByID)).
+		WithArgs(9).
+		WillReturnError(sql.ErrNoRows)
+	got2, err := r.GetStatementLineByID(context.Background(), 9)
+	assert.NoError(t, err)
+	assert.Equal(t, payment.StatementLine{}, got2)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_ResolveStatementLine(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	line := getMockStatementLine()
+	resolvedBy := 1
+	line.Status = payment.LineStatusDismissed
+	line.ResolvedBy = &resolvedBy
+	line.Note = "interest credit"
+	r := &dbRepo{db: &postgres.Postgres{DB: db}}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(queryResolveStatementLine)).
+		WithArgs(9, payment.LineStatusDismissed, line.PaymentID, line.ResolvedBy, "interest credit").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	assert.NoError(t, r.ResolveStatementLine(context.Background(), line, nil))
+
+	// the settlement is rolled back when the line was resolved in the meantime
+	paymentID := 5
+	resolved := getMockStatementLine()
+	resolved.Status = payment.LineStatusResolved
+	resolved.PaymentID = &paymentID
+	resolved.ResolvedBy = &resolvedBy
+	settlement := &payment.Settlement{PaymentID: 5, From: payment.StatusSent, Status: payment.StatusPaid, Note: "bank statement 2 line 6"}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(queryUpdatePaymentStatus)).
+		WithArgs(5, pq.Array([]string{payment.StatusSent}), payment.StatusPaid, "bank statement 2 line 6").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(queryResolveStatementLine)).
+		WithArgs(9, payment.LineStatusResolved, resolved.PaymentID, resolved.ResolvedBy, "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	assert.ErrorContains(t, r.ResolveStatementLine(context.Background(), resolved, settlement), "already been resolved")
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	payslip "payslip-generation-system/internal/entity/payslip"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,20 @@ func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
 	return m.recorder
 }
 
+// ActivateTemplate mocks base method.
+func (m *MockdbRepoProvider) ActivateTemplate(ctx context.Context, id, activatedBy int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateTemplate", ctx, id, activatedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateTemplate indicates an expected call of ActivateTemplate.
+func (mr *MockdbRepoProviderMockRecorder) ActivateTemplate(ctx, id, activatedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTemplate", reflect.TypeOf((*MockdbRepoProvider)(nil).ActivateTemplate), ctx, id, activatedBy)
+}
+
 // BulkInsertPayslips mocks base method.
 func (m *MockdbRepoProvider) BulkInsertPayslips(ctx context.Context, payslips []payslip.Payslip) error {
 	m.ctrl.T.Helper()
@@ -49,6 +64,81 @@ func (mr *MockdbRepoProviderMockRecorder) BulkInsertPayslips(ctx, payslips inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkInsertPayslips", reflect.TypeOf((*MockdbRepoProvider)(nil).BulkInsertPayslips), ctx, payslips)
 }
 
+// ClaimDueDeliveries mocks base method.
+func (m *MockdbRepoProvider) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeliveries", ctx, limit, lease)
+	ret0, _ := ret[0].([]payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeliveries indicates an expected call of ClaimDueDeliveries.
+func (mr *MockdbRepoProviderMockRecorder) ClaimDueDeliveries(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeliveries", reflect.TypeOf((*MockdbRepoProvider)(nil).ClaimDueDeliveries), ctx, limit, lease)
+}
+
+// GetActiveTemplate mocks base method.
+func (m *MockdbRepoProvider) GetActiveTemplate(ctx context.Context, legalEntity string) (payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTemplate", ctx, legalEntity)
+	ret0, _ := ret[0].(payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTemplate indicates an expected call of GetActiveTemplate.
+func (mr *MockdbRepoProviderMockRecorder) GetActiveTemplate(ctx, legalEntity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTemplate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetActiveTemplate), ctx, legalEntity)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockdbRepoProvider) GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, periodID, status)
+	ret0, _ := ret[0].([]payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockdbRepoProviderMockRecorder) GetDeliveries(ctx, periodID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockdbRepoProvider)(nil).GetDeliveries), ctx, periodID, status)
+}
+
+// GetDeliveryByPayslipID mocks base method.
+func (m *MockdbRepoProvider) GetDeliveryByPayslipID(ctx context.Context, payslipID int) (payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryByPayslipID", ctx, payslipID)
+	ret0, _ := ret[0].(payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryByPayslipID indicates an expected call of GetDeliveryByPayslipID.
+func (mr *MockdbRepoProviderMockRecorder) GetDeliveryByPayslipID(ctx, payslipID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryByPayslipID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetDeliveryByPayslipID), ctx, payslipID)
+}
+
+// GetPayslipByID mocks base method.
+func (m *MockdbRepoProvider) GetPayslipByID(ctx context.Context, id int) (payslip.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayslipByID", ctx, id)
+	ret0, _ := ret[0].(payslip.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayslipByID indicates an expected call of GetPayslipByID.
+func (mr *MockdbRepoProviderMockRecorder) GetPayslipByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPayslipByID), ctx, id)
+}
+
 // GetPayslipSummary mocks base method.
 func (m *MockdbRepoProvider) GetPayslipSummary(ctx context.Context, periodID int) (payslip.PayslipSummaryReport, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +154,21 @@ func (mr *MockdbRepoProviderMockRecorder) GetPayslipSummary(ctx, periodID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipSummary", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPayslipSummary), ctx, periodID)
 }
 
+// GetPayslipsByPeriodID mocks base method.
+func (m *MockdbRepoProvider) GetPayslipsByPeriodID(ctx context.Context, periodID int) ([]payslip.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayslipsByPeriodID", ctx, periodID)
+	ret0, _ := ret[0].([]payslip.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayslipsByPeriodID indicates an expected call of GetPayslipsByPeriodID.
+func (mr *MockdbRepoProviderMockRecorder) GetPayslipsByPeriodID(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipsByPeriodID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPayslipsByPeriodID), ctx, periodID)
+}
+
 // GetPayslipsByUserID mocks base method.
 func (m *MockdbRepoProvider) GetPayslipsByUserID(ctx context.Context, userID int) ([]payslip.Payslip, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +184,80 @@ func (mr *MockdbRepoProviderMockRecorder) GetPayslipsByUserID(ctx, userID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipsByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPayslipsByUserID), ctx, userID)
 }
 
+// GetTemplateByID mocks base method.
+func (m *MockdbRepoProvider) GetTemplateByID(ctx context.Context, id int) (payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateByID", ctx, id)
+	ret0, _ := ret[0].(payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateByID indicates an expected call of GetTemplateByID.
+func (mr *MockdbRepoProviderMockRecorder) GetTemplateByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetTemplateByID), ctx, id)
+}
+
+// GetTemplates mocks base method.
+func (m *MockdbRepoProvider) GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", ctx, legalEntity)
+	ret0, _ := ret[0].([]payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockdbRepoProviderMockRecorder) GetTemplates(ctx, legalEntity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockdbRepoProvider)(nil).GetTemplates), ctx, legalEntity)
+}
+
+// InsertTemplate mocks base method.
+func (m *MockdbRepoProvider) InsertTemplate(ctx context.Context, tmpl payslip.Template) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTemplate", ctx, tmpl)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InsertTemplate indicates an expected call of InsertTemplate.
+func (mr *MockdbRepoProviderMockRecorder) InsertTemplate(ctx, tmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplate", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertTemplate), ctx, tmpl)
+}
+
+// MarkDeliveryFailed mocks base method.
+func (m *MockdbRepoProvider) MarkDeliveryFailed(ctx context.Context, id int, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeliveryFailed", ctx, id, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeliveryFailed indicates an expected call of MarkDeliveryFailed.
+func (mr *MockdbRepoProviderMockRecorder) MarkDeliveryFailed(ctx, id, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeliveryFailed", reflect.TypeOf((*MockdbRepoProvider)(nil).MarkDeliveryFailed), ctx, id, lastError)
+}
+
+// MarkDeliverySent mocks base method.
+func (m *MockdbRepoProvider) MarkDeliverySent(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeliverySent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeliverySent indicates an expected call of MarkDeliverySent.
+func (mr *MockdbRepoProviderMockRecorder) MarkDeliverySent(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeliverySent", reflect.TypeOf((*MockdbRepoProvider)(nil).MarkDeliverySent), ctx, id)
+}
+
 // PayslipExistsByPeriodID mocks base method.
 func (m *MockdbRepoProvider) PayslipExistsByPeriodID(ctx context.Context, periodID int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -93,3 +272,86 @@ func (mr *MockdbRepoProviderMockRecorder) PayslipExistsByPeriodID(ctx, periodID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayslipExistsByPeriodID", reflect.TypeOf((*MockdbRepoProvider)(nil).PayslipExistsByPeriodID), ctx, periodID)
 }
+
+// QueuePayslipDeliveries mocks base method.
+func (m *MockdbRepoProvider) QueuePayslipDeliveries(ctx context.Context, periodID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueuePayslipDeliveries", ctx, periodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueuePayslipDeliveries indicates an expected call of QueuePayslipDeliveries.
+func (mr *MockdbRepoProviderMockRecorder) QueuePayslipDeliveries(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueuePayslipDeliveries", reflect.TypeOf((*MockdbRepoProvider)(nil).QueuePayslipDeliveries), ctx, periodID)
+}
+
+// RequeueDelivery mocks base method.
+func (m *MockdbRepoProvider) RequeueDelivery(ctx context.Context, payslipID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDelivery", ctx, payslipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDelivery indicates an expected call of RequeueDelivery.
+func (mr *MockdbRepoProviderMockRecorder) RequeueDelivery(ctx, payslipID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDelivery", reflect.TypeOf((*MockdbRepoProvider)(nil).RequeueDelivery), ctx, payslipID)
+}
+
+// RescheduleDelivery mocks base method.
+func (m *MockdbRepoProvider) RescheduleDelivery(ctx context.Context, id int, lastError string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleDelivery", ctx, id, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleDelivery indicates an expected call of RescheduleDelivery.
+func (mr *MockdbRepoProviderMockRecorder) RescheduleDelivery(ctx, id, lastError, nextAttemptAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleDelivery", reflect.TypeOf((*MockdbRepoProvider)(nil).RescheduleDelivery), ctx, id, lastError, nextAttemptAt)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	payslip "payslip-generation-system/internal/entity/payslip"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,20 @@ func (m *MockPayslipRepositoryProvider) EXPECT() *MockPayslipRepositoryProviderM
 	return m.recorder
 }
 
+// ActivateTemplate mocks base method.
+func (m *MockPayslipRepositoryProvider) ActivateTemplate(ctx context.Context, id, activatedBy int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateTemplate", ctx, id, activatedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateTemplate indicates an expected call of ActivateTemplate.
+func (mr *MockPayslipRepositoryProviderMockRecorder) ActivateTemplate(ctx, id, activatedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTemplate", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).ActivateTemplate), ctx, id, activatedBy)
+}
+
 // BulkInsertPayslips mocks base method.
 func (m *MockPayslipRepositoryProvider) BulkInsertPayslips(ctx context.Context, payslips []payslip.Payslip) error {
 	m.ctrl.T.Helper()
@@ -49,6 +64,81 @@ func (mr *MockPayslipRepositoryProviderMockRecorder) BulkInsertPayslips(ctx, pay
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkInsertPayslips", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).BulkInsertPayslips), ctx, payslips)
 }
 
+// ClaimDueDeliveries mocks base method.
+func (m *MockPayslipRepositoryProvider) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeliveries", ctx, limit, lease)
+	ret0, _ := ret[0].([]payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeliveries indicates an expected call of ClaimDueDeliveries.
+func (mr *MockPayslipRepositoryProviderMockRecorder) ClaimDueDeliveries(ctx, limit, lease interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeliveries", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).ClaimDueDeliveries), ctx, limit, lease)
+}
+
+// GetActiveTemplate mocks base method.
+func (m *MockPayslipRepositoryProvider) GetActiveTemplate(ctx context.Context, legalEntity string) (payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveTemplate", ctx, legalEntity)
+	ret0, _ := ret[0].(payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveTemplate indicates an expected call of GetActiveTemplate.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetActiveTemplate(ctx, legalEntity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveTemplate", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetActiveTemplate), ctx, legalEntity)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockPayslipRepositoryProvider) GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, periodID, status)
+	ret0, _ := ret[0].([]payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetDeliveries(ctx, periodID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetDeliveries), ctx, periodID, status)
+}
+
+// GetDeliveryByPayslipID mocks base method.
+func (m *MockPayslipRepositoryProvider) GetDeliveryByPayslipID(ctx context.Context, payslipID int) (payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryByPayslipID", ctx, payslipID)
+	ret0, _ := ret[0].(payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveryByPayslipID indicates an expected call of GetDeliveryByPayslipID.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetDeliveryByPayslipID(ctx, payslipID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryByPayslipID", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetDeliveryByPayslipID), ctx, payslipID)
+}
+
+// GetPayslipByID mocks base method.
+func (m *MockPayslipRepositoryProvider) GetPayslipByID(ctx context.Context, id int) (payslip.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayslipByID", ctx, id)
+	ret0, _ := ret[0].(payslip.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayslipByID indicates an expected call of GetPayslipByID.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetPayslipByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipByID", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetPayslipByID), ctx, id)
+}
+
 // GetPayslipSummary mocks base method.
 func (m *MockPayslipRepositoryProvider) GetPayslipSummary(ctx context.Context, periodID int) (payslip.PayslipSummaryReport, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +154,21 @@ func (mr *MockPayslipRepositoryProviderMockRecorder) GetPayslipSummary(ctx, peri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipSummary", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetPayslipSummary), ctx, periodID)
 }
 
+// GetPayslipsByPeriodID mocks base method.
+func (m *MockPayslipRepositoryProvider) GetPayslipsByPeriodID(ctx context.Context, periodID int) ([]payslip.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayslipsByPeriodID", ctx, periodID)
+	ret0, _ := ret[0].([]payslip.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayslipsByPeriodID indicates an expected call of GetPayslipsByPeriodID.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetPayslipsByPeriodID(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipsByPeriodID", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetPayslipsByPeriodID), ctx, periodID)
+}
+
 // GetPayslipsByUserID mocks base method.
 func (m *MockPayslipRepositoryProvider) GetPayslipsByUserID(ctx context.Context, userID int) ([]payslip.Payslip, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +184,80 @@ func (mr *MockPayslipRepositoryProviderMockRecorder) GetPayslipsByUserID(ctx, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipsByUserID", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetPayslipsByUserID), ctx, userID)
 }
 
+// GetTemplateByID mocks base method.
+func (m *MockPayslipRepositoryProvider) GetTemplateByID(ctx context.Context, id int) (payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateByID", ctx, id)
+	ret0, _ := ret[0].(payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateByID indicates an expected call of GetTemplateByID.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetTemplateByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetTemplateByID), ctx, id)
+}
+
+// GetTemplates mocks base method.
+func (m *MockPayslipRepositoryProvider) GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", ctx, legalEntity)
+	ret0, _ := ret[0].([]payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockPayslipRepositoryProviderMockRecorder) GetTemplates(ctx, legalEntity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).GetTemplates), ctx, legalEntity)
+}
+
+// InsertTemplate mocks base method.
+func (m *MockPayslipRepositoryProvider) InsertTemplate(ctx context.Context, tmpl payslip.Template) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTemplate", ctx, tmpl)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InsertTemplate indicates an expected call of InsertTemplate.
+func (mr *MockPayslipRepositoryProviderMockRecorder) InsertTemplate(ctx, tmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTemplate", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).InsertTemplate), ctx, tmpl)
+}
+
+// MarkDeliveryFailed mocks base method.
+func (m *MockPayslipRepositoryProvider) MarkDeliveryFailed(ctx context.Context, id int, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeliveryFailed", ctx, id, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeliveryFailed indicates an expected call of MarkDeliveryFailed.
+func (mr *MockPayslipRepositoryProviderMockRecorder) MarkDeliveryFailed(ctx, id, lastError interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeliveryFailed", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).MarkDeliveryFailed), ctx, id, lastError)
+}
+
+// MarkDeliverySent mocks base method.
+func (m *MockPayslipRepositoryProvider) MarkDeliverySent(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDeliverySent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDeliverySent indicates an expected call of MarkDeliverySent.
+func (mr *MockPayslipRepositoryProviderMockRecorder) MarkDeliverySent(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDeliverySent", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).MarkDeliverySent), ctx, id)
+}
+
 // PayslipExistsByPeriodID mocks base method.
 func (m *MockPayslipRepositoryProvider) PayslipExistsByPeriodID(ctx context.Context, periodID int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -93,3 +272,45 @@ func (mr *MockPayslipRepositoryProviderMockRecorder) PayslipExistsByPeriodID(ctx
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayslipExistsByPeriodID", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).PayslipExistsByPeriodID), ctx, periodID)
 }
+
+// QueuePayslipDeliveries mocks base method.
+func (m *MockPayslipRepositoryProvider) QueuePayslipDeliveries(ctx context.Context, periodID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueuePayslipDeliveries", ctx, periodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QueuePayslipDeliveries indicates an expected call of QueuePayslipDeliveries.
+func (mr *MockPayslipRepositoryProviderMockRecorder) QueuePayslipDeliveries(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueuePayslipDeliveries", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).QueuePayslipDeliveries), ctx, periodID)
+}
+
+// RequeueDelivery mocks base method.
+func (m *MockPayslipRepositoryProvider) RequeueDelivery(ctx context.Context, payslipID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDelivery", ctx, payslipID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDelivery indicates an expected call of RequeueDelivery.
+func (mr *MockPayslipRepositoryProviderMockRecorder) RequeueDelivery(ctx, payslipID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDelivery", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).RequeueDelivery), ctx, payslipID)
+}
+
+// RescheduleDelivery mocks base method.
+func (m *MockPayslipRepositoryProvider) RescheduleDelivery(ctx context.Context, id int, lastError string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleDelivery", ctx, id, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleDelivery indicates an expected call of RescheduleDelivery.
+func (mr *MockPayslipRepositoryProviderMockRecorder) RescheduleDelivery(ctx, id, lastError, nextAttemptAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleDelivery", reflect.TypeOf((*MockPayslipRepositoryProvider)(nil).RescheduleDelivery), ctx, id, lastError, nextAttemptAt)
+}
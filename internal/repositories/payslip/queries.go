@@ -14,6 +14,34 @@ const (
 			) VALUES 
 		`
 
+	queryBulkInsertPayslipItems = `
+		INSERT INTO payslip_items (
+				payslip_id, component, category, description, amount
+			) VALUES 
+		`
+
+	queryMarkReimbursementsPaid = `
+		UPDATE reimbursements
+		SET
+			status = 'paid',
+			updated_at = NOW()
+		WHERE id = ANY($1)
+			AND status IN ('approved', 'partially_approved');
+	`
+
+	queryGetPayslipItemsByPayslipIDs = `
+		SELECT
+			id,
+			payslip_id,
+			component,
+			category,
+			description,
+			amount
+		FROM payslip_items
+		WHERE payslip_id = ANY($1)
+		ORDER BY payslip_id, id;
+	`
+
 	queryGetPayslipsByUserID = `
 		SELECT
 			id,
@@ -33,6 +61,45 @@ const (
 		WHERE user_id = $1;
 		`
 
+	queryGetPayslipByID = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			base_salary,
+			working_days,
+			present_days,
+			attendance_amount,
+			overtime_hours,
+			overtime_amount,
+			reimbursement_total,
+			take_home_pay,
+			created_at,
+			updated_at
+		FROM payslips
+		WHERE id = $1;
+		`
+
+	queryGetPayslipsByPeriodID = `
+		SELECT
+			id,
+			user_id,
+			period_id,
+			base_salary,
+			working_days,
+			present_days,
+			attendance_amount,
+			overtime_hours,
+			overtime_amount,
+			reimbursement_total,
+			take_home_pay,
+			created_at,
+			updated_at
+		FROM payslips
+		WHERE period_id = $1
+		ORDER BY user_id;
+		`
+
 	queryPayslipSummaryPerUser = `
 		SELECT user_id, SUM(take_home_pay) AS total_take_home
 		FROM payslips
@@ -45,4 +112,211 @@ const (
 		FROM payslips
 		WHERE period_id = $1;
 	`
+
+	// the version is the next one of the legal entity, the UNIQUE (legal_entity, version) constraint
+	// refuses a concurrent upload that got the same version
+	queryInsertTemplate = `
+		INSERT INTO payslip_templates (legal_entity, version, content, created_by)
+		VALUES (
+			$1,
+			(SELECT COALESCE(MAX(version), 0) + 1 FROM payslip_templates WHERE legal_entity = $1),
+			$2,
+			$3
+		)
+		RETURNING id, version;
+	`
+
+	queryGetTemplateByID = `
+		SELECT
+			id,
+			legal_entity,
+			version,
+			content,
+			is_active,
+			created_by,
+			activated_by,
+			activated_at,
+			created_at,
+			updated_at
+		FROM payslip_templates
+		WHERE id = $1;
+	`
+
+	// the content is left out of the listing, it is fetched per template
+	queryGetTemplates = `
+		SELECT
+			id,
+			legal_entity,
+			version,
+			'' AS content,
+			is_active,
+			created_by,
+			activated_by,
+			activated_at,
+			created_at,
+			updated_at
+		FROM payslip_templates
+		WHERE legal_entity = $1
+		ORDER BY version DESC;
+	`
+
+	// the template of the legal entity wins over the one of the company, the empty legal entity
+	queryGetActiveTemplate = `
+		SELECT
+			id,
+			legal_entity,
+			version,
+			content,
+			is_active,
+			created_by,
+			activated_by,
+			activated_at,
+			created_at,
+			updated_at
+		FROM payslip_templates
+		WHERE is_active
+			AND legal_entity IN ($1, '')
+		ORDER BY legal_entity = $1 DESC
+		LIMIT 1;
+	`
+
+	// activates the template and deactivates the other versions of its legal entity in one statement
+	queryActivateTemplate = `
+		UPDATE payslip_templates
+		SET
+			is_active = (id = $1),
+			activated_by = CASE WHEN id = $1 THEN $2 ELSE activated_by END,
+			activated_at = CASE WHEN id = $1 THEN NOW() ELSE activated_at END,
+			updated_at = NOW()
+		WHERE legal_entity = (SELECT legal_entity FROM payslip_templates WHERE id = $1)
+			AND (is_active OR id = $1);
+	`
+
+	// employees without an email address can't be sent their payslip, their delivery fails right away
+	queryQueuePayslipDeliveries = `
+		INSERT INTO payslip_deliveries (payslip_id, email, status, last_error)
+		SELECT
+			p.id,
+			u.email,
+			CASE WHEN u.email = '' THEN 'failed' ELSE 'queued' END,
+			CASE WHEN u.email = '' THEN 'employee has no email address' ELSE '' END
+		FROM payslips p
+		JOIN users u ON u.id = p.user_id
+		WHERE p.period_id = $1
+		ON CONFLICT (payslip_id) DO NOTHING;
+	`
+
+	// the claimed deliveries count an attempt and are left alone for the lease of $2 seconds, a worker that
+	// stops while sending leaves them to be picked up again after it. Rows claimed by another worker are skipped
+	queryClaimDueDeliveries = `
+		UPDATE payslip_deliveries d
+		SET
+			attempts = d.attempts + 1,
+			next_attempt_at = NOW() + $2 * INTERVAL '1 second',
+			updated_at = NOW()
+		FROM payslips p
+		WHERE p.id = d.payslip_id
+			AND d.id IN (
+				SELECT id FROM payslip_deliveries
+				WHERE status = 'queued' AND next_attempt_at <= NOW()
+				ORDER BY next_attempt_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			d.id,
+			d.payslip_id,
+			p.user_id,
+			p.period_id,
+			d.email,
+			d.status,
+			d.attempts,
+			d.last_error,
+			d.next_attempt_at,
+			d.sent_at,
+			d.created_at,
+			d.updated_at;
+	`
+
+	queryMarkDeliverySent = `
+		UPDATE payslip_deliveries
+		SET status = 'sent', last_error = '', sent_at = NOW(), updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	queryRescheduleDelivery = `
+		UPDATE payslip_deliveries
+		SET last_error = $2, next_attempt_at = $3, updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	queryMarkDeliveryFailed = `
+		UPDATE payslip_deliveries
+		SET status = 'failed', last_error = $2, updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	// a resend takes the current email address of the employee, which may have been corrected since. A queued
+	// delivery due later is leased by a worker or waiting for a retry and is left alone
+	queryRequeueDelivery = `
+		INSERT INTO payslip_deliveries (payslip_id, email, status, last_error)
+		SELECT
+			p.id,
+			u.email,
+			CASE WHEN u.email = '' THEN 'failed' ELSE 'queued' END,
+			CASE WHEN u.email = '' THEN 'employee has no email address' ELSE '' END
+		FROM payslips p
+		JOIN users u ON u.id = p.user_id
+		WHERE p.id = $1
+		ON CONFLICT (payslip_id) DO UPDATE SET
+			email = EXCLUDED.email,
+			status = EXCLUDED.status,
+			attempts = 0,
+			last_error = EXCLUDED.last_error,
+			next_attempt_at = NOW(),
+			sent_at = NULL,
+			updated_at = NOW()
+		WHERE NOT (payslip_deliveries.status = 'queued' AND payslip_deliveries.next_attempt_at > NOW());
+	`
+
+	queryGetDeliveryByPayslipID = `
+		SELECT
+			d.id,
+			d.payslip_id,
+			p.user_id,
+			p.period_id,
+			d.email,
+			d.status,
+			d.attempts,
+			d.last_error,
+			d.next_attempt_at,
+			d.sent_at,
+			d.created_at,
+			d.updated_at
+		FROM payslip_deliveries d
+		JOIN payslips p ON p.id = d.payslip_id
+		WHERE d.payslip_id = $1;
+	`
+
+	// $1 and $2 are optional, 0 and an empty status list the deliveries of every period and status
+	queryGetDeliveries = `
+		SELECT
+			d.id,
+			d.payslip_id,
+			p.user_id,
+			p.period_id,
+			d.email,
+			d.status,
+			d.attempts,
+			d.last_error,
+			d.next_attempt_at,
+			d.sent_at,
+			d.created_at,
+			d.updated_at
+		FROM payslip_deliveries d
+		JOIN payslips p ON p.id = d.payslip_id
+		WHERE ($1 = 0 OR p.period_id = $1)
+			AND ($2 = '' OR d.status = $2)
+		ORDER BY p.period_id DESC, p.user_id;
+	`
 )
@@ -2,6 +2,7 @@ package payslip
 
 import (
 	"context"
+	"time"
 
 	"payslip-generation-system/internal/entity/payslip"
 	"payslip-generation-system/internal/postgres"
@@ -13,6 +14,21 @@ type PayslipRepositoryProvider interface {
 	PayslipExistsByPeriodID(ctx context.Context, periodID int) (bool, error)
 	GetPayslipsByUserID(ctx context.Context, userID int) ([]payslip.Payslip, error) 
 	GetPayslipSummary(ctx context.Context, periodID int) (payslip.PayslipSummaryReport, error)
+	GetPayslipByID(ctx context.Context, id int) (payslip.Payslip, error)
+	GetPayslipsByPeriodID(ctx context.Context, periodID int) ([]payslip.Payslip, error)
+	InsertTemplate(ctx context.Context, tmpl payslip.Template) (int, int, error)
+	GetTemplateByID(ctx context.Context, id int) (payslip.Template, error)
+	GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error)
+	GetActiveTemplate(ctx context.Context, legalEntity string) (payslip.Template, error)
+	ActivateTemplate(ctx context.Context, id, activatedBy int) error
+	QueuePayslipDeliveries(ctx context.Context, periodID int) error
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]payslip.Delivery, error)
+	MarkDeliverySent(ctx context.Context, id int) error
+	RescheduleDelivery(ctx context.Context, id int, lastError string, nextAttemptAt time.Time) error
+	MarkDeliveryFailed(ctx context.Context, id int, lastError string) error
+	RequeueDelivery(ctx context.Context, payslipID int) error
+	GetDeliveryByPayslipID(ctx context.Context, payslipID int) (payslip.Delivery, error)
+	GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error)
 }
 
 type payslipRepository struct {
@@ -59,4 +75,124 @@ func (r *payslipRepository) GetPayslipSummary(ctx context.Context, periodID int)
 		return payslip.PayslipSummaryReport{}, err
 	}
 	return report, nil
-}
\ No newline at end of file
+}
+
+func (r *payslipRepository) GetPayslipByID(ctx context.Context, id int) (payslip.Payslip, error) {
+	p, err := r.db.GetPayslipByID(ctx, id)
+	if err != nil {
+		return payslip.Payslip{}, err
+	}
+	return p, nil
+}
+
+func (r *payslipRepository) GetPayslipsByPeriodID(ctx context.Context, periodID int) ([]payslip.Payslip, error) {
+	payslips, err := r.db.GetPayslipsByPeriodID(ctx, periodID)
+	if err != nil {
+		return []payslip.Payslip{}, err
+	}
+	return payslips, nil
+}
+
+func (r *payslipRepository) InsertTemplate(ctx context.Context, tmpl payslip.Template) (int, int, error) {
+	id, version, err := r.db.InsertTemplate(ctx, tmpl)
+	if err != nil {
+		return 0, 0, err
+	}
+	return id, version, nil
+}
+
+func (r *payslipRepository) GetTemplateByID(ctx context.Context, id int) (payslip.Template, error) {
+	tmpl, err := r.db.GetTemplateByID(ctx, id)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+	return tmpl, nil
+}
+
+func (r *payslipRepository) GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error) {
+	templates, err := r.db.GetTemplates(ctx, legalEntity)
+	if err != nil {
+		return []payslip.Template{}, err
+	}
+	return templates, nil
+}
+
+func (r *payslipRepository) GetActiveTemplate(ctx context.Context, legalEntity string) (payslip.Template, error) {
+	tmpl, err := r.db.GetActiveTemplate(ctx, legalEntity)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+	return tmpl, nil
+}
+
+func (r *payslipRepository) ActivateTemplate(ctx context.Context, id, activatedBy int) error {
+	err := r.db.ActivateTemplate(ctx, id, activatedBy)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *payslipRepository) QueuePayslipDeliveries(ctx context.Context, periodID int) error {
+	err := r.db.QueuePayslipDeliveries(ctx, periodID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *payslipRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]payslip.Delivery, error) {
+	deliveries, err := r.db.ClaimDueDeliveries(ctx, limit, lease)
+	if err != nil {
+		return []payslip.Delivery{}, err
+	}
+	return deliveries, nil
+}
+
+func (r *payslipRepository) MarkDeliverySent(ctx context.Context, id int) error {
+	err := r.db.MarkDeliverySent(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *payslipRepository) RescheduleDelivery(ctx context.Context, id int, lastError string, nextAttemptAt time.Time) error {
+	err := r.db.RescheduleDelivery(ctx, id, lastError, nextAttemptAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *payslipRepository) MarkDeliveryFailed(ctx context.Context, id int, lastError string) error {
+	err := r.db.MarkDeliveryFailed(ctx, id, lastError)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *payslipRepository) RequeueDelivery(ctx context.Context, payslipID int) error {
+	err := r.db.RequeueDelivery(ctx, payslipID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *payslipRepository) GetDeliveryByPayslipID(ctx context.Context, payslipID int) (payslip.Delivery, error) {
+	d, err := r.db.GetDeliveryByPayslipID(ctx, payslipID)
+	if err != nil {
+		return payslip.Delivery{}, err
+	}
+	return d, nil
+}
+
+func (r *payslipRepository) GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error) {
+	deliveries, err := r.db.GetDeliveries(ctx, periodID, status)
+	if err != nil {
+		return []payslip.Delivery{}, err
+	}
+	return deliveries, nil
+}
@@ -2,9 +2,13 @@ package payslip
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"payslip-generation-system/internal/entity/payslip"
 	"payslip-generation-system/internal/postgres"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 //go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
@@ -13,6 +17,21 @@ type dbRepoProvider interface {
 	PayslipExistsByPeriodID(ctx context.Context, periodID int) (bool, error)
 	GetPayslipsByUserID(ctx context.Context, userID int) ([]payslip.Payslip, error)
 	GetPayslipSummary(ctx context.Context, periodID int) (payslip.PayslipSummaryReport, error)
+	GetPayslipByID(ctx context.Context, id int) (payslip.Payslip, error)
+	GetPayslipsByPeriodID(ctx context.Context, periodID int) ([]payslip.Payslip, error)
+	InsertTemplate(ctx context.Context, tmpl payslip.Template) (int, int, error)
+	GetTemplateByID(ctx context.Context, id int) (payslip.Template, error)
+	GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error)
+	GetActiveTemplate(ctx context.Context, legalEntity string) (payslip.Template, error)
+	ActivateTemplate(ctx context.Context, id, activatedBy int) error
+	QueuePayslipDeliveries(ctx context.Context, periodID int) error
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]payslip.Delivery, error)
+	MarkDeliverySent(ctx context.Context, id int) error
+	RescheduleDelivery(ctx context.Context, id int, lastError string, nextAttemptAt time.Time) error
+	MarkDeliveryFailed(ctx context.Context, id int, lastError string) error
+	RequeueDelivery(ctx context.Context, payslipID int) error
+	GetDeliveryByPayslipID(ctx context.Context, payslipID int) (payslip.Delivery, error)
+	GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error)
 }
 
 type dbRepo struct {
@@ -27,6 +46,10 @@ func newDBRepo(
 	}
 }
 
+// BulkInsertPayslips writes the payslips of a run with their items, all or nothing. Payslips are of one
+// period, so an employee has one of them and the ids that come back are matched on the employee.
+// The claims the reimbursement lines pay are marked paid in the same transaction, if one of them is no longer
+// approved nothing is written
 func (r *dbRepo) BulkInsertPayslips(ctx context.Context, payslips []payslip.Payslip) error {
 	if len(payslips) == 0 {
 		return nil
@@ -36,8 +59,14 @@ func (r *dbRepo) BulkInsertPayslips(ctx context.Context, payslips []payslip.Pays
 
 	args := []interface{}{}
 	values := ""
+	indexByUser := map[int]int{}
 
 	for i, p := range payslips {
+		if _, ok := indexByUser[p.UserID]; ok {
+			return fmt.Errorf("user %d has more than one payslip", p.UserID)
+		}
+		indexByUser[p.UserID] = i
+
 		start := i * 10
 		values += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d),",
 			start+1, start+2, start+3, start+4, start+5,
@@ -49,10 +78,91 @@ func (r *dbRepo) BulkInsertPayslips(ctx context.Context, payslips []payslip.Pays
 		)
 	}
 
-	// Remove trailing comma
-	query = query + values[:len(values)-1] + ";"
+	// Remove trailing comma, the order the rows come back in isn't guaranteed so each id comes with its user
+	query = query + values[:len(values)-1] + " RETURNING id, user_id;"
+
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	_, err := r.db.DB.ExecContext(ctx, query, args...)
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	items := []payslip.PayslipItem{}
+	for rows.Next() {
+		var id, userID int
+		if err := rows.Scan(&id, &userID); err != nil {
+			return err
+		}
+		i, ok := indexByUser[userID]
+		if !ok {
+			return fmt.Errorf("payslip %d was inserted for unknown user %d", id, userID)
+		}
+		for _, item := range payslips[i].Items {
+			item.PayslipID = id
+			items = append(items, item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	err = bulkInsertPayslipItems(ctx, tx, items)
+	if err != nil {
+		return err
+	}
+
+	err = markReimbursementsPaid(ctx, tx, items)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func markReimbursementsPaid(ctx context.Context, tx *sql.Tx, items []payslip.PayslipItem) error {
+	ids := []int{}
+	for _, item := range items {
+		ids = append(ids, item.ReimbursementIDs...)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	result, err := tx.ExecContext(ctx, queryMarkReimbursementsPaid, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected != int64(len(ids)) {
+		return fmt.Errorf("a reimbursement on the payslips is no longer approved")
+	}
+	return nil
+}
+
+func bulkInsertPayslipItems(ctx context.Context, tx *sql.Tx, items []payslip.PayslipItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	args := []interface{}{}
+	values := ""
+	for i, item := range items {
+		start := i * 5
+		values += fmt.Sprintf("($%d,$%d,$%d,$%d,$%d),", start+1, start+2, start+3, start+4, start+5)
+		args = append(args, item.PayslipID, item.Component, item.Category, item.Description, item.Amount)
+	}
+
+	query := queryBulkInsertPayslipItems + values[:len(values)-1] + ";"
+	_, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -77,22 +187,7 @@ func (r *dbRepo) GetPayslipsByUserID(ctx context.Context, userID int) ([]payslip
 
 	var payslips []payslip.Payslip
 	for rows.Next() {
-		var p payslip.Payslip
-		err := rows.Scan(
-			&p.ID,
-			&p.UserID,
-			&p.PeriodID,
-			&p.BaseSalary,
-			&p.WorkingDays,
-			&p.PresentDays,
-			&p.AttendanceAmount,
-			&p.OvertimeHours,
-			&p.OvertimeAmount,
-			&p.ReimbursementTotal,
-			&p.TakeHomePay,
-			&p.CreatedAt,
-			&p.UpdatedAt,
-		)
+		p, err := scanPayslip(rows)
 		if err != nil {
 			return []payslip.Payslip{}, err
 		}
@@ -103,9 +198,293 @@ func (r *dbRepo) GetPayslipsByUserID(ctx context.Context, userID int) ([]payslip
 		return []payslip.Payslip{}, err
 	}
 
+	err = r.attachPayslipItems(ctx, payslips)
+	if err != nil {
+		return []payslip.Payslip{}, err
+	}
+
+	return payslips, nil
+}
+
+// GetPayslipByID returns the payslip with its line items, an empty payslip when there is none
+func (r *dbRepo) GetPayslipByID(ctx context.Context, id int) (payslip.Payslip, error) {
+	p, err := scanPayslip(r.db.DB.QueryRowContext(ctx, queryGetPayslipByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payslip.Payslip{}, nil
+		}
+		return payslip.Payslip{}, err
+	}
+
+	payslips := []payslip.Payslip{p}
+	err = r.attachPayslipItems(ctx, payslips)
+	if err != nil {
+		return payslip.Payslip{}, err
+	}
+	return payslips[0], nil
+}
+
+// GetPayslipsByPeriodID returns every payslip of a period with its line items, ordered by employee
+func (r *dbRepo) GetPayslipsByPeriodID(ctx context.Context, periodID int) ([]payslip.Payslip, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetPayslipsByPeriodID, periodID)
+	if err != nil {
+		return []payslip.Payslip{}, err
+	}
+	defer rows.Close()
+
+	payslips := []payslip.Payslip{}
+	for rows.Next() {
+		p, err := scanPayslip(rows)
+		if err != nil {
+			return []payslip.Payslip{}, err
+		}
+		payslips = append(payslips, p)
+	}
+	if err = rows.Err(); err != nil {
+		return []payslip.Payslip{}, err
+	}
+
+	err = r.attachPayslipItems(ctx, payslips)
+	if err != nil {
+		return []payslip.Payslip{}, err
+	}
 	return payslips, nil
 }
 
+// InsertTemplate stores an inactive new version of the template of a legal entity and returns its id and version
+func (r *dbRepo) InsertTemplate(ctx context.Context, tmpl payslip.Template) (int, int, error) {
+	var id, version int
+	err := r.db.DB.QueryRowContext(ctx, queryInsertTemplate, tmpl.LegalEntity, tmpl.Content, tmpl.CreatedBy).Scan(&id, &version)
+	if err != nil {
+		return 0, 0, err
+	}
+	return id, version, nil
+}
+
+func (r *dbRepo) GetTemplateByID(ctx context.Context, id int) (payslip.Template, error) {
+	tmpl, err := scanTemplate(r.db.DB.QueryRowContext(ctx, queryGetTemplateByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payslip.Template{}, nil
+		}
+		return payslip.Template{}, err
+	}
+	return tmpl, nil
+}
+
+// GetTemplates lists the versions of the template of a legal entity without their content, newest first
+func (r *dbRepo) GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetTemplates, legalEntity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := []payslip.Template{}
+	for rows.Next() {
+		tmpl, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, tmpl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// GetActiveTemplate returns the active template of the legal entity, or of the company when the legal entity
+// has none. An empty template means the built-in layout is used
+func (r *dbRepo) GetActiveTemplate(ctx context.Context, legalEntity string) (payslip.Template, error) {
+	tmpl, err := scanTemplate(r.db.DB.QueryRowContext(ctx, queryGetActiveTemplate, legalEntity))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payslip.Template{}, nil
+		}
+		return payslip.Template{}, err
+	}
+	return tmpl, nil
+}
+
+// ActivateTemplate makes the template the only active version of its legal entity
+func (r *dbRepo) ActivateTemplate(ctx context.Context, id, activatedBy int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryActivateTemplate, id, activatedBy)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// QueuePayslipDeliveries queues the email of every payslip of a period, payslips already queued are left as they are
+func (r *dbRepo) QueuePayslipDeliveries(ctx context.Context, periodID int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryQueuePayslipDeliveries, periodID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ClaimDueDeliveries takes up to limit queued deliveries that are due and counts an attempt for each of them,
+// they aren't due again until the lease is over
+func (r *dbRepo) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]payslip.Delivery, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryClaimDueDeliveries, limit, int(lease.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []payslip.Delivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *dbRepo) MarkDeliverySent(ctx context.Context, id int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryMarkDeliverySent, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RescheduleDelivery records why an attempt failed and when to try again
+func (r *dbRepo) RescheduleDelivery(ctx context.Context, id int, lastError string, nextAttemptAt time.Time) error {
+	_, err := r.db.DB.ExecContext(ctx, queryRescheduleDelivery, id, lastError, nextAttemptAt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarkDeliveryFailed gives up on a delivery, only a resend queues it again
+func (r *dbRepo) MarkDeliveryFailed(ctx context.Context, id int, lastError string) error {
+	_, err := r.db.DB.ExecContext(ctx, queryMarkDeliveryFailed, id, lastError)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RequeueDelivery queues the email of a payslip again with no attempts made. A delivery a worker is sending or
+// that waits for its next attempt isn't touched, so the email isn't sent twice
+func (r *dbRepo) RequeueDelivery(ctx context.Context, payslipID int) error {
+	result, err := r.db.DB.ExecContext(ctx, queryRequeueDelivery, payslipID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("payslip is being sent, try again later")
+	}
+	return nil
+}
+
+func (r *dbRepo) GetDeliveryByPayslipID(ctx context.Context, payslipID int) (payslip.Delivery, error) {
+	d, err := scanDelivery(r.db.DB.QueryRowContext(ctx, queryGetDeliveryByPayslipID, payslipID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payslip.Delivery{}, nil
+		}
+		return payslip.Delivery{}, err
+	}
+	return d, nil
+}
+
+func (r *dbRepo) GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetDeliveries, periodID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []payslip.Delivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPayslip(row rowScanner) (payslip.Payslip, error) {
+	var p payslip.Payslip
+	err := row.Scan(
+		&p.ID,
+		&p.UserID,
+		&p.PeriodID,
+		&p.BaseSalary,
+		&p.WorkingDays,
+		&p.PresentDays,
+		&p.AttendanceAmount,
+		&p.OvertimeHours,
+		&p.OvertimeAmount,
+		&p.ReimbursementTotal,
+		&p.TakeHomePay,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	return p, err
+}
+
+// attachPayslipItems loads the line items of the payslips in one query
+func (r *dbRepo) attachPayslipItems(ctx context.Context, payslips []payslip.Payslip) error {
+	if len(payslips) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(payslips))
+	index := map[int]int{}
+	for i, p := range payslips {
+		ids = append(ids, p.ID)
+		index[p.ID] = i
+	}
+
+	rows, err := r.db.DB.QueryContext(ctx, queryGetPayslipItemsByPayslipIDs, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item payslip.PayslipItem
+		err := rows.Scan(
+			&item.ID,
+			&item.PayslipID,
+			&item.Component,
+			&item.Category,
+			&item.Description,
+			&item.Amount,
+		)
+		if err != nil {
+			return err
+		}
+		i := index[item.PayslipID]
+		payslips[i].Items = append(payslips[i].Items, item)
+	}
+	return rows.Err()
+}
+
 func (r *dbRepo) GetPayslipSummary(ctx context.Context, periodID int) (payslip.PayslipSummaryReport, error) {
 	rows, err := r.db.DB.QueryContext(ctx, queryPayslipSummaryPerUser, periodID)
 	if err != nil {
@@ -135,4 +514,40 @@ func (r *dbRepo) GetPayslipSummary(ctx context.Context, periodID int) (payslip.P
 		PerUser: summaries,
 		Total:   total,
 	}, nil
-}
\ No newline at end of file
+}
+
+func scanTemplate(row rowScanner) (payslip.Template, error) {
+	var tmpl payslip.Template
+	err := row.Scan(
+		&tmpl.ID,
+		&tmpl.LegalEntity,
+		&tmpl.Version,
+		&tmpl.Content,
+		&tmpl.IsActive,
+		&tmpl.CreatedBy,
+		&tmpl.ActivatedBy,
+		&tmpl.ActivatedAt,
+		&tmpl.CreatedAt,
+		&tmpl.UpdatedAt,
+	)
+	return tmpl, err
+}
+
+func scanDelivery(row rowScanner) (payslip.Delivery, error) {
+	var d payslip.Delivery
+	err := row.Scan(
+		&d.ID,
+		&d.PayslipID,
+		&d.UserID,
+		&d.PeriodID,
+		&d.Email,
+		&d.Status,
+		&d.Attempts,
+		&d.LastError,
+		&d.NextAttemptAt,
+		&d.SentAt,
+		&d.CreatedAt,
+		&d.UpdatedAt,
+	)
+	return d, err
+}
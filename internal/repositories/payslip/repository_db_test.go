@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -85,6 +86,9 @@ func Test_dbRepo_GetPayslipsByUserID(t *testing.T) {
 				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipsByUserID)).
 					WithArgs(mockUserID).
 					WillReturnRows(rows)
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipItemsByPayslipIDs)).
+					WithArgs(pq.Array([]int{mockData[0].ID, mockData[1].ID})).
+					WillReturnRows(getMockPayslipItemsRows(mockData))
 			},
 			args:    args{ctx: context.Background(), userID: mockUserID},
 			want:    mockData,
@@ -160,10 +164,15 @@ func getMockPayslipsData(userID int) []payslip.Payslip {
 			WorkingDays:        22,
 			PresentDays:        22,
 			AttendanceAmount:   8000000,
-			OvertimeHours:      10,
+			OvertimeHours:      10.25,
 			OvertimeAmount:     500000,
 			ReimbursementTotal: 250000,
 			TakeHomePay:        8750000,
+			Items: []payslip.PayslipItem{
+				{ID: 1, PayslipID: 1, Component: payslip.ComponentAttendance, Description: "Attendance", Amount: 8000000},
+				{ID: 2, PayslipID: 1, Component: payslip.ComponentOvertime, Description: "Overtime", Amount: 500000},
+				{ID: 3, PayslipID: 1, Component: payslip.ComponentReimbursement, Category: "meal", Description: "Meal", Amount: 250000},
+			},
 			CreatedAt:          mockTime,
 			UpdatedAt:          mockTime,
 		},
@@ -202,4 +211,684 @@ func getMockPayslipsRows(data []payslip.Payslip) *sqlmock.Rows {
 		)
 	}
 	return rows
-}
\ No newline at end of file
+}
+
+func getMockPayslipItemsRows(data []payslip.Payslip) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "payslip_id", "component", "category", "description", "amount"})
+	for _, p := range data {
+		for _, item := range p.Items {
+			rows.AddRow(item.ID, item.PayslipID, item.Component, item.Category, item.Description, item.Amount)
+		}
+	}
+	return rows
+}
+
+func Test_dbRepo_BulkInsertPayslips(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mockPayslips := []payslip.Payslip{
+		{
+			UserID: 101, PeriodID: 7, BaseSalary: 8000000, WorkingDays: 20, PresentDays: 20,
+			AttendanceAmount: 8000000, ReimbursementTotal: 100000, TakeHomePay: 8100000,
+			Items: []payslip.PayslipItem{
+				{Component: payslip.ComponentAttendance, Description: "Attendance", Amount: 8000000},
+				{Component: payslip.ComponentReimbursement, Category: "meal", Description: "Meal", Amount: 100000, ReimbursementIDs: []int{5, 6}},
+			},
+		},
+		{
+			UserID: 102, PeriodID: 7, BaseSalary: 5000000, WorkingDays: 20, PresentDays: 0,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectBegin()
+				// the ids don't come back in the order of the values, items follow the payslip of their user
+				mock.ExpectQuery(regexp.QuoteMeta(queryBulkInsertPayslips)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(12, 102).AddRow(11, 101))
+				mock.ExpectExec(regexp.QuoteMeta(queryBulkInsertPayslipItems)).
+					WithArgs(11, payslip.ComponentAttendance, "", "Attendance", 8000000, 11, payslip.ComponentReimbursement, "meal", "Meal", 100000).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(queryMarkReimbursementsPaid)).
+					WithArgs(pq.Array([]int{5, 6})).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error - Reimbursement No Longer Approved",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryBulkInsertPayslips)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(11, 101).AddRow(12, 102))
+				mock.ExpectExec(regexp.QuoteMeta(queryBulkInsertPayslipItems)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(regexp.QuoteMeta(queryMarkReimbursementsPaid)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error - Insert Items Failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryBulkInsertPayslips)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(11, 101).AddRow(12, 102))
+				mock.ExpectExec(regexp.QuoteMeta(queryBulkInsertPayslipItems)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error - Insert Payslips Failed",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryBulkInsertPayslips)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.BulkInsertPayslips(context.Background(), mockPayslips)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetPayslipByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mockData := getMockPayslipsData(101)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		id      int
+		want    payslip.Payslip
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - With Items",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipByID)).
+					WithArgs(mockData[0].ID).
+					WillReturnRows(getMockPayslipsRows(mockData[:1]))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipItemsByPayslipIDs)).
+					WithArgs(pq.Array([]int{mockData[0].ID})).
+					WillReturnRows(getMockPayslipItemsRows(mockData[:1]))
+			},
+			id:   mockData[0].ID,
+			want: mockData[0],
+		},
+		{
+			name: "Happy Path - Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipByID)).
+					WithArgs(99).
+					WillReturnError(sql.ErrNoRows)
+			},
+			id:   99,
+			want: payslip.Payslip{},
+		},
+		{
+			name: "Error - Query Failed",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipByID)).
+					WithArgs(1).
+					WillReturnError(sql.ErrConnDone)
+			},
+			id:      1,
+			want:    payslip.Payslip{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.GetPayslipByID(context.Background(), tt.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_dbRepo_GetPayslipsByPeriodID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mockData := getMockPayslipsData(101)
+	mockPeriodID := 202405
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []payslip.Payslip
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - Multiple Rows",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipsByPeriodID)).
+					WithArgs(mockPeriodID).
+					WillReturnRows(getMockPayslipsRows(mockData))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipItemsByPayslipIDs)).
+					WithArgs(pq.Array([]int{mockData[0].ID, mockData[1].ID})).
+					WillReturnRows(getMockPayslipItemsRows(mockData))
+			},
+			want: mockData,
+		},
+		{
+			name: "Happy Path - No Rows",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipsByPeriodID)).
+					WithArgs(mockPeriodID).
+					WillReturnRows(getMockPayslipsRows(nil))
+			},
+			want: []payslip.Payslip{},
+		},
+		{
+			name: "Error - Query Failed",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPayslipsByPeriodID)).
+					WithArgs(mockPeriodID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    []payslip.Payslip{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{db: &postgres.Postgres{DB: db}}
+			got, err := r.GetPayslipsByPeriodID(context.Background(), mockPeriodID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_dbRepo_InsertTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTemplate := getMockTemplate(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryInsertTemplate)).
+		WithArgs(mockTemplate.LegalEntity, mockTemplate.Content, mockTemplate.CreatedBy).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(mockTemplate.ID, mockTemplate.Version))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	id, version, err := r.InsertTemplate(context.Background(), mockTemplate)
+	assert.NoError(t, err)
+	assert.Equal(t, mockTemplate.ID, id)
+	assert.Equal(t, mockTemplate.Version, version)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetTemplateByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTemplate := getMockTemplate(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    payslip.Template
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetTemplateByID)).
+					WithArgs(mockTemplate.ID).
+					WillReturnRows(getMockTemplateRows(mockTemplate))
+			},
+			want:    mockTemplate,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetTemplateByID)).
+					WithArgs(mockTemplate.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    payslip.Template{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetTemplateByID)).
+					WithArgs(mockTemplate.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    payslip.Template{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetTemplateByID(context.Background(), mockTemplate.ID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetTemplates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTemplate := getMockTemplate(time.Now())
+	mockTemplate.Content = ""
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetTemplates)).
+		WithArgs(mockTemplate.LegalEntity).
+		WillReturnRows(getMockTemplateRows(mockTemplate))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetTemplates(context.Background(), mockTemplate.LegalEntity)
+	assert.NoError(t, err)
+	assert.Equal(t, []payslip.Template{mockTemplate}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetActiveTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTemplate := getMockTemplate(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    payslip.Template
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetActiveTemplate)).
+					WithArgs(mockTemplate.LegalEntity).
+					WillReturnRows(getMockTemplateRows(mockTemplate))
+			},
+			want:    mockTemplate,
+			wantErr: false,
+		},
+		{
+			name: "No active template",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetActiveTemplate)).
+					WithArgs(mockTemplate.LegalEntity).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    payslip.Template{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetActiveTemplate)).
+					WithArgs(mockTemplate.LegalEntity).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    payslip.Template{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetActiveTemplate(context.Background(), mockTemplate.LegalEntity)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_ActivateTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta(queryActivateTemplate)).
+		WithArgs(4, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	err = r.ActivateTemplate(context.Background(), 4, 1)
+	assert.NoError(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func getMockTemplate(mocktime time.Time) payslip.Template {
+	adminID := 1
+	return payslip.Template{
+		ID:          4,
+		LegalEntity: "PT Maju Jaya Logistik",
+		Version:     2,
+		Content:     "<h1>{{.Company.Name}}</h1>",
+		IsActive:    true,
+		CreatedBy:   &adminID,
+		ActivatedBy: &adminID,
+		ActivatedAt: &mocktime,
+		CreatedAt:   mocktime,
+		UpdatedAt:   mocktime,
+	}
+}
+
+func getMockTemplateRows(tmpl payslip.Template) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "legal_entity", "version", "content", "is_active",
+		"created_by", "activated_by", "activated_at", "created_at", "updated_at",
+	}).AddRow(
+		tmpl.ID, tmpl.LegalEntity, tmpl.Version, tmpl.Content, tmpl.IsActive,
+		*tmpl.CreatedBy, *tmpl.ActivatedBy, *tmpl.ActivatedAt, tmpl.CreatedAt, tmpl.UpdatedAt,
+	)
+}
+
+func Test_dbRepo_QueuePayslipDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta(queryQueuePayslipDeliveries)).
+		WithArgs(202506).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	err = r.QueuePayslipDeliveries(context.Background(), 202506)
+	assert.NoError(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_ClaimDueDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockDelivery := getMockDelivery(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []payslip.Delivery
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryClaimDueDeliveries)).
+					WithArgs(20, 600).
+					WillReturnRows(getMockDeliveryRows(mockDelivery))
+			},
+			want:    []payslip.Delivery{mockDelivery},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryClaimDueDeliveries)).
+					WithArgs(20, 600).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.ClaimDueDeliveries(context.Background(), 20, 10*time.Minute)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	nextAttemptAt := time.Date(2025, 7, 1, 9, 5, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta(queryMarkDeliverySent)).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(queryRescheduleDelivery)).
+		WithArgs(7, "smtp connect: connection refused", nextAttemptAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(queryMarkDeliveryFailed)).
+		WithArgs(7, "smtp rcpt to: 550 no such user").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(queryRequeueDelivery)).
+		WithArgs(12).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectExec(regexp.QuoteMeta(queryRequeueDelivery)).
+		WithArgs(13).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(queryRequeueDelivery)).
+		WithArgs(14).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	assert.NoError(t, r.MarkDeliverySent(context.Background(), 7))
+	assert.NoError(t, r.RescheduleDelivery(context.Background(), 7, "smtp connect: connection refused", nextAttemptAt))
+	assert.NoError(t, r.MarkDeliveryFailed(context.Background(), 7, "smtp rcpt to: 550 no such user"))
+	assert.Error(t, r.RequeueDelivery(context.Background(), 12))
+	assert.ErrorContains(t, r.RequeueDelivery(context.Background(), 13), "being sent")
+	assert.NoError(t, r.RequeueDelivery(context.Background(), 14))
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetDeliveryByPayslipID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockDelivery := getMockDelivery(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetDeliveryByPayslipID)).
+		WithArgs(mockDelivery.PayslipID).
+		WillReturnRows(getMockDeliveryRows(mockDelivery))
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetDeliveryByPayslipID)).
+		WithArgs(99).
+		WillReturnError(sql.ErrNoRows)
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetDeliveryByPayslipID(context.Background(), mockDelivery.PayslipID)
+	assert.NoError(t, err)
+	assert.Equal(t, mockDelivery, got)
+
+	got, err = r.GetDeliveryByPayslipID(context.Background(), 99)
+	assert.NoError(t, err)
+	assert.Equal(t, payslip.Delivery{}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetDeliveries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockDelivery := getMockDelivery(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetDeliveries)).
+		WithArgs(mockDelivery.PeriodID, payslip.DeliveryStatusSent).
+		WillReturnRows(getMockDeliveryRows(mockDelivery))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetDeliveries(context.Background(), mockDelivery.PeriodID, payslip.DeliveryStatusSent)
+	assert.NoError(t, err)
+	assert.Equal(t, []payslip.Delivery{mockDelivery}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func getMockDelivery(mocktime time.Time) payslip.Delivery {
+	return payslip.Delivery{
+		ID:            7,
+		PayslipID:     12,
+		UserID:        3,
+		PeriodID:      202506,
+		Email:         "budi@example.com",
+		Status:        payslip.DeliveryStatusSent,
+		Attempts:      1,
+		LastError:     "",
+		NextAttemptAt: mocktime,
+		SentAt:        &mocktime,
+		CreatedAt:     mocktime,
+		UpdatedAt:     mocktime,
+	}
+}
+
+func getMockDeliveryRows(d payslip.Delivery) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "payslip_id", "user_id", "period_id", "email", "status", "attempts",
+		"last_error", "next_attempt_at", "sent_at", "created_at", "updated_at",
+	}).AddRow(
+		d.ID, d.PayslipID, d.UserID, d.PeriodID, d.Email, d.Status, d.Attempts,
+		d.LastError, d.NextAttemptAt, *d.SentAt, d.CreatedAt, d.UpdatedAt,
+	)
+}
@@ -6,8 +6,10 @@ package mock
 
 import (
 	context "context"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	reimbursement "payslip-generation-system/internal/entity/reimbursement"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,11 +37,251 @@ func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
 	return m.recorder
 }
 
+// DeleteReimbursement mocks base method.
+func (m *MockdbRepoProvider) DeleteReimbursement(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReimbursement", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReimbursement indicates an expected call of DeleteReimbursement.
+func (mr *MockdbRepoProviderMockRecorder) DeleteReimbursement(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReimbursement", reflect.TypeOf((*MockdbRepoProvider)(nil).DeleteReimbursement), ctx, id)
+}
+
+// GetAttachmentByID mocks base method.
+func (m *MockdbRepoProvider) GetAttachmentByID(ctx context.Context, id int) (reimbursement.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByID", ctx, id)
+	ret0, _ := ret[0].(reimbursement.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByID indicates an expected call of GetAttachmentByID.
+func (mr *MockdbRepoProviderMockRecorder) GetAttachmentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttachmentByID), ctx, id)
+}
+
+// GetAttachmentsByChecksums mocks base method.
+func (m *MockdbRepoProvider) GetAttachmentsByChecksums(ctx context.Context, checksums []string) ([]reimbursement.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByChecksums", ctx, checksums)
+	ret0, _ := ret[0].([]reimbursement.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByChecksums indicates an expected call of GetAttachmentsByChecksums.
+func (mr *MockdbRepoProviderMockRecorder) GetAttachmentsByChecksums(ctx, checksums interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByChecksums", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAttachmentsByChecksums), ctx, checksums)
+}
+
+// GetCategories mocks base method.
+func (m *MockdbRepoProvider) GetCategories(ctx context.Context) ([]reimbursement.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", ctx)
+	ret0, _ := ret[0].([]reimbursement.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockdbRepoProviderMockRecorder) GetCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockdbRepoProvider)(nil).GetCategories), ctx)
+}
+
+// GetCategoryByCode mocks base method.
+func (m *MockdbRepoProvider) GetCategoryByCode(ctx context.Context, code string) (reimbursement.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByCode", ctx, code)
+	ret0, _ := ret[0].(reimbursement.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByCode indicates an expected call of GetCategoryByCode.
+func (mr *MockdbRepoProviderMockRecorder) GetCategoryByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByCode", reflect.TypeOf((*MockdbRepoProvider)(nil).GetCategoryByCode), ctx, code)
+}
+
+// GetCategoryUsage mocks base method.
+func (m *MockdbRepoProvider) GetCategoryUsage(ctx context.Context, userID, categoryID, periodID, year int) (reimbursement.CategoryUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryUsage", ctx, userID, categoryID, periodID, year)
+	ret0, _ := ret[0].(reimbursement.CategoryUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryUsage indicates an expected call of GetCategoryUsage.
+func (mr *MockdbRepoProviderMockRecorder) GetCategoryUsage(ctx, userID, categoryID, periodID, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryUsage", reflect.TypeOf((*MockdbRepoProvider)(nil).GetCategoryUsage), ctx, userID, categoryID, periodID, year)
+}
+
+// GetDuplicateReport mocks base method.
+func (m *MockdbRepoProvider) GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDuplicateReport", ctx, status, periodID)
+	ret0, _ := ret[0].([]reimbursement.DuplicateReportEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDuplicateReport indicates an expected call of GetDuplicateReport.
+func (mr *MockdbRepoProviderMockRecorder) GetDuplicateReport(ctx, status, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateReport", reflect.TypeOf((*MockdbRepoProvider)(nil).GetDuplicateReport), ctx, status, periodID)
+}
+
+// GetExchangeRate mocks base method.
+func (m *MockdbRepoProvider) GetExchangeRate(ctx context.Context, currency string, onDate time.Time) (reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", ctx, currency, onDate)
+	ret0, _ := ret[0].(reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockdbRepoProviderMockRecorder) GetExchangeRate(ctx, currency, onDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetExchangeRate), ctx, currency, onDate)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockdbRepoProvider) GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx, currency)
+	ret0, _ := ret[0].([]reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockdbRepoProviderMockRecorder) GetExchangeRates(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockdbRepoProvider)(nil).GetExchangeRates), ctx, currency)
+}
+
+// GetRate mocks base method.
+func (m *MockdbRepoProvider) GetRate(ctx context.Context, calculation, destination string, onDate time.Time) (reimbursement.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, calculation, destination, onDate)
+	ret0, _ := ret[0].(reimbursement.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockdbRepoProviderMockRecorder) GetRate(ctx, calculation, destination, onDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetRate), ctx, calculation, destination, onDate)
+}
+
+// GetRates mocks base method.
+func (m *MockdbRepoProvider) GetRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx, calculation)
+	ret0, _ := ret[0].([]reimbursement.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockdbRepoProviderMockRecorder) GetRates(ctx, calculation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockdbRepoProvider)(nil).GetRates), ctx, calculation)
+}
+
+// GetRecentReimbursementsByUserID mocks base method.
+func (m *MockdbRepoProvider) GetRecentReimbursementsByUserID(ctx context.Context, userID int, since time.Time) ([]reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentReimbursementsByUserID", ctx, userID, since)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentReimbursementsByUserID indicates an expected call of GetRecentReimbursementsByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetRecentReimbursementsByUserID(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentReimbursementsByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetRecentReimbursementsByUserID), ctx, userID, since)
+}
+
+// GetReimbursementByID mocks base method.
+func (m *MockdbRepoProvider) GetReimbursementByID(ctx context.Context, id int) (reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementByID", ctx, id)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementByID indicates an expected call of GetReimbursementByID.
+func (mr *MockdbRepoProviderMockRecorder) GetReimbursementByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetReimbursementByID), ctx, id)
+}
+
+// GetReimbursementTotalsByCategory mocks base method.
+func (m *MockdbRepoProvider) GetReimbursementTotalsByCategory(ctx context.Context, periodID int) ([]reimbursement.CategoryTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementTotalsByCategory", ctx, periodID)
+	ret0, _ := ret[0].([]reimbursement.CategoryTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementTotalsByCategory indicates an expected call of GetReimbursementTotalsByCategory.
+func (mr *MockdbRepoProviderMockRecorder) GetReimbursementTotalsByCategory(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementTotalsByCategory", reflect.TypeOf((*MockdbRepoProvider)(nil).GetReimbursementTotalsByCategory), ctx, periodID)
+}
+
+// GetReimbursementsByStatus mocks base method.
+func (m *MockdbRepoProvider) GetReimbursementsByStatus(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementsByStatus", ctx, status, periodID)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementsByStatus indicates an expected call of GetReimbursementsByStatus.
+func (mr *MockdbRepoProviderMockRecorder) GetReimbursementsByStatus(ctx, status, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementsByStatus", reflect.TypeOf((*MockdbRepoProvider)(nil).GetReimbursementsByStatus), ctx, status, periodID)
+}
+
+// GetReimbursementsByUserID mocks base method.
+func (m *MockdbRepoProvider) GetReimbursementsByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementsByUserID", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReimbursementsByUserID indicates an expected call of GetReimbursementsByUserID.
+func (mr *MockdbRepoProviderMockRecorder) GetReimbursementsByUserID(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementsByUserID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetReimbursementsByUserID), ctx, userID, periodID, page)
+}
+
 // InsertReimbursement mocks base method.
-func (m *MockdbRepoProvider) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (int, error) {
+func (m *MockdbRepoProvider) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertReimbursement", ctx, rmb)
-	ret0, _ := ret[0].(int)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -49,3 +291,116 @@ func (mr *MockdbRepoProviderMockRecorder) InsertReimbursement(ctx, rmb interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReimbursement", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertReimbursement), ctx, rmb)
 }
+
+// UpdateCategory mocks base method.
+func (m *MockdbRepoProvider) UpdateCategory(ctx context.Context, category reimbursement.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockdbRepoProviderMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateCategory), ctx, category)
+}
+
+// UpdateReimbursementClaim mocks base method.
+func (m *MockdbRepoProvider) UpdateReimbursementClaim(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReimbursementClaim", ctx, rmb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReimbursementClaim indicates an expected call of UpdateReimbursementClaim.
+func (mr *MockdbRepoProviderMockRecorder) UpdateReimbursementClaim(ctx, rmb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReimbursementClaim", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateReimbursementClaim), ctx, rmb)
+}
+
+// UpdateReimbursementReview mocks base method.
+func (m *MockdbRepoProvider) UpdateReimbursementReview(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReimbursementReview", ctx, rmb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReimbursementReview indicates an expected call of UpdateReimbursementReview.
+func (mr *MockdbRepoProviderMockRecorder) UpdateReimbursementReview(ctx, rmb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReimbursementReview", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateReimbursementReview), ctx, rmb)
+}
+
+// UpsertExchangeRate mocks base method.
+func (m *MockdbRepoProvider) UpsertExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExchangeRate", ctx, rate)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertExchangeRate indicates an expected call of UpsertExchangeRate.
+func (mr *MockdbRepoProviderMockRecorder) UpsertExchangeRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExchangeRate", reflect.TypeOf((*MockdbRepoProvider)(nil).UpsertExchangeRate), ctx, rate)
+}
+
+// UpsertRate mocks base method.
+func (m *MockdbRepoProvider) UpsertRate(ctx context.Context, rate reimbursement.Rate) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRate", ctx, rate)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRate indicates an expected call of UpsertRate.
+func (mr *MockdbRepoProviderMockRecorder) UpsertRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRate", reflect.TypeOf((*MockdbRepoProvider)(nil).UpsertRate), ctx, rate)
+}
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
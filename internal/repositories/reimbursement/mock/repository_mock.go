@@ -6,8 +6,10 @@ package mock
 
 import (
 	context "context"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	reimbursement "payslip-generation-system/internal/entity/reimbursement"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,11 +37,251 @@ func (m *MockReimbursementRepositoryProvider) EXPECT() *MockReimbursementReposit
 	return m.recorder
 }
 
+// DeleteReimbursement mocks base method.
+func (m *MockReimbursementRepositoryProvider) DeleteReimbursement(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReimbursement", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReimbursement indicates an expected call of DeleteReimbursement.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) DeleteReimbursement(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReimbursement", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).DeleteReimbursement), ctx, id)
+}
+
+// GetAttachmentByID mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetAttachmentByID(ctx context.Context, id int) (reimbursement.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentByID", ctx, id)
+	ret0, _ := ret[0].(reimbursement.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentByID indicates an expected call of GetAttachmentByID.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetAttachmentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentByID", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetAttachmentByID), ctx, id)
+}
+
+// GetAttachmentsByChecksums mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetAttachmentsByChecksums(ctx context.Context, checksums []string) ([]reimbursement.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByChecksums", ctx, checksums)
+	ret0, _ := ret[0].([]reimbursement.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByChecksums indicates an expected call of GetAttachmentsByChecksums.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetAttachmentsByChecksums(ctx, checksums interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByChecksums", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetAttachmentsByChecksums), ctx, checksums)
+}
+
+// GetCategories mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetCategories(ctx context.Context) ([]reimbursement.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", ctx)
+	ret0, _ := ret[0].([]reimbursement.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetCategories), ctx)
+}
+
+// GetCategoryByCode mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetCategoryByCode(ctx context.Context, code string) (reimbursement.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByCode", ctx, code)
+	ret0, _ := ret[0].(reimbursement.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByCode indicates an expected call of GetCategoryByCode.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetCategoryByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByCode", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetCategoryByCode), ctx, code)
+}
+
+// GetCategoryUsage mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetCategoryUsage(ctx context.Context, userID, categoryID, periodID, year int) (reimbursement.CategoryUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryUsage", ctx, userID, categoryID, periodID, year)
+	ret0, _ := ret[0].(reimbursement.CategoryUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryUsage indicates an expected call of GetCategoryUsage.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetCategoryUsage(ctx, userID, categoryID, periodID, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryUsage", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetCategoryUsage), ctx, userID, categoryID, periodID, year)
+}
+
+// GetDuplicateReport mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDuplicateReport", ctx, status, periodID)
+	ret0, _ := ret[0].([]reimbursement.DuplicateReportEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDuplicateReport indicates an expected call of GetDuplicateReport.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetDuplicateReport(ctx, status, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateReport", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetDuplicateReport), ctx, status, periodID)
+}
+
+// GetExchangeRate mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetExchangeRate(ctx context.Context, currency string, onDate time.Time) (reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRate", ctx, currency, onDate)
+	ret0, _ := ret[0].(reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRate indicates an expected call of GetExchangeRate.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetExchangeRate(ctx, currency, onDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRate", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetExchangeRate), ctx, currency, onDate)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx, currency)
+	ret0, _ := ret[0].([]reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetExchangeRates(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetExchangeRates), ctx, currency)
+}
+
+// GetRate mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetRate(ctx context.Context, calculation, destination string, onDate time.Time) (reimbursement.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, calculation, destination, onDate)
+	ret0, _ := ret[0].(reimbursement.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetRate(ctx, calculation, destination, onDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetRate), ctx, calculation, destination, onDate)
+}
+
+// GetRates mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx, calculation)
+	ret0, _ := ret[0].([]reimbursement.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetRates(ctx, calculation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetRates), ctx, calculation)
+}
+
+// GetRecentReimbursementsByUserID mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetRecentReimbursementsByUserID(ctx context.Context, userID int, since time.Time) ([]reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentReimbursementsByUserID", ctx, userID, since)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentReimbursementsByUserID indicates an expected call of GetRecentReimbursementsByUserID.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetRecentReimbursementsByUserID(ctx, userID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentReimbursementsByUserID", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetRecentReimbursementsByUserID), ctx, userID, since)
+}
+
+// GetReimbursementByID mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetReimbursementByID(ctx context.Context, id int) (reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementByID", ctx, id)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementByID indicates an expected call of GetReimbursementByID.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetReimbursementByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementByID", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetReimbursementByID), ctx, id)
+}
+
+// GetReimbursementTotalsByCategory mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetReimbursementTotalsByCategory(ctx context.Context, periodID int) ([]reimbursement.CategoryTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementTotalsByCategory", ctx, periodID)
+	ret0, _ := ret[0].([]reimbursement.CategoryTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementTotalsByCategory indicates an expected call of GetReimbursementTotalsByCategory.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetReimbursementTotalsByCategory(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementTotalsByCategory", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetReimbursementTotalsByCategory), ctx, periodID)
+}
+
+// GetReimbursementsByStatus mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetReimbursementsByStatus(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementsByStatus", ctx, status, periodID)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementsByStatus indicates an expected call of GetReimbursementsByStatus.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetReimbursementsByStatus(ctx, status, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementsByStatus", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetReimbursementsByStatus), ctx, status, periodID)
+}
+
+// GetReimbursementsByUserID mocks base method.
+func (m *MockReimbursementRepositoryProvider) GetReimbursementsByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementsByUserID", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReimbursementsByUserID indicates an expected call of GetReimbursementsByUserID.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) GetReimbursementsByUserID(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementsByUserID", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).GetReimbursementsByUserID), ctx, userID, periodID, page)
+}
+
 // InsertReimbursement mocks base method.
-func (m *MockReimbursementRepositoryProvider) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (int, error) {
+func (m *MockReimbursementRepositoryProvider) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertReimbursement", ctx, rmb)
-	ret0, _ := ret[0].(int)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -49,3 +291,75 @@ func (mr *MockReimbursementRepositoryProviderMockRecorder) InsertReimbursement(c
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReimbursement", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).InsertReimbursement), ctx, rmb)
 }
+
+// UpdateCategory mocks base method.
+func (m *MockReimbursementRepositoryProvider) UpdateCategory(ctx context.Context, category reimbursement.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).UpdateCategory), ctx, category)
+}
+
+// UpdateReimbursementClaim mocks base method.
+func (m *MockReimbursementRepositoryProvider) UpdateReimbursementClaim(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReimbursementClaim", ctx, rmb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReimbursementClaim indicates an expected call of UpdateReimbursementClaim.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) UpdateReimbursementClaim(ctx, rmb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReimbursementClaim", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).UpdateReimbursementClaim), ctx, rmb)
+}
+
+// UpdateReimbursementReview mocks base method.
+func (m *MockReimbursementRepositoryProvider) UpdateReimbursementReview(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReimbursementReview", ctx, rmb)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReimbursementReview indicates an expected call of UpdateReimbursementReview.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) UpdateReimbursementReview(ctx, rmb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReimbursementReview", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).UpdateReimbursementReview), ctx, rmb)
+}
+
+// UpsertExchangeRate mocks base method.
+func (m *MockReimbursementRepositoryProvider) UpsertExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExchangeRate", ctx, rate)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertExchangeRate indicates an expected call of UpsertExchangeRate.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) UpsertExchangeRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExchangeRate", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).UpsertExchangeRate), ctx, rate)
+}
+
+// UpsertRate mocks base method.
+func (m *MockReimbursementRepositoryProvider) UpsertRate(ctx context.Context, rate reimbursement.Rate) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRate", ctx, rate)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRate indicates an expected call of UpsertRate.
+func (mr *MockReimbursementRepositoryProviderMockRecorder) UpsertRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRate", reflect.TypeOf((*MockReimbursementRepositoryProvider)(nil).UpsertRate), ctx, rate)
+}
@@ -5,13 +5,537 @@ const (
 		INSERT INTO reimbursements (
 			user_id,
 			period_id,
+			category_id,
+			currency,
+			original_amount,
+			exchange_rate,
+			exchange_rate_date,
+			requested_amount,
 			amount,
-			description
+			description,
+			calculation,
+			distance_km,
+			travel_days,
+			destination,
+			rate_id,
+			unit_rate
 		) VALUES (
 			$1,
 			$2,
 			$3,
-			$4
+			$4,
+			$5,
+			$6,
+			$7,
+			$8,
+			$9,
+			$10,
+			$11,
+			$12,
+			$13,
+			$14,
+			$15,
+			$16
 		) RETURNING id;
 	`
+
+	queryGetCategoryByCode = `
+		SELECT
+			id,
+			code,
+			name,
+			per_claim_limit,
+			per_period_limit,
+			per_year_limit,
+			eligible_grades,
+			over_limit_action,
+			calculation,
+			created_at,
+			updated_at
+		FROM reimbursement_categories
+		WHERE code = $1;
+	`
+
+	queryGetCategories = `
+		SELECT
+			id,
+			code,
+			name,
+			per_claim_limit,
+			per_period_limit,
+			per_year_limit,
+			eligible_grades,
+			over_limit_action,
+			calculation,
+			created_at,
+			updated_at
+		FROM reimbursement_categories
+		ORDER BY code;
+	`
+
+	queryUpdateCategory = `
+		UPDATE reimbursement_categories
+		SET
+			name = $2,
+			per_claim_limit = $3,
+			per_period_limit = $4,
+			per_year_limit = $5,
+			eligible_grades = $6,
+			over_limit_action = $7,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	// the year is the calendar year the claim's attendance period starts in,
+	// rejected claims don't use the allowance and reviewed claims only use what was approved
+	queryGetCategoryUsage = `
+		SELECT
+			COALESCE(SUM(COALESCE(r.approved_amount, r.amount)) FILTER (WHERE r.period_id = $3), 0) AS period_total,
+			COALESCE(SUM(COALESCE(r.approved_amount, r.amount)), 0) AS year_total
+		FROM reimbursements r
+		JOIN attendance_periods p ON p.id = r.period_id
+		WHERE r.user_id = $1
+			AND r.category_id = $2
+			AND r.status <> 'rejected'
+			AND EXTRACT(YEAR FROM p.start_date) = $4;
+	`
+
+	// only approved amounts are paid out
+	queryGetReimbursementTotalsByCategory = `
+		SELECT
+			r.user_id,
+			COALESCE(c.code, '') AS category,
+			COALESCE(c.name, '') AS name,
+			SUM(r.approved_amount) AS amount,
+			ARRAY_AGG(r.id ORDER BY r.id) AS reimbursement_ids
+		FROM reimbursements r
+		LEFT JOIN reimbursement_categories c ON c.id = r.category_id
+		WHERE r.period_id = $1
+			AND r.status IN ('approved', 'partially_approved')
+		GROUP BY r.user_id, c.code, c.name
+		ORDER BY r.user_id, c.code;
+	`
+
+	queryGetReimbursementByID = `
+		SELECT
+			r.id,
+			r.user_id,
+			r.period_id,
+			r.category_id,
+			COALESCE(c.code, '') AS category,
+			r.currency,
+			r.original_amount,
+			r.exchange_rate,
+			r.exchange_rate_date,
+			r.requested_amount,
+			r.amount,
+			COALESCE(r.description, '') AS description,
+			r.status,
+			r.approved_amount,
+			r.reviewed_by,
+			r.reviewer_notes,
+			r.reviewed_at,
+			r.calculation,
+			r.distance_km,
+			r.travel_days,
+			r.destination,
+			r.rate_id,
+			r.unit_rate,
+			r.created_at,
+			r.updated_at
+		FROM reimbursements r
+		LEFT JOIN reimbursement_categories c ON c.id = r.category_id
+		WHERE r.id = $1;
+	`
+
+	// $1 and $2 are optional filters, an empty status matches every status and 0 every period
+	queryGetReimbursementsByStatus = `
+		SELECT
+			r.id,
+			r.user_id,
+			r.period_id,
+			r.category_id,
+			COALESCE(c.code, '') AS category,
+			r.currency,
+			r.original_amount,
+			r.exchange_rate,
+			r.exchange_rate_date,
+			r.requested_amount,
+			r.amount,
+			COALESCE(r.description, '') AS description,
+			r.status,
+			r.approved_amount,
+			r.reviewed_by,
+			r.reviewer_notes,
+			r.reviewed_at,
+			r.calculation,
+			r.distance_km,
+			r.travel_days,
+			r.destination,
+			r.rate_id,
+			r.unit_rate,
+			r.created_at,
+			r.updated_at
+		FROM reimbursements r
+		LEFT JOIN reimbursement_categories c ON c.id = r.category_id
+		WHERE ($1::text = '' OR r.status = $1)
+			AND ($2::int = 0 OR r.period_id = $2)
+		ORDER BY r.created_at, r.id;
+	`
+
+	queryUpdateReimbursementReview = `
+		UPDATE reimbursements
+		SET
+			status = $2,
+			approved_amount = $3,
+			reviewed_by = $4,
+			reviewer_notes = $5,
+			reviewed_at = $6,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	queryUpdateReimbursementClaim = `
+		UPDATE reimbursements
+		SET
+			currency = $2,
+			original_amount = $3,
+			exchange_rate = $4,
+			exchange_rate_date = $5,
+			requested_amount = $6,
+			amount = $7,
+			description = $8,
+			calculation = $9,
+			distance_km = $10,
+			travel_days = $11,
+			destination = $12,
+			rate_id = $13,
+			unit_rate = $14,
+			updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	queryDeleteReimbursement = `
+		DELETE FROM reimbursements
+		WHERE id = $1;
+	`
+
+	queryInsertAttachment = `
+		INSERT INTO reimbursement_attachments (
+			reimbursement_id,
+			file_name,
+			content_type,
+			size_bytes,
+			checksum_sha256,
+			storage_key,
+			uploaded_by
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5,
+			$6,
+			$7
+		) RETURNING id;
+	`
+
+	queryGetAttachmentByID = `
+		SELECT
+			id,
+			reimbursement_id,
+			file_name,
+			content_type,
+			size_bytes,
+			checksum_sha256,
+			storage_key,
+			uploaded_by,
+			created_at,
+			updated_at
+		FROM reimbursement_attachments
+		WHERE id = $1;
+	`
+
+	queryGetAttachmentsByReimbursementIDs = `
+		SELECT
+			id,
+			reimbursement_id,
+			file_name,
+			content_type,
+			size_bytes,
+			checksum_sha256,
+			storage_key,
+			uploaded_by,
+			created_at,
+			updated_at
+		FROM reimbursement_attachments
+		WHERE reimbursement_id = ANY($1)
+		ORDER BY reimbursement_id, id;
+	`
+
+	// the rate in effect on a day is the latest one published on or before it
+	queryGetExchangeRate = `
+		SELECT
+			id,
+			currency,
+			rate_date,
+			rate,
+			source,
+			created_by,
+			created_at,
+			updated_at
+		FROM exchange_rates
+		WHERE currency = $1 AND rate_date <= $2
+		ORDER BY rate_date DESC
+		LIMIT 1;
+	`
+
+	// $1 is optional, an empty currency lists every currency
+	queryGetExchangeRates = `
+		SELECT
+			id,
+			currency,
+			rate_date,
+			rate,
+			source,
+			created_by,
+			created_at,
+			updated_at
+		FROM exchange_rates
+		WHERE ($1::text = '' OR currency = $1)
+		ORDER BY rate_date DESC, currency
+		LIMIT 100;
+	`
+
+	queryUpsertExchangeRate = `
+		INSERT INTO exchange_rates (
+			currency,
+			rate_date,
+			rate,
+			source,
+			created_by
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5
+		)
+		ON CONFLICT (currency, rate_date) DO UPDATE
+		SET
+			rate = EXCLUDED.rate,
+			source = EXCLUDED.source,
+			created_by = EXCLUDED.created_by,
+			updated_at = NOW()
+		RETURNING id;
+	`
+
+	// a rate for the destination wins over the default rate with an empty destination,
+	// among those the latest one effective on the claim date applies
+	queryGetRate = `
+		SELECT
+			id,
+			calculation,
+			destination,
+			rate,
+			effective_from,
+			created_by,
+			created_at,
+			updated_at
+		FROM reimbursement_rates
+		WHERE calculation = $1
+			AND destination IN ($2, '')
+			AND effective_from <= $3
+		ORDER BY destination = $2 DESC, effective_from DESC
+		LIMIT 1;
+	`
+
+	// $1 is optional, an empty calculation lists the rates of every calculation
+	queryGetRates = `
+		SELECT
+			id,
+			calculation,
+			destination,
+			rate,
+			effective_from,
+			created_by,
+			created_at,
+			updated_at
+		FROM reimbursement_rates
+		WHERE ($1::text = '' OR calculation = $1)
+		ORDER BY calculation, destination, effective_from DESC;
+	`
+
+	queryUpsertRate = `
+		INSERT INTO reimbursement_rates (
+			calculation,
+			destination,
+			rate,
+			effective_from,
+			created_by
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4,
+			$5
+		)
+		ON CONFLICT (calculation, destination, effective_from) DO UPDATE
+		SET
+			rate = EXCLUDED.rate,
+			created_by = EXCLUDED.created_by,
+			updated_at = NOW()
+		RETURNING id;
+	`
+
+	// rejected claims are never counted as the original of a duplicate
+	queryGetRecentReimbursementsByUserID = `
+		SELECT
+			r.id,
+			r.user_id,
+			r.period_id,
+			r.category_id,
+			COALESCE(c.code, '') AS category,
+			r.currency,
+			r.original_amount,
+			r.exchange_rate,
+			r.exchange_rate_date,
+			r.requested_amount,
+			r.amount,
+			COALESCE(r.description, '') AS description,
+			r.status,
+			r.approved_amount,
+			r.reviewed_by,
+			r.reviewer_notes,
+			r.reviewed_at,
+			r.calculation,
+			r.distance_km,
+			r.travel_days,
+			r.destination,
+			r.rate_id,
+			r.unit_rate,
+			r.created_at,
+			r.updated_at
+		FROM reimbursements r
+		LEFT JOIN reimbursement_categories c ON c.id = r.category_id
+		WHERE r.user_id = $1
+			AND r.created_at >= $2
+			AND r.status <> 'rejected'
+		ORDER BY r.created_at DESC, r.id DESC;
+	`
+
+	// $2 is optional, period 0 lists every period
+	queryCountReimbursementsByUserID = `
+		SELECT COUNT(*)
+		FROM reimbursements
+		WHERE user_id = $1 AND ($2::int = 0 OR period_id = $2);
+	`
+
+	queryGetReimbursementsByUserID = `
+		SELECT
+			r.id,
+			r.user_id,
+			r.period_id,
+			r.category_id,
+			COALESCE(c.code, '') AS category,
+			r.currency,
+			r.original_amount,
+			r.exchange_rate,
+			r.exchange_rate_date,
+			r.requested_amount,
+			r.amount,
+			COALESCE(r.description, '') AS description,
+			r.status,
+			r.approved_amount,
+			r.reviewed_by,
+			r.reviewer_notes,
+			r.reviewed_at,
+			r.calculation,
+			r.distance_km,
+			r.travel_days,
+			r.destination,
+			r.rate_id,
+			r.unit_rate,
+			r.created_at,
+			r.updated_at
+		FROM reimbursements r
+		LEFT JOIN reimbursement_categories c ON c.id = r.category_id
+		WHERE r.user_id = $1 AND ($2::int = 0 OR r.period_id = $2)
+		ORDER BY r.created_at DESC, r.id DESC
+		LIMIT $3 OFFSET $4;
+	`
+
+	queryGetAttachmentsByChecksums = `
+		SELECT
+			a.id,
+			a.reimbursement_id,
+			a.file_name,
+			a.content_type,
+			a.size_bytes,
+			a.checksum_sha256,
+			a.storage_key,
+			a.uploaded_by,
+			a.created_at,
+			a.updated_at
+		FROM reimbursement_attachments a
+		JOIN reimbursements r ON r.id = a.reimbursement_id
+		WHERE a.checksum_sha256 = ANY($1)
+			AND r.status <> 'rejected'
+		ORDER BY a.reimbursement_id, a.id;
+	`
+
+	queryInsertDuplicateFlag = `
+		INSERT INTO reimbursement_duplicate_flags (
+			reimbursement_id,
+			duplicate_of_id,
+			reason,
+			similarity
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4
+		)
+		ON CONFLICT (reimbursement_id, duplicate_of_id, reason) DO NOTHING
+		RETURNING id;
+	`
+
+	// $1 and $2 are optional filters on the flagged claim, an empty status matches every status and 0 every period
+	queryGetDuplicateReport = `
+		SELECT
+			f.id,
+			f.reimbursement_id,
+			f.duplicate_of_id,
+			f.reason,
+			f.similarity,
+			f.created_at,
+			r.id,
+			r.user_id,
+			r.period_id,
+			COALESCE(rc.code, '') AS category,
+			r.currency,
+			r.original_amount,
+			r.amount,
+			COALESCE(r.description, '') AS description,
+			r.status,
+			r.created_at,
+			d.id,
+			d.user_id,
+			d.period_id,
+			COALESCE(dc.code, '') AS category,
+			d.currency,
+			d.original_amount,
+			d.amount,
+			COALESCE(d.description, '') AS description,
+			d.status,
+			d.created_at
+		FROM reimbursement_duplicate_flags f
+		JOIN reimbursements r ON r.id = f.reimbursement_id
+		JOIN reimbursements d ON d.id = f.duplicate_of_id
+		LEFT JOIN reimbursement_categories rc ON rc.id = r.category_id
+		LEFT JOIN reimbursement_categories dc ON dc.id = d.category_id
+		WHERE ($1::text = '' OR r.status = $1)
+			AND ($2::int = 0 OR r.period_id = $2)
+		ORDER BY f.created_at DESC, f.id DESC;
+	`
 )
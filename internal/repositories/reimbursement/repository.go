@@ -2,14 +2,37 @@ package reimbursement
 
 import (
 	"context"
+	"time"
 
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/entity/reimbursement"
 	"payslip-generation-system/internal/postgres"
 )
 
 //go:generate mockgen -source=repository.go -package=mock -destination=mock/repository_mock.go
 type ReimbursementRepositoryProvider interface {
-	InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (int, error)
+	InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error)
+	GetCategoryByCode(ctx context.Context, code string) (reimbursement.Category, error)
+	GetCategories(ctx context.Context) ([]reimbursement.Category, error)
+	UpdateCategory(ctx context.Context, category reimbursement.Category) error
+	GetCategoryUsage(ctx context.Context, userID, categoryID, periodID, year int) (reimbursement.CategoryUsage, error)
+	GetReimbursementTotalsByCategory(ctx context.Context, periodID int) ([]reimbursement.CategoryTotal, error)
+	GetReimbursementByID(ctx context.Context, id int) (reimbursement.Reimbursement, error)
+	GetAttachmentByID(ctx context.Context, id int) (reimbursement.Attachment, error)
+	GetReimbursementsByStatus(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error)
+	UpdateReimbursementReview(ctx context.Context, rmb reimbursement.Reimbursement) error
+	UpdateReimbursementClaim(ctx context.Context, rmb reimbursement.Reimbursement) error
+	DeleteReimbursement(ctx context.Context, id int) error
+	GetExchangeRate(ctx context.Context, currency string, onDate time.Time) (reimbursement.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error)
+	UpsertExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate) (int, error)
+	GetRate(ctx context.Context, calculation, destination string, onDate time.Time) (reimbursement.Rate, error)
+	GetRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error)
+	UpsertRate(ctx context.Context, rate reimbursement.Rate) (int, error)
+	GetRecentReimbursementsByUserID(ctx context.Context, userID int, since time.Time) ([]reimbursement.Reimbursement, error)
+	GetReimbursementsByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error)
+	GetAttachmentsByChecksums(ctx context.Context, checksums []string) ([]reimbursement.Attachment, error)
+	GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error)
 }
 
 type reimbursementRepository struct {
@@ -26,10 +49,178 @@ func NewReimbursementRepository(
 	}
 }
 
-func (r *reimbursementRepository) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (int, error) {
-	id, err := r.db.InsertReimbursement(ctx, rmb)
+func (r *reimbursementRepository) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error) {
+	result, err := r.db.InsertReimbursement(ctx, rmb)
+	if err != nil {
+		return reimbursement.Reimbursement{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetCategoryByCode(ctx context.Context, code string) (reimbursement.Category, error) {
+	result, err := r.db.GetCategoryByCode(ctx, code)
+	if err != nil {
+		return reimbursement.Category{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetCategories(ctx context.Context) ([]reimbursement.Category, error) {
+	result, err := r.db.GetCategories(ctx)
+	if err != nil {
+		return []reimbursement.Category{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) UpdateCategory(ctx context.Context, category reimbursement.Category) error {
+	err := r.db.UpdateCategory(ctx, category)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *reimbursementRepository) GetCategoryUsage(ctx context.Context, userID, categoryID, periodID, year int) (reimbursement.CategoryUsage, error) {
+	result, err := r.db.GetCategoryUsage(ctx, userID, categoryID, periodID, year)
+	if err != nil {
+		return reimbursement.CategoryUsage{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetReimbursementTotalsByCategory(ctx context.Context, periodID int) ([]reimbursement.CategoryTotal, error) {
+	result, err := r.db.GetReimbursementTotalsByCategory(ctx, periodID)
+	if err != nil {
+		return []reimbursement.CategoryTotal{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetReimbursementByID(ctx context.Context, id int) (reimbursement.Reimbursement, error) {
+	result, err := r.db.GetReimbursementByID(ctx, id)
+	if err != nil {
+		return reimbursement.Reimbursement{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetAttachmentByID(ctx context.Context, id int) (reimbursement.Attachment, error) {
+	result, err := r.db.GetAttachmentByID(ctx, id)
+	if err != nil {
+		return reimbursement.Attachment{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetReimbursementsByStatus(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error) {
+	result, err := r.db.GetReimbursementsByStatus(ctx, status, periodID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) UpdateReimbursementReview(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	err := r.db.UpdateReimbursementReview(ctx, rmb)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *reimbursementRepository) UpdateReimbursementClaim(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	err := r.db.UpdateReimbursementClaim(ctx, rmb)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *reimbursementRepository) DeleteReimbursement(ctx context.Context, id int) error {
+	err := r.db.DeleteReimbursement(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *reimbursementRepository) GetExchangeRate(ctx context.Context, currency string, onDate time.Time) (reimbursement.ExchangeRate, error) {
+	result, err := r.db.GetExchangeRate(ctx, currency, onDate)
+	if err != nil {
+		return reimbursement.ExchangeRate{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error) {
+	result, err := r.db.GetExchangeRates(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) UpsertExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate) (int, error) {
+	id, err := r.db.UpsertExchangeRate(ctx, rate)
 	if err != nil {
 		return 0, err
 	}
 	return id, nil
 }
+
+func (r *reimbursementRepository) GetRate(ctx context.Context, calculation, destination string, onDate time.Time) (reimbursement.Rate, error) {
+	result, err := r.db.GetRate(ctx, calculation, destination, onDate)
+	if err != nil {
+		return reimbursement.Rate{}, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error) {
+	result, err := r.db.GetRates(ctx, calculation)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) UpsertRate(ctx context.Context, rate reimbursement.Rate) (int, error) {
+	id, err := r.db.UpsertRate(ctx, rate)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *reimbursementRepository) GetRecentReimbursementsByUserID(ctx context.Context, userID int, since time.Time) ([]reimbursement.Reimbursement, error) {
+	result, err := r.db.GetRecentReimbursementsByUserID(ctx, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetReimbursementsByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error) {
+	result, total, err := r.db.GetReimbursementsByUserID(ctx, userID, periodID, page)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+func (r *reimbursementRepository) GetAttachmentsByChecksums(ctx context.Context, checksums []string) ([]reimbursement.Attachment, error) {
+	result, err := r.db.GetAttachmentsByChecksums(ctx, checksums)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *reimbursementRepository) GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error) {
+	result, err := r.db.GetDuplicateReport(ctx, status, periodID)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
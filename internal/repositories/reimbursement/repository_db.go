@@ -2,13 +2,39 @@ package reimbursement
 
 import (
 	"context"
+	"database/sql"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/entity/reimbursement"
 	"payslip-generation-system/internal/postgres"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 //go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
 type dbRepoProvider interface {
-	InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (int, error) 
+	InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error)
+	GetCategoryByCode(ctx context.Context, code string) (reimbursement.Category, error)
+	GetCategories(ctx context.Context) ([]reimbursement.Category, error)
+	UpdateCategory(ctx context.Context, category reimbursement.Category) error
+	GetCategoryUsage(ctx context.Context, userID, categoryID, periodID, year int) (reimbursement.CategoryUsage, error)
+	GetReimbursementTotalsByCategory(ctx context.Context, periodID int) ([]reimbursement.CategoryTotal, error)
+	GetReimbursementByID(ctx context.Context, id int) (reimbursement.Reimbursement, error)
+	GetAttachmentByID(ctx context.Context, id int) (reimbursement.Attachment, error)
+	GetReimbursementsByStatus(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error)
+	UpdateReimbursementReview(ctx context.Context, rmb reimbursement.Reimbursement) error
+	UpdateReimbursementClaim(ctx context.Context, rmb reimbursement.Reimbursement) error
+	DeleteReimbursement(ctx context.Context, id int) error
+	GetExchangeRate(ctx context.Context, currency string, onDate time.Time) (reimbursement.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error)
+	UpsertExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate) (int, error)
+	GetRate(ctx context.Context, calculation, destination string, onDate time.Time) (reimbursement.Rate, error)
+	GetRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error)
+	UpsertRate(ctx context.Context, rate reimbursement.Rate) (int, error)
+	GetRecentReimbursementsByUserID(ctx context.Context, userID int, since time.Time) ([]reimbursement.Reimbursement, error)
+	GetReimbursementsByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error)
+	GetAttachmentsByChecksums(ctx context.Context, checksums []string) ([]reimbursement.Attachment, error)
+	GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error)
 }
 
 type dbRepo struct {
@@ -23,18 +49,698 @@ func newDBRepo(
 	}
 }
 
-func (r *dbRepo) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (int, error) {
+// InsertReimbursement writes a claim together with its duplicate flags and receipts, all or nothing, and
+// returns it with the ids it was given
+func (r *dbRepo) InsertReimbursement(ctx context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error) {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return reimbursement.Reimbursement{}, err
+	}
+	defer tx.Rollback()
+
+	rmb.ID, err = insertReimbursement(ctx, tx, rmb)
+	if err != nil {
+		return reimbursement.Reimbursement{}, err
+	}
+	for i := range rmb.DuplicateFlags {
+		rmb.DuplicateFlags[i].ReimbursementID = rmb.ID
+		rmb.DuplicateFlags[i].ID, err = insertDuplicateFlag(ctx, tx, rmb.DuplicateFlags[i])
+		if err != nil {
+			return reimbursement.Reimbursement{}, err
+		}
+	}
+	for i := range rmb.Attachments {
+		rmb.Attachments[i].ReimbursementID = rmb.ID
+		rmb.Attachments[i].ID, err = insertAttachment(ctx, tx, rmb.Attachments[i])
+		if err != nil {
+			return reimbursement.Reimbursement{}, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return reimbursement.Reimbursement{}, err
+	}
+	return rmb, nil
+}
+
+func insertReimbursement(ctx context.Context, tx *sql.Tx, rmb reimbursement.Reimbursement) (int, error) {
+	calculation := reimbursement.Calculation{}
+	if rmb.Calculation != nil {
+		calculation = *rmb.Calculation
+	}
+
 	var id int
-	err := r.db.DB.QueryRowContext(
+	err := tx.QueryRowContext(
 		ctx,
 		queryInsertReimbursement,
 		rmb.UserID,
 		rmb.PeriodID,
+		rmb.CategoryID,
+		rmb.Currency,
+		rmb.OriginalAmount,
+		rmb.ExchangeRate,
+		rmb.ExchangeRateDate,
+		rmb.RequestedAmount,
+		rmb.Amount,
+		rmb.Description,
+		calculation.Method,
+		nullIfZero(calculation.DistanceKm),
+		nullIfZero(calculation.TravelDays),
+		calculation.Destination,
+		nullIfZero(calculation.RateID),
+		nullIfZero(calculation.UnitRate),
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetCategoryByCode(ctx context.Context, code string) (reimbursement.Category, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetCategoryByCode, code)
+
+	category, err := scanCategory(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return reimbursement.Category{}, nil
+		}
+		return reimbursement.Category{}, err
+	}
+	return category, nil
+}
+
+func (r *dbRepo) GetCategories(ctx context.Context) ([]reimbursement.Category, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []reimbursement.Category{}
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *dbRepo) UpdateCategory(ctx context.Context, category reimbursement.Category) error {
+	_, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateCategory,
+		category.ID,
+		category.Name,
+		category.PerClaimLimit,
+		category.PerPeriodLimit,
+		category.PerYearLimit,
+		pq.Array(category.EligibleGrades),
+		category.OverLimitAction,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *dbRepo) GetCategoryUsage(ctx context.Context, userID, categoryID, periodID, year int) (reimbursement.CategoryUsage, error) {
+	var usage reimbursement.CategoryUsage
+	err := r.db.DB.QueryRowContext(ctx, queryGetCategoryUsage, userID, categoryID, periodID, year).Scan(
+		&usage.PeriodTotal,
+		&usage.YearTotal,
+	)
+	if err != nil {
+		return reimbursement.CategoryUsage{}, err
+	}
+	return usage, nil
+}
+
+func (r *dbRepo) GetReimbursementTotalsByCategory(ctx context.Context, periodID int) ([]reimbursement.CategoryTotal, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetReimbursementTotalsByCategory, periodID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := []reimbursement.CategoryTotal{}
+	for rows.Next() {
+		var total reimbursement.CategoryTotal
+		var ids []int64
+		err := rows.Scan(
+			&total.UserID,
+			&total.Category,
+			&total.Name,
+			&total.Amount,
+			pq.Array(&ids),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			total.ReimbursementIDs = append(total.ReimbursementIDs, int(id))
+		}
+		totals = append(totals, total)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return totals, nil
+}
+
+// GetReimbursementByID returns a claim with its attachments
+func (r *dbRepo) GetReimbursementByID(ctx context.Context, id int) (reimbursement.Reimbursement, error) {
+	rmb, err := scanReimbursement(r.db.DB.QueryRowContext(ctx, queryGetReimbursementByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return reimbursement.Reimbursement{}, nil
+		}
+		return reimbursement.Reimbursement{}, err
+	}
+
+	reimbursements := []reimbursement.Reimbursement{rmb}
+	err = r.attachAttachments(ctx, reimbursements)
+	if err != nil {
+		return reimbursement.Reimbursement{}, err
+	}
+	return reimbursements[0], nil
+}
+
+func (r *dbRepo) GetReimbursementsByStatus(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetReimbursementsByStatus, status, periodID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reimbursements := []reimbursement.Reimbursement{}
+	for rows.Next() {
+		rmb, err := scanReimbursement(rows)
+		if err != nil {
+			return nil, err
+		}
+		reimbursements = append(reimbursements, rmb)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = r.attachAttachments(ctx, reimbursements)
+	if err != nil {
+		return nil, err
+	}
+	return reimbursements, nil
+}
+
+// attachAttachments loads the receipts of the reimbursements in one query
+func (r *dbRepo) attachAttachments(ctx context.Context, reimbursements []reimbursement.Reimbursement) error {
+	if len(reimbursements) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(reimbursements))
+	index := map[int]int{}
+	for i, rmb := range reimbursements {
+		ids = append(ids, rmb.ID)
+		index[rmb.ID] = i
+		reimbursements[i].Attachments = []reimbursement.Attachment{}
+	}
+
+	rows, err := r.db.DB.QueryContext(ctx, queryGetAttachmentsByReimbursementIDs, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return err
+		}
+		i := index[attachment.ReimbursementID]
+		reimbursements[i].Attachments = append(reimbursements[i].Attachments, attachment)
+	}
+	return rows.Err()
+}
+
+func (r *dbRepo) UpdateReimbursementReview(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	_, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateReimbursementReview,
+		rmb.ID,
+		rmb.Status,
+		rmb.ApprovedAmount,
+		rmb.ReviewedBy,
+		rmb.ReviewerNotes,
+		rmb.ReviewedAt,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateReimbursementClaim saves an amended claim, the amount, currency and calculation inputs and everything computed from them
+func (r *dbRepo) UpdateReimbursementClaim(ctx context.Context, rmb reimbursement.Reimbursement) error {
+	calculation := reimbursement.Calculation{}
+	if rmb.Calculation != nil {
+		calculation = *rmb.Calculation
+	}
+
+	_, err := r.db.DB.ExecContext(
+		ctx,
+		queryUpdateReimbursementClaim,
+		rmb.ID,
+		rmb.Currency,
+		rmb.OriginalAmount,
+		rmb.ExchangeRate,
+		rmb.ExchangeRateDate,
+		rmb.RequestedAmount,
 		rmb.Amount,
 		rmb.Description,
+		calculation.Method,
+		nullIfZero(calculation.DistanceKm),
+		nullIfZero(calculation.TravelDays),
+		calculation.Destination,
+		nullIfZero(calculation.RateID),
+		nullIfZero(calculation.UnitRate),
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteReimbursement deletes a claim, its attachment rows and duplicate flags go with it
+func (r *dbRepo) DeleteReimbursement(ctx context.Context, id int) error {
+	_, err := r.db.DB.ExecContext(ctx, queryDeleteReimbursement, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func insertAttachment(ctx context.Context, tx *sql.Tx, attachment reimbursement.Attachment) (int, error) {
+	var id int
+	err := tx.QueryRowContext(
+		ctx,
+		queryInsertAttachment,
+		attachment.ReimbursementID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.Checksum,
+		attachment.StorageKey,
+		attachment.UploadedBy,
 	).Scan(&id)
 	if err != nil {
 		return 0, err
 	}
 	return id, nil
 }
+
+func (r *dbRepo) GetAttachmentByID(ctx context.Context, id int) (reimbursement.Attachment, error) {
+	attachment, err := scanAttachment(r.db.DB.QueryRowContext(ctx, queryGetAttachmentByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return reimbursement.Attachment{}, nil
+		}
+		return reimbursement.Attachment{}, err
+	}
+	return attachment, nil
+}
+
+func (r *dbRepo) GetExchangeRate(ctx context.Context, currency string, onDate time.Time) (reimbursement.ExchangeRate, error) {
+	rate, err := scanExchangeRate(r.db.DB.QueryRowContext(ctx, queryGetExchangeRate, currency, onDate))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return reimbursement.ExchangeRate{}, nil
+		}
+		return reimbursement.ExchangeRate{}, err
+	}
+	return rate, nil
+}
+
+func (r *dbRepo) GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetExchangeRates, currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := []reimbursement.ExchangeRate{}
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (r *dbRepo) UpsertExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryUpsertExchangeRate,
+		rate.Currency,
+		rate.RateDate,
+		rate.Rate,
+		rate.Source,
+		rate.CreatedBy,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetRate(ctx context.Context, calculation, destination string, onDate time.Time) (reimbursement.Rate, error) {
+	rate, err := scanRate(r.db.DB.QueryRowContext(ctx, queryGetRate, calculation, destination, onDate))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return reimbursement.Rate{}, nil
+		}
+		return reimbursement.Rate{}, err
+	}
+	return rate, nil
+}
+
+func (r *dbRepo) GetRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetRates, calculation)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rates := []reimbursement.Rate{}
+	for rows.Next() {
+		rate, err := scanRate(rows)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (r *dbRepo) UpsertRate(ctx context.Context, rate reimbursement.Rate) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryUpsertRate,
+		rate.Calculation,
+		rate.Destination,
+		rate.Rate,
+		rate.EffectiveFrom,
+		rate.CreatedBy,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetRecentReimbursementsByUserID(ctx context.Context, userID int, since time.Time) ([]reimbursement.Reimbursement, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetRecentReimbursementsByUserID, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reimbursements := []reimbursement.Reimbursement{}
+	for rows.Next() {
+		rmb, err := scanReimbursement(rows)
+		if err != nil {
+			return nil, err
+		}
+		reimbursements = append(reimbursements, rmb)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return reimbursements, nil
+}
+
+// GetReimbursementsByUserID lists an employee's claims newest first with their attachments
+func (r *dbRepo) GetReimbursementsByUserID(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error) {
+	var total int
+	err := r.db.DB.QueryRowContext(ctx, queryCountReimbursementsByUserID, userID, periodID).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.DB.QueryContext(ctx, queryGetReimbursementsByUserID, userID, periodID, page.Limit(), page.Offset())
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	reimbursements := []reimbursement.Reimbursement{}
+	for rows.Next() {
+		rmb, err := scanReimbursement(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		reimbursements = append(reimbursements, rmb)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	err = r.attachAttachments(ctx, reimbursements)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reimbursements, total, nil
+}
+
+func (r *dbRepo) GetAttachmentsByChecksums(ctx context.Context, checksums []string) ([]reimbursement.Attachment, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetAttachmentsByChecksums, pq.Array(checksums))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := []reimbursement.Attachment{}
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// insertDuplicateFlag returns 0 when the same flag was already recorded
+func insertDuplicateFlag(ctx context.Context, tx *sql.Tx, flag reimbursement.DuplicateFlag) (int, error) {
+	var id int
+	err := tx.QueryRowContext(
+		ctx,
+		queryInsertDuplicateFlag,
+		flag.ReimbursementID,
+		flag.DuplicateOfID,
+		flag.Reason,
+		flag.Similarity,
+	).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetDuplicateReport, status, periodID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []reimbursement.DuplicateReportEntry{}
+	for rows.Next() {
+		var entry reimbursement.DuplicateReportEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ReimbursementID,
+			&entry.DuplicateOfID,
+			&entry.Reason,
+			&entry.Similarity,
+			&entry.CreatedAt,
+			&entry.Claim.ID,
+			&entry.Claim.UserID,
+			&entry.Claim.PeriodID,
+			&entry.Claim.Category,
+			&entry.Claim.Currency,
+			&entry.Claim.OriginalAmount,
+			&entry.Claim.Amount,
+			&entry.Claim.Description,
+			&entry.Claim.Status,
+			&entry.Claim.CreatedAt,
+			&entry.DuplicateOf.ID,
+			&entry.DuplicateOf.UserID,
+			&entry.DuplicateOf.PeriodID,
+			&entry.DuplicateOf.Category,
+			&entry.DuplicateOf.Currency,
+			&entry.DuplicateOf.OriginalAmount,
+			&entry.DuplicateOf.Amount,
+			&entry.DuplicateOf.Description,
+			&entry.DuplicateOf.Status,
+			&entry.DuplicateOf.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCategory(row rowScanner) (reimbursement.Category, error) {
+	var category reimbursement.Category
+	err := row.Scan(
+		&category.ID,
+		&category.Code,
+		&category.Name,
+		&category.PerClaimLimit,
+		&category.PerPeriodLimit,
+		&category.PerYearLimit,
+		pq.Array(&category.EligibleGrades),
+		&category.OverLimitAction,
+		&category.Calculation,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+	)
+	return category, err
+}
+
+func scanReimbursement(row rowScanner) (reimbursement.Reimbursement, error) {
+	var (
+		rmb         reimbursement.Reimbursement
+		calculation reimbursement.Calculation
+		distanceKm  sql.NullFloat64
+		travelDays  sql.NullInt64
+		rateID      sql.NullInt64
+		unitRate    sql.NullInt64
+	)
+	err := row.Scan(
+		&rmb.ID,
+		&rmb.UserID,
+		&rmb.PeriodID,
+		&rmb.CategoryID,
+		&rmb.Category,
+		&rmb.Currency,
+		&rmb.OriginalAmount,
+		&rmb.ExchangeRate,
+		&rmb.ExchangeRateDate,
+		&rmb.RequestedAmount,
+		&rmb.Amount,
+		&rmb.Description,
+		&rmb.Status,
+		&rmb.ApprovedAmount,
+		&rmb.ReviewedBy,
+		&rmb.ReviewerNotes,
+		&rmb.ReviewedAt,
+		&calculation.Method,
+		&distanceKm,
+		&travelDays,
+		&calculation.Destination,
+		&rateID,
+		&unitRate,
+		&rmb.CreatedAt,
+		&rmb.UpdatedAt,
+	)
+	if err != nil {
+		return rmb, err
+	}
+	if calculation.Method != "" {
+		calculation.DistanceKm = distanceKm.Float64
+		calculation.TravelDays = int(travelDays.Int64)
+		calculation.RateID = int(rateID.Int64)
+		calculation.UnitRate = int(unitRate.Int64)
+		rmb.Calculation = &calculation
+	}
+	return rmb, nil
+}
+
+func scanAttachment(row rowScanner) (reimbursement.Attachment, error) {
+	var attachment reimbursement.Attachment
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.ReimbursementID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.SizeBytes,
+		&attachment.Checksum,
+		&attachment.StorageKey,
+		&attachment.UploadedBy,
+		&attachment.CreatedAt,
+		&attachment.UpdatedAt,
+	)
+	return attachment, err
+}
+
+func scanExchangeRate(row rowScanner) (reimbursement.ExchangeRate, error) {
+	var rate reimbursement.ExchangeRate
+	err := row.Scan(
+		&rate.ID,
+		&rate.Currency,
+		&rate.RateDate,
+		&rate.Rate,
+		&rate.Source,
+		&rate.CreatedBy,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+	)
+	return rate, err
+}
+
+func scanRate(row rowScanner) (reimbursement.Rate, error) {
+	var rate reimbursement.Rate
+	err := row.Scan(
+		&rate.ID,
+		&rate.Calculation,
+		&rate.Destination,
+		&rate.Rate,
+		&rate.EffectiveFrom,
+		&rate.CreatedBy,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+	)
+	return rate, err
+}
+
+// nullIfZero stores the calculation inputs that don't apply to a claim as NULL
+func nullIfZero[T int | float64](value T) any {
+	if value == 0 {
+		return nil
+	}
+	return value
+}
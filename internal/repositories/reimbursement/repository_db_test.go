@@ -3,6 +3,7 @@ package reimbursement
 import (
 	"context"
 	"database/sql"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/entity/reimbursement"
 	"payslip-generation-system/internal/postgres"
 	"reflect"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -61,60 +63,131 @@ func Test_dbRepo_InsertReimbursement(t *testing.T) {
 
 	mockTimeNow := time.Now()
 	mockRmb := getMockReimbursement(mockTimeNow)
+	mockAttachment := getMockAttachment(mockTimeNow)
 
-	type fields struct {
-		db *postgres.Postgres
+	claim := reimbursement.Reimbursement{
+		UserID:          mockRmb.UserID,
+		PeriodID:        mockRmb.PeriodID,
+		CategoryID:      mockRmb.CategoryID,
+		Currency:        mockRmb.Currency,
+		OriginalAmount:  mockRmb.OriginalAmount,
+		ExchangeRate:    mockRmb.ExchangeRate,
+		RequestedAmount: mockRmb.RequestedAmount,
+		Amount:          mockRmb.Amount,
+		Description:     mockRmb.Description,
 	}
-	type args struct {
-		ctx context.Context
-		rmb reimbursement.Reimbursement
+	inserted := claim
+	inserted.ID = mockRmb.ID
+
+	mileage := claim
+	mileage.OriginalAmount = 30000
+	mileage.RequestedAmount = 30000
+	mileage.Amount = 30000
+	mileage.Calculation = &reimbursement.Calculation{
+		Method:      reimbursement.CalculationMileage,
+		DistanceKm:  12,
+		Destination: "Bandung",
+		RateID:      1,
+		UnitRate:    2500,
+	}
+	insertedMileage := mileage
+	insertedMileage.ID = mockRmb.ID
+
+	withReceipt := func() reimbursement.Reimbursement {
+		rmb := claim
+		rmb.DuplicateFlags = []reimbursement.DuplicateFlag{{DuplicateOfID: 9, Reason: reimbursement.DuplicateReasonSameReceipt, Similarity: 1}}
+		attachment := mockAttachment
+		attachment.ID = 0
+		attachment.ReimbursementID = 0
+		rmb.Attachments = []reimbursement.Attachment{attachment}
+		return rmb
 	}
+	insertedWithReceipt := withReceipt()
+	insertedWithReceipt.ID = mockRmb.ID
+	insertedWithReceipt.DuplicateFlags[0].ID = 3
+	insertedWithReceipt.DuplicateFlags[0].ReimbursementID = mockRmb.ID
+	insertedWithReceipt.Attachments[0].ID = mockAttachment.ID
+	insertedWithReceipt.Attachments[0].ReimbursementID = mockRmb.ID
+
 	tests := []struct {
 		name    string
-		fields  fields
 		mock    func()
-		args    args
-		want    int
+		rmb     reimbursement.Reimbursement
+		want    reimbursement.Reimbursement
 		wantErr bool
 	}{
 		{
-			name:   "Happy Path",
-			fields: fields{db: &postgres.Postgres{DB: db}},
+			name: "Happy Path",
 			mock: func() {
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(queryInsertReimbursement)).
-					WithArgs(mockRmb.UserID, mockRmb.PeriodID, mockRmb.Amount, mockRmb.Description).
+					WithArgs(mockRmb.UserID, mockRmb.PeriodID, mockRmb.CategoryID, mockRmb.Currency, mockRmb.OriginalAmount, mockRmb.ExchangeRate,
+						mockRmb.ExchangeRateDate, mockRmb.RequestedAmount, mockRmb.Amount, mockRmb.Description, "", nil, nil, "", nil, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockRmb.ID))
+				mock.ExpectCommit()
 			},
-			args: args{
-				ctx: context.Background(),
-				rmb: reimbursement.Reimbursement{
-					UserID:      mockRmb.UserID,
-					PeriodID:    mockRmb.PeriodID,
-					Amount:      mockRmb.Amount,
-					Description: mockRmb.Description,
-				},
+			rmb:  claim,
+			want: inserted,
+		},
+		{
+			name: "Happy Path - Mileage",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertReimbursement)).
+					WithArgs(mockRmb.UserID, mockRmb.PeriodID, mockRmb.CategoryID, mockRmb.Currency, float64(30000), mockRmb.ExchangeRate,
+						mockRmb.ExchangeRateDate, 30000, 30000, mockRmb.Description, reimbursement.CalculationMileage, 12.0, nil, "Bandung", 1, 2500).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockRmb.ID))
+				mock.ExpectCommit()
 			},
-			want:    1,
-			wantErr: false,
+			rmb:  mileage,
+			want: insertedMileage,
+		},
+		{
+			name: "Happy Path - With Flags And Receipts",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertReimbursement)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockRmb.ID))
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertDuplicateFlag)).
+					WithArgs(mockRmb.ID, 9, reimbursement.DuplicateReasonSameReceipt, 1.0).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertAttachment)).
+					WithArgs(mockRmb.ID, mockAttachment.FileName, mockAttachment.ContentType,
+						mockAttachment.SizeBytes, mockAttachment.Checksum, mockAttachment.StorageKey, mockAttachment.UploadedBy).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockAttachment.ID))
+				mock.ExpectCommit()
+			},
+			rmb:  withReceipt(),
+			want: insertedWithReceipt,
 		},
 		{
-			name:   "Error Insert",
-			fields: fields{db: &postgres.Postgres{DB: db}},
+			name: "Error Insert",
 			mock: func() {
+				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(queryInsertReimbursement)).
-					WithArgs(mockRmb.UserID, mockRmb.PeriodID, mockRmb.Amount, mockRmb.Description).
+					WithArgs(mockRmb.UserID, mockRmb.PeriodID, mockRmb.CategoryID, mockRmb.Currency, mockRmb.OriginalAmount, mockRmb.ExchangeRate,
+						mockRmb.ExchangeRateDate, mockRmb.RequestedAmount, mockRmb.Amount, mockRmb.Description, "", nil, nil, "", nil, nil).
 					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
 			},
-			args: args{
-				ctx: context.Background(),
-				rmb: reimbursement.Reimbursement{
-					UserID:      mockRmb.UserID,
-					PeriodID:    mockRmb.PeriodID,
-					Amount:      mockRmb.Amount,
-					Description: mockRmb.Description,
-				},
+			rmb:     claim,
+			want:    reimbursement.Reimbursement{},
+			wantErr: true,
+		},
+		{
+			name: "Error Insert Receipt - Claim Rolled Back",
+			mock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertReimbursement)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockRmb.ID))
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertDuplicateFlag)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertAttachment)).
+					WillReturnError(sql.ErrConnDone)
+				mock.ExpectRollback()
 			},
-			want:    0,
+			rmb:     withReceipt(),
+			want:    reimbursement.Reimbursement{},
 			wantErr: true,
 		},
 	}
@@ -122,9 +195,9 @@ func Test_dbRepo_InsertReimbursement(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
 			r := &dbRepo{
-				db: tt.fields.db,
+				db: &postgres.Postgres{DB: db},
 			}
-			got, err := r.InsertReimbursement(tt.args.ctx, tt.args.rmb)
+			got, err := r.InsertReimbursement(context.Background(), tt.rmb)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -142,13 +215,1388 @@ func Test_dbRepo_InsertReimbursement(t *testing.T) {
 
 
 func getMockReimbursement(mocktime time.Time) reimbursement.Reimbursement {
+	categoryID := 2
 	return reimbursement.Reimbursement{
-		ID:          1,
-		UserID:      101,
-		PeriodID:    202406,
-		Amount:      150000,
-		Description: "Biaya transport",
-		CreatedAt:   mocktime,
-		UpdatedAt:   mocktime,
-	}
-}
\ No newline at end of file
+		ID:              1,
+		UserID:          101,
+		PeriodID:        202406,
+		CategoryID:      &categoryID,
+		Category:        reimbursement.CategoryTravel,
+		Currency:        reimbursement.BaseCurrency,
+		OriginalAmount:  150000,
+		ExchangeRate:    1,
+		RequestedAmount: 150000,
+		Amount:          150000,
+		Description:     "Biaya transport",
+		Status:          reimbursement.StatusSubmitted,
+		CreatedAt:       mocktime,
+		UpdatedAt:       mocktime,
+	}
+}
+
+func Test_dbRepo_GetCategoryByCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTimeNow := time.Now()
+	mockCategory := getMockCategory(mockTimeNow)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    reimbursement.Category
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetCategoryByCode)).
+					WithArgs(mockCategory.Code).
+					WillReturnRows(getMockCategoryRows(mockCategory))
+			},
+			want:    mockCategory,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetCategoryByCode)).
+					WithArgs(mockCategory.Code).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    reimbursement.Category{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetCategoryByCode)).
+					WithArgs(mockCategory.Code).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    reimbursement.Category{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetCategoryByCode(context.Background(), mockCategory.Code)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetCategories(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockCategory := getMockCategory(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetCategories)).
+		WillReturnRows(getMockCategoryRows(mockCategory))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetCategories(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []reimbursement.Category{mockCategory}, got)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetCategories)).
+		WillReturnError(sql.ErrConnDone)
+	_, err = r.GetCategories(context.Background())
+	assert.Error(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_UpdateCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockCategory := getMockCategory(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateCategory)).
+					WithArgs(mockCategory.ID, mockCategory.Name, mockCategory.PerClaimLimit, mockCategory.PerPeriodLimit, mockCategory.PerYearLimit, pq.Array(mockCategory.EligibleGrades), mockCategory.OverLimitAction).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateCategory)).
+					WithArgs(mockCategory.ID, mockCategory.Name, mockCategory.PerClaimLimit, mockCategory.PerPeriodLimit, mockCategory.PerYearLimit, pq.Array(mockCategory.EligibleGrades), mockCategory.OverLimitAction).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateCategory(context.Background(), mockCategory)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetCategoryUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    reimbursement.CategoryUsage
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetCategoryUsage)).
+					WithArgs(101, 2, 202406, 2025).
+					WillReturnRows(sqlmock.NewRows([]string{"period_total", "year_total"}).AddRow(150000, 900000))
+			},
+			want:    reimbursement.CategoryUsage{PeriodTotal: 150000, YearTotal: 900000},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetCategoryUsage)).
+					WithArgs(101, 2, 202406, 2025).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    reimbursement.CategoryUsage{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetCategoryUsage(context.Background(), 101, 2, 202406, 2025)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetReimbursementTotalsByCategory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTotals := []reimbursement.CategoryTotal{
+		{UserID: 101, Category: "", Name: "", Amount: 50000, ReimbursementIDs: []int{3}},
+		{UserID: 101, Category: reimbursement.CategoryMeal, Name: "Meal", Amount: 100000, ReimbursementIDs: []int{4, 7}},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []reimbursement.CategoryTotal
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"user_id", "category", "name", "amount", "reimbursement_ids"})
+				rows.AddRow(101, "", "", 50000, "{3}")
+				rows.AddRow(101, reimbursement.CategoryMeal, "Meal", 100000, "{4,7}")
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementTotalsByCategory)).
+					WithArgs(202406).
+					WillReturnRows(rows)
+			},
+			want:    mockTotals,
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementTotalsByCategory)).
+					WithArgs(202406).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetReimbursementTotalsByCategory(context.Background(), 202406)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func getMockCategory(mocktime time.Time) reimbursement.Category {
+	return reimbursement.Category{
+		ID:              2,
+		Code:            reimbursement.CategoryTravel,
+		Name:            "Travel",
+		PerClaimLimit:   2000000,
+		PerPeriodLimit:  5000000,
+		EligibleGrades:  []string{"G3", "G4"},
+		OverLimitAction: reimbursement.OverLimitReject,
+		CreatedAt:       mocktime,
+		UpdatedAt:       mocktime,
+	}
+}
+
+func getMockCategoryRows(category reimbursement.Category) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "code", "name", "per_claim_limit", "per_period_limit", "per_year_limit",
+		"eligible_grades", "over_limit_action", "calculation", "created_at", "updated_at",
+	}).AddRow(
+		category.ID, category.Code, category.Name, category.PerClaimLimit, category.PerPeriodLimit, category.PerYearLimit,
+		"{G3,G4}", category.OverLimitAction, category.Calculation, category.CreatedAt, category.UpdatedAt,
+	)
+}
+func Test_dbRepo_GetReimbursementByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTimeNow := time.Now()
+	mockRmb := getMockReimbursement(mockTimeNow)
+	mockAttachment := getMockAttachment(mockTimeNow)
+	mockAttachment.ReimbursementID = mockRmb.ID
+	mockRmb.Attachments = []reimbursement.Attachment{mockAttachment}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    reimbursement.Reimbursement
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementByID)).
+					WithArgs(mockRmb.ID).
+					WillReturnRows(getMockReimbursementRows(mockRmb))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentsByReimbursementIDs)).
+					WithArgs(pq.Array([]int{mockRmb.ID})).
+					WillReturnRows(getMockAttachmentRows(mockAttachment))
+			},
+			want:    mockRmb,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementByID)).
+					WithArgs(mockRmb.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    reimbursement.Reimbursement{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementByID)).
+					WithArgs(mockRmb.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    reimbursement.Reimbursement{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetReimbursementByID(context.Background(), mockRmb.ID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_insertAttachment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockAttachment := getMockAttachment(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertAttachment)).
+					WithArgs(mockAttachment.ReimbursementID, mockAttachment.FileName, mockAttachment.ContentType,
+						mockAttachment.SizeBytes, mockAttachment.Checksum, mockAttachment.StorageKey, mockAttachment.UploadedBy).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockAttachment.ID))
+			},
+			want:    mockAttachment.ID,
+			wantErr: false,
+		},
+		{
+			name: "Error Insert",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertAttachment)).
+					WithArgs(mockAttachment.ReimbursementID, mockAttachment.FileName, mockAttachment.ContentType,
+						mockAttachment.SizeBytes, mockAttachment.Checksum, mockAttachment.StorageKey, mockAttachment.UploadedBy).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			tt.mock()
+			tx, err := db.Begin()
+			if err != nil {
+				t.Fatalf("Error beginning transaction: %s", err)
+			}
+			got, err := insertAttachment(context.Background(), tx, mockAttachment)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetAttachmentByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockAttachment := getMockAttachment(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    reimbursement.Attachment
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentByID)).
+					WithArgs(mockAttachment.ID).
+					WillReturnRows(getMockAttachmentRows(mockAttachment))
+			},
+			want:    mockAttachment,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentByID)).
+					WithArgs(mockAttachment.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    reimbursement.Attachment{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentByID)).
+					WithArgs(mockAttachment.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    reimbursement.Attachment{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetAttachmentByID(context.Background(), mockAttachment.ID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetReimbursementsByStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTimeNow := time.Now()
+	mockRmb := getMockReimbursement(mockTimeNow)
+	mockAttachment := getMockAttachment(mockTimeNow)
+	withAttachment := mockRmb
+	withAttachment.Attachments = []reimbursement.Attachment{mockAttachment}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    []reimbursement.Reimbursement
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementsByStatus)).
+					WithArgs(reimbursement.StatusSubmitted, mockRmb.PeriodID).
+					WillReturnRows(getMockReimbursementRows(mockRmb))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentsByReimbursementIDs)).
+					WithArgs(pq.Array([]int{mockRmb.ID})).
+					WillReturnRows(getMockAttachmentRows(mockAttachment))
+			},
+			want:    []reimbursement.Reimbursement{withAttachment},
+			wantErr: false,
+		},
+		{
+			name: "Empty",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementsByStatus)).
+					WithArgs(reimbursement.StatusSubmitted, mockRmb.PeriodID).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			want:    []reimbursement.Reimbursement{},
+			wantErr: false,
+		},
+		{
+			name: "Error - attachments",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementsByStatus)).
+					WithArgs(reimbursement.StatusSubmitted, mockRmb.PeriodID).
+					WillReturnRows(getMockReimbursementRows(mockRmb))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentsByReimbursementIDs)).
+					WithArgs(pq.Array([]int{mockRmb.ID})).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetReimbursementsByStatus(context.Background(), reimbursement.StatusSubmitted, mockRmb.PeriodID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateReimbursementReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTimeNow := time.Now()
+	reviewerID := 1
+	approvedAmount := 100000
+	mockRmb := getMockReimbursement(mockTimeNow)
+	mockRmb.Status = reimbursement.StatusPartiallyApproved
+	mockRmb.ApprovedAmount = &approvedAmount
+	mockRmb.ReviewedBy = &reviewerID
+	mockRmb.ReviewerNotes = "taxi receipt only covers one way"
+	mockRmb.ReviewedAt = &mockTimeNow
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateReimbursementReview)).
+					WithArgs(mockRmb.ID, mockRmb.Status, mockRmb.ApprovedAmount, mockRmb.ReviewedBy, mockRmb.ReviewerNotes, mockRmb.ReviewedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateReimbursementReview)).
+					WithArgs(mockRmb.ID, mockRmb.Status, mockRmb.ApprovedAmount, mockRmb.ReviewedBy, mockRmb.ReviewerNotes, mockRmb.ReviewedAt).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateReimbursementReview(context.Background(), mockRmb)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetExchangeRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRate := getMockExchangeRate(time.Now())
+	onDate := time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    reimbursement.ExchangeRate
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetExchangeRate)).
+					WithArgs("USD", onDate).
+					WillReturnRows(getMockExchangeRateRows(mockRate))
+			},
+			want:    mockRate,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetExchangeRate)).
+					WithArgs("USD", onDate).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    reimbursement.ExchangeRate{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetExchangeRate)).
+					WithArgs("USD", onDate).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    reimbursement.ExchangeRate{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetExchangeRate(context.Background(), "USD", onDate)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetExchangeRates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRate := getMockExchangeRate(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetExchangeRates)).
+		WithArgs("").
+		WillReturnRows(getMockExchangeRateRows(mockRate))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetExchangeRates(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, []reimbursement.ExchangeRate{mockRate}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_UpsertExchangeRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRate := getMockExchangeRate(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryUpsertExchangeRate)).
+					WithArgs(mockRate.Currency, mockRate.RateDate, mockRate.Rate, mockRate.Source, mockRate.CreatedBy).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockRate.ID))
+			},
+			want:    mockRate.ID,
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryUpsertExchangeRate)).
+					WithArgs(mockRate.Currency, mockRate.RateDate, mockRate.Rate, mockRate.Source, mockRate.CreatedBy).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.UpsertExchangeRate(context.Background(), mockRate)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetReimbursementByID_Calculated(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTimeNow := time.Now()
+	categoryID := 5
+	want := reimbursement.Reimbursement{
+		ID:              3,
+		UserID:          101,
+		PeriodID:        202407,
+		CategoryID:      &categoryID,
+		Category:        "per_diem",
+		Currency:        "IDR",
+		OriginalAmount:  900000,
+		ExchangeRate:    1,
+		RequestedAmount: 900000,
+		Amount:          900000,
+		Description:     "Client visit",
+		Status:          reimbursement.StatusSubmitted,
+		Attachments:     []reimbursement.Attachment{},
+		Calculation: &reimbursement.Calculation{
+			Method:      reimbursement.CalculationPerDiem,
+			TravelDays:  2,
+			Destination: "Jakarta",
+			RateID:      3,
+			UnitRate:    450000,
+		},
+		CreatedAt: mockTimeNow,
+		UpdatedAt: mockTimeNow,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementByID)).
+		WithArgs(want.ID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "period_id", "category_id", "category", "currency", "original_amount", "exchange_rate", "exchange_rate_date",
+			"requested_amount", "amount", "description",
+			"status", "approved_amount", "reviewed_by", "reviewer_notes", "reviewed_at",
+			"calculation", "distance_km", "travel_days", "destination", "rate_id", "unit_rate", "created_at", "updated_at",
+		}).AddRow(
+			want.ID, want.UserID, want.PeriodID, categoryID, want.Category, want.Currency, "900000.00", "1.000000", nil,
+			want.RequestedAmount, want.Amount, want.Description,
+			want.Status, nil, nil, "", nil,
+			reimbursement.CalculationPerDiem, nil, 2, "Jakarta", 3, 450000, mockTimeNow, mockTimeNow,
+		))
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentsByReimbursementIDs)).
+		WithArgs(pq.Array([]int{want.ID})).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "reimbursement_id", "file_name", "content_type", "size_bytes", "checksum_sha256",
+			"storage_key", "uploaded_by", "created_at", "updated_at",
+		}))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetReimbursementByID(context.Background(), want.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRate := getMockRate(time.Now())
+	onDate := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    reimbursement.Rate
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetRate)).
+					WithArgs(reimbursement.CalculationPerDiem, "Jakarta", onDate).
+					WillReturnRows(getMockRateRows(mockRate))
+			},
+			want:    mockRate,
+			wantErr: false,
+		},
+		{
+			name: "Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetRate)).
+					WithArgs(reimbursement.CalculationPerDiem, "Jakarta", onDate).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    reimbursement.Rate{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetRate)).
+					WithArgs(reimbursement.CalculationPerDiem, "Jakarta", onDate).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    reimbursement.Rate{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetRate(context.Background(), reimbursement.CalculationPerDiem, "Jakarta", onDate)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetRates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRate := getMockRate(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetRates)).
+		WithArgs("").
+		WillReturnRows(getMockRateRows(mockRate))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetRates(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, []reimbursement.Rate{mockRate}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_UpsertRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRate := getMockRate(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpsertRate)).
+		WithArgs(mockRate.Calculation, mockRate.Destination, mockRate.Rate, mockRate.EffectiveFrom, mockRate.CreatedBy).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockRate.ID))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.UpsertRate(context.Background(), mockRate)
+	assert.NoError(t, err)
+	assert.Equal(t, mockRate.ID, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func getMockRate(mocktime time.Time) reimbursement.Rate {
+	return reimbursement.Rate{
+		ID:            3,
+		Calculation:   reimbursement.CalculationPerDiem,
+		Destination:   "Jakarta",
+		Rate:          450000,
+		EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatedAt:     mocktime,
+		UpdatedAt:     mocktime,
+	}
+}
+
+func getMockRateRows(rate reimbursement.Rate) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "calculation", "destination", "rate", "effective_from", "created_by", "created_at", "updated_at",
+	}).AddRow(
+		rate.ID, rate.Calculation, rate.Destination, rate.Rate, rate.EffectiveFrom, rate.CreatedBy, rate.CreatedAt, rate.UpdatedAt,
+	)
+}
+
+func Test_dbRepo_GetRecentReimbursementsByUserID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRmb := getMockReimbursement(time.Now())
+	since := time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetRecentReimbursementsByUserID)).
+		WithArgs(mockRmb.UserID, since).
+		WillReturnRows(getMockReimbursementRows(mockRmb))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetRecentReimbursementsByUserID(context.Background(), mockRmb.UserID, since)
+	assert.NoError(t, err)
+	assert.Equal(t, []reimbursement.Reimbursement{mockRmb}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_dbRepo_GetAttachmentsByChecksums(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockAttachment := getMockAttachment(time.Now())
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentsByChecksums)).
+		WithArgs(pq.Array([]string{mockAttachment.Checksum})).
+		WillReturnRows(getMockAttachmentRows(mockAttachment))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetAttachmentsByChecksums(context.Background(), []string{mockAttachment.Checksum})
+	assert.NoError(t, err)
+	assert.Equal(t, []reimbursement.Attachment{mockAttachment}, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func Test_insertDuplicateFlag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	flag := reimbursement.DuplicateFlag{ReimbursementID: 2, DuplicateOfID: 1, Reason: reimbursement.DuplicateReasonSameReceipt, Similarity: 1}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertDuplicateFlag)).
+					WithArgs(flag.ReimbursementID, flag.DuplicateOfID, flag.Reason, flag.Similarity).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+			},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name: "Already Flagged",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertDuplicateFlag)).
+					WithArgs(flag.ReimbursementID, flag.DuplicateOfID, flag.Reason, flag.Similarity).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertDuplicateFlag)).
+					WithArgs(flag.ReimbursementID, flag.DuplicateOfID, flag.Reason, flag.Similarity).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			tt.mock()
+			tx, err := db.Begin()
+			if err != nil {
+				t.Fatalf("Error beginning transaction: %s", err)
+			}
+			got, err := insertDuplicateFlag(context.Background(), tx, flag)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetDuplicateReport(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockTimeNow := time.Now()
+	claim := reimbursement.ClaimSummary{
+		ID: 2, UserID: 101, PeriodID: 202407, Category: reimbursement.CategoryTravel, Currency: "IDR",
+		OriginalAmount: 150000, Amount: 150000, Description: "Biaya transport", Status: reimbursement.StatusOnHold, CreatedAt: mockTimeNow,
+	}
+	original := claim
+	original.ID = 1
+	original.PeriodID = 202406
+	original.Status = reimbursement.StatusPaid
+	want := []reimbursement.DuplicateReportEntry{
+		{
+			DuplicateFlag: reimbursement.DuplicateFlag{
+				ID: 3, ReimbursementID: 2, DuplicateOfID: 1, Reason: reimbursement.DuplicateReasonSimilarClaim, Similarity: 1, CreatedAt: mockTimeNow,
+			},
+			Claim:       claim,
+			DuplicateOf: original,
+		},
+	}
+
+	columns := []string{"id", "reimbursement_id", "duplicate_of_id", "reason", "similarity", "created_at"}
+	for _, prefix := range []string{"r", "d"} {
+		for _, column := range []string{"id", "user_id", "period_id", "category", "currency", "original_amount", "amount", "description", "status", "created_at"} {
+			columns = append(columns, prefix+"_"+column)
+		}
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(queryGetDuplicateReport)).
+		WithArgs(reimbursement.StatusOnHold, 0).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			3, 2, 1, reimbursement.DuplicateReasonSimilarClaim, "1.000", mockTimeNow,
+			claim.ID, claim.UserID, claim.PeriodID, claim.Category, claim.Currency, "150000.00", claim.Amount, claim.Description, claim.Status, claim.CreatedAt,
+			original.ID, original.UserID, original.PeriodID, original.Category, original.Currency, "150000.00", original.Amount, original.Description, original.Status, original.CreatedAt,
+		))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	got, err := r.GetDuplicateReport(context.Background(), reimbursement.StatusOnHold, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func getMockExchangeRate(mocktime time.Time) reimbursement.ExchangeRate {
+	createdBy := 1
+	return reimbursement.ExchangeRate{
+		ID:        4,
+		Currency:  "USD",
+		RateDate:  time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		Rate:      16250.5,
+		Source:    reimbursement.RateSourceManual,
+		CreatedBy: &createdBy,
+		CreatedAt: mocktime,
+		UpdatedAt: mocktime,
+	}
+}
+
+func getMockExchangeRateRows(rate reimbursement.ExchangeRate) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "currency", "rate_date", "rate", "source", "created_by", "created_at", "updated_at",
+	}).AddRow(
+		rate.ID, rate.Currency, rate.RateDate, "16250.500000", rate.Source, *rate.CreatedBy, rate.CreatedAt, rate.UpdatedAt,
+	)
+}
+
+func getMockReimbursementRows(rmb reimbursement.Reimbursement) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "user_id", "period_id", "category_id", "category", "currency", "original_amount", "exchange_rate", "exchange_rate_date",
+		"requested_amount", "amount", "description",
+		"status", "approved_amount", "reviewed_by", "reviewer_notes", "reviewed_at",
+		"calculation", "distance_km", "travel_days", "destination", "rate_id", "unit_rate", "created_at", "updated_at",
+	}).AddRow(
+		rmb.ID, rmb.UserID, rmb.PeriodID, *rmb.CategoryID, rmb.Category, rmb.Currency, "150000.00", "1.000000", nil,
+		rmb.RequestedAmount, rmb.Amount, rmb.Description,
+		rmb.Status, nil, nil, rmb.ReviewerNotes, nil,
+		"", nil, nil, "", nil, nil, rmb.CreatedAt, rmb.UpdatedAt,
+	)
+}
+
+func getMockAttachmentRows(attachment reimbursement.Attachment) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "reimbursement_id", "file_name", "content_type", "size_bytes", "checksum_sha256",
+		"storage_key", "uploaded_by", "created_at", "updated_at",
+	}).AddRow(
+		attachment.ID, attachment.ReimbursementID, attachment.FileName, attachment.ContentType,
+		attachment.SizeBytes, attachment.Checksum, attachment.StorageKey, attachment.UploadedBy,
+		attachment.CreatedAt, attachment.UpdatedAt,
+	)
+}
+
+func getMockAttachment(mocktime time.Time) reimbursement.Attachment {
+	return reimbursement.Attachment{
+		ID:              7,
+		ReimbursementID: 1,
+		FileName:        "taxi.pdf",
+		ContentType:     reimbursement.ContentTypePDF,
+		SizeBytes:       20480,
+		Checksum:        "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		StorageKey:      "reimbursements/1/1-9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.pdf",
+		UploadedBy:      101,
+		CreatedAt:       mocktime,
+		UpdatedAt:       mocktime,
+	}
+}
+
+func Test_dbRepo_GetReimbursementsByUserID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	mockRmb := getMockReimbursement(mocktimenow)
+	mockRmb.Attachments = []reimbursement.Attachment{}
+	page := pagination.NewRequest(2, 10)
+
+	tests := []struct {
+		name      string
+		mock      func()
+		want      []reimbursement.Reimbursement
+		wantTotal int
+		wantErr   bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryCountReimbursementsByUserID)).
+					WithArgs(mockRmb.UserID, mockRmb.PeriodID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetReimbursementsByUserID)).
+					WithArgs(mockRmb.UserID, mockRmb.PeriodID, 10, 10).
+					WillReturnRows(getMockReimbursementRows(mockRmb))
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetAttachmentsByReimbursementIDs)).
+					WithArgs(pq.Array([]int{mockRmb.ID})).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "reimbursement_id", "file_name", "content_type", "size_bytes", "checksum_sha256",
+						"storage_key", "uploaded_by", "created_at", "updated_at",
+					}))
+			},
+			want:      []reimbursement.Reimbursement{mockRmb},
+			wantTotal: 11,
+			wantErr:   false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryCountReimbursementsByUserID)).
+					WithArgs(mockRmb.UserID, mockRmb.PeriodID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:      nil,
+			wantTotal: 0,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, total, err := r.GetReimbursementsByUserID(context.Background(), mockRmb.UserID, mockRmb.PeriodID, page)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateReimbursementClaim(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockRmb := getMockReimbursement(time.Now())
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateReimbursementClaim)).
+					WithArgs(mockRmb.ID, mockRmb.Currency, mockRmb.OriginalAmount, mockRmb.ExchangeRate, mockRmb.ExchangeRateDate,
+						mockRmb.RequestedAmount, mockRmb.Amount, mockRmb.Description, "", nil, nil, "", nil, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error Update",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateReimbursementClaim)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateReimbursementClaim(context.Background(), mockRmb)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_DeleteReimbursement(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta(queryDeleteReimbursement)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	r := &dbRepo{
+		db: &postgres.Postgres{DB: db},
+	}
+	err = r.DeleteReimbursement(context.Background(), 1)
+	assert.NoError(t, err)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository_db.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	schedule "payslip-generation-system/internal/entity/schedule"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockdbRepoProvider is a mock of dbRepoProvider interface.
+type MockdbRepoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockdbRepoProviderMockRecorder
+}
+
+// MockdbRepoProviderMockRecorder is the mock recorder for MockdbRepoProvider.
+type MockdbRepoProviderMockRecorder struct {
+	mock *MockdbRepoProvider
+}
+
+// NewMockdbRepoProvider creates a new mock instance.
+func NewMockdbRepoProvider(ctrl *gomock.Controller) *MockdbRepoProvider {
+	mock := &MockdbRepoProvider{ctrl: ctrl}
+	mock.recorder = &MockdbRepoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdbRepoProvider) EXPECT() *MockdbRepoProviderMockRecorder {
+	return m.recorder
+}
+
+// GetEmployeeScheduleByDate mocks base method.
+func (m *MockdbRepoProvider) GetEmployeeScheduleByDate(ctx context.Context, userID int, date time.Time) (schedule.EmployeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeScheduleByDate", ctx, userID, date)
+	ret0, _ := ret[0].(schedule.EmployeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeScheduleByDate indicates an expected call of GetEmployeeScheduleByDate.
+func (mr *MockdbRepoProviderMockRecorder) GetEmployeeScheduleByDate(ctx, userID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeScheduleByDate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetEmployeeScheduleByDate), ctx, userID, date)
+}
+
+// GetEmployeeSchedulesInRange mocks base method.
+func (m *MockdbRepoProvider) GetEmployeeSchedulesInRange(ctx context.Context, startDate, endDate time.Time) ([]schedule.EmployeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeSchedulesInRange", ctx, startDate, endDate)
+	ret0, _ := ret[0].([]schedule.EmployeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeSchedulesInRange indicates an expected call of GetEmployeeSchedulesInRange.
+func (mr *MockdbRepoProviderMockRecorder) GetEmployeeSchedulesInRange(ctx, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeSchedulesInRange", reflect.TypeOf((*MockdbRepoProvider)(nil).GetEmployeeSchedulesInRange), ctx, startDate, endDate)
+}
+
+// GetPublicHolidayByDate mocks base method.
+func (m *MockdbRepoProvider) GetPublicHolidayByDate(ctx context.Context, date time.Time) (schedule.PublicHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicHolidayByDate", ctx, date)
+	ret0, _ := ret[0].(schedule.PublicHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicHolidayByDate indicates an expected call of GetPublicHolidayByDate.
+func (mr *MockdbRepoProviderMockRecorder) GetPublicHolidayByDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicHolidayByDate", reflect.TypeOf((*MockdbRepoProvider)(nil).GetPublicHolidayByDate), ctx, date)
+}
+
+// GetShiftByID mocks base method.
+func (m *MockdbRepoProvider) GetShiftByID(ctx context.Context, id int) (schedule.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShiftByID", ctx, id)
+	ret0, _ := ret[0].(schedule.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShiftByID indicates an expected call of GetShiftByID.
+func (mr *MockdbRepoProviderMockRecorder) GetShiftByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShiftByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetShiftByID), ctx, id)
+}
+
+// InsertEmployeeSchedule mocks base method.
+func (m *MockdbRepoProvider) InsertEmployeeSchedule(ctx context.Context, es schedule.EmployeeSchedule) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEmployeeSchedule", ctx, es)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertEmployeeSchedule indicates an expected call of InsertEmployeeSchedule.
+func (mr *MockdbRepoProviderMockRecorder) InsertEmployeeSchedule(ctx, es interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEmployeeSchedule", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertEmployeeSchedule), ctx, es)
+}
+
+// InsertPublicHoliday mocks base method.
+func (m *MockdbRepoProvider) InsertPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPublicHoliday", ctx, holiday)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPublicHoliday indicates an expected call of InsertPublicHoliday.
+func (mr *MockdbRepoProviderMockRecorder) InsertPublicHoliday(ctx, holiday interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPublicHoliday", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertPublicHoliday), ctx, holiday)
+}
+
+// InsertShift mocks base method.
+func (m *MockdbRepoProvider) InsertShift(ctx context.Context, shift schedule.Shift) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertShift", ctx, shift)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertShift indicates an expected call of InsertShift.
+func (mr *MockdbRepoProviderMockRecorder) InsertShift(ctx, shift interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShift", reflect.TypeOf((*MockdbRepoProvider)(nil).InsertShift), ctx, shift)
+}
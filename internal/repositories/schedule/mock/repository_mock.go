@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	schedule "payslip-generation-system/internal/entity/schedule"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockScheduleRepositoryProvider is a mock of ScheduleRepositoryProvider interface.
+type MockScheduleRepositoryProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleRepositoryProviderMockRecorder
+}
+
+// MockScheduleRepositoryProviderMockRecorder is the mock recorder for MockScheduleRepositoryProvider.
+type MockScheduleRepositoryProviderMockRecorder struct {
+	mock *MockScheduleRepositoryProvider
+}
+
+// NewMockScheduleRepositoryProvider creates a new mock instance.
+func NewMockScheduleRepositoryProvider(ctrl *gomock.Controller) *MockScheduleRepositoryProvider {
+	mock := &MockScheduleRepositoryProvider{ctrl: ctrl}
+	mock.recorder = &MockScheduleRepositoryProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleRepositoryProvider) EXPECT() *MockScheduleRepositoryProviderMockRecorder {
+	return m.recorder
+}
+
+// GetEmployeeScheduleByDate mocks base method.
+func (m *MockScheduleRepositoryProvider) GetEmployeeScheduleByDate(ctx context.Context, userID int, date time.Time) (schedule.EmployeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeScheduleByDate", ctx, userID, date)
+	ret0, _ := ret[0].(schedule.EmployeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeScheduleByDate indicates an expected call of GetEmployeeScheduleByDate.
+func (mr *MockScheduleRepositoryProviderMockRecorder) GetEmployeeScheduleByDate(ctx, userID, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeScheduleByDate", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).GetEmployeeScheduleByDate), ctx, userID, date)
+}
+
+// GetEmployeeSchedulesInRange mocks base method.
+func (m *MockScheduleRepositoryProvider) GetEmployeeSchedulesInRange(ctx context.Context, startDate, endDate time.Time) ([]schedule.EmployeeSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeSchedulesInRange", ctx, startDate, endDate)
+	ret0, _ := ret[0].([]schedule.EmployeeSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeSchedulesInRange indicates an expected call of GetEmployeeSchedulesInRange.
+func (mr *MockScheduleRepositoryProviderMockRecorder) GetEmployeeSchedulesInRange(ctx, startDate, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeSchedulesInRange", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).GetEmployeeSchedulesInRange), ctx, startDate, endDate)
+}
+
+// GetPublicHolidayByDate mocks base method.
+func (m *MockScheduleRepositoryProvider) GetPublicHolidayByDate(ctx context.Context, date time.Time) (schedule.PublicHoliday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicHolidayByDate", ctx, date)
+	ret0, _ := ret[0].(schedule.PublicHoliday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicHolidayByDate indicates an expected call of GetPublicHolidayByDate.
+func (mr *MockScheduleRepositoryProviderMockRecorder) GetPublicHolidayByDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicHolidayByDate", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).GetPublicHolidayByDate), ctx, date)
+}
+
+// GetShiftByID mocks base method.
+func (m *MockScheduleRepositoryProvider) GetShiftByID(ctx context.Context, id int) (schedule.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShiftByID", ctx, id)
+	ret0, _ := ret[0].(schedule.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShiftByID indicates an expected call of GetShiftByID.
+func (mr *MockScheduleRepositoryProviderMockRecorder) GetShiftByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShiftByID", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).GetShiftByID), ctx, id)
+}
+
+// InsertEmployeeSchedule mocks base method.
+func (m *MockScheduleRepositoryProvider) InsertEmployeeSchedule(ctx context.Context, es schedule.EmployeeSchedule) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEmployeeSchedule", ctx, es)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertEmployeeSchedule indicates an expected call of InsertEmployeeSchedule.
+func (mr *MockScheduleRepositoryProviderMockRecorder) InsertEmployeeSchedule(ctx, es interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEmployeeSchedule", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).InsertEmployeeSchedule), ctx, es)
+}
+
+// InsertPublicHoliday mocks base method.
+func (m *MockScheduleRepositoryProvider) InsertPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPublicHoliday", ctx, holiday)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPublicHoliday indicates an expected call of InsertPublicHoliday.
+func (mr *MockScheduleRepositoryProviderMockRecorder) InsertPublicHoliday(ctx, holiday interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPublicHoliday", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).InsertPublicHoliday), ctx, holiday)
+}
+
+// InsertShift mocks base method.
+func (m *MockScheduleRepositoryProvider) InsertShift(ctx context.Context, shift schedule.Shift) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertShift", ctx, shift)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertShift indicates an expected call of InsertShift.
+func (mr *MockScheduleRepositoryProviderMockRecorder) InsertShift(ctx, shift interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertShift", reflect.TypeOf((*MockScheduleRepositoryProvider)(nil).InsertShift), ctx, shift)
+}
@@ -0,0 +1,109 @@
+package schedule
+
+const (
+	queryInsertShift = `
+		INSERT INTO shifts (
+			name,
+			work_pattern,
+			pattern_start_date,
+			start_time
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4
+		) RETURNING id;
+	`
+
+	queryGetShiftByID = `
+		SELECT 
+			id,
+			name,
+			work_pattern,
+			pattern_start_date,
+			start_time,
+			created_at,
+			updated_at
+		FROM shifts
+		WHERE id = $1;
+	`
+
+	queryInsertEmployeeSchedule = `
+		INSERT INTO employee_schedules (
+			user_id,
+			shift_id,
+			effective_from,
+			effective_to
+		) VALUES (
+			$1,
+			$2,
+			$3,
+			$4
+		) RETURNING id;
+	`
+
+	queryGetEmployeeScheduleByDate = `
+		SELECT 
+			es.id,
+			es.user_id,
+			es.shift_id,
+			es.effective_from,
+			es.effective_to,
+			s.id,
+			s.name,
+			s.work_pattern,
+			s.pattern_start_date,
+			s.start_time,
+			es.created_at,
+			es.updated_at
+		FROM employee_schedules es
+		JOIN shifts s ON s.id = es.shift_id
+		WHERE es.user_id = $1
+			AND es.effective_from <= $2
+			AND (es.effective_to IS NULL OR es.effective_to >= $2)
+		ORDER BY es.effective_from DESC, es.id DESC
+		LIMIT 1;
+	`
+
+	queryGetEmployeeSchedulesInRange = `
+		SELECT 
+			es.id,
+			es.user_id,
+			es.shift_id,
+			es.effective_from,
+			es.effective_to,
+			s.id,
+			s.name,
+			s.work_pattern,
+			s.pattern_start_date,
+			s.start_time,
+			es.created_at,
+			es.updated_at
+		FROM employee_schedules es
+		JOIN shifts s ON s.id = es.shift_id
+		WHERE es.effective_from <= $2
+			AND (es.effective_to IS NULL OR es.effective_to >= $1)
+		ORDER BY es.user_id, es.effective_from DESC, es.id DESC;
+	`
+
+	queryInsertPublicHoliday = `
+		INSERT INTO public_holidays (
+			date,
+			name
+		) VALUES (
+			$1,
+			$2
+		) RETURNING id;
+	`
+
+	queryGetPublicHolidayByDate = `
+		SELECT 
+			id,
+			date,
+			name,
+			created_at,
+			updated_at
+		FROM public_holidays
+		WHERE date = $1;
+	`
+)
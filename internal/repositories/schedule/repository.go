@@ -0,0 +1,90 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"payslip-generation-system/internal/entity/schedule"
+	"payslip-generation-system/internal/postgres"
+)
+
+//go:generate mockgen -source=repository.go -package=mock -destination=mock/repository_mock.go
+type ScheduleRepositoryProvider interface {
+	InsertShift(ctx context.Context, shift schedule.Shift) (int, error)
+	GetShiftByID(ctx context.Context, id int) (schedule.Shift, error)
+	InsertEmployeeSchedule(ctx context.Context, es schedule.EmployeeSchedule) (int, error)
+	GetEmployeeScheduleByDate(ctx context.Context, userID int, date time.Time) (schedule.EmployeeSchedule, error)
+	GetEmployeeSchedulesInRange(ctx context.Context, startDate, endDate time.Time) ([]schedule.EmployeeSchedule, error)
+	InsertPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday) (int, error)
+	GetPublicHolidayByDate(ctx context.Context, date time.Time) (schedule.PublicHoliday, error)
+}
+
+type scheduleRepository struct {
+	db dbRepoProvider
+}
+
+func NewScheduleRepository(
+	db *postgres.Postgres,
+) ScheduleRepositoryProvider {
+	return &scheduleRepository{
+		db: newDBRepo(
+			db,
+		),
+	}
+}
+
+func (r *scheduleRepository) InsertShift(ctx context.Context, shift schedule.Shift) (int, error) {
+	id, err := r.db.InsertShift(ctx, shift)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *scheduleRepository) GetShiftByID(ctx context.Context, id int) (schedule.Shift, error) {
+	result, err := r.db.GetShiftByID(ctx, id)
+	if err != nil {
+		return schedule.Shift{}, err
+	}
+	return result, nil
+}
+
+func (r *scheduleRepository) InsertEmployeeSchedule(ctx context.Context, es schedule.EmployeeSchedule) (int, error) {
+	id, err := r.db.InsertEmployeeSchedule(ctx, es)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *scheduleRepository) GetEmployeeScheduleByDate(ctx context.Context, userID int, date time.Time) (schedule.EmployeeSchedule, error) {
+	result, err := r.db.GetEmployeeScheduleByDate(ctx, userID, date)
+	if err != nil {
+		return schedule.EmployeeSchedule{}, err
+	}
+	return result, nil
+}
+
+func (r *scheduleRepository) GetEmployeeSchedulesInRange(ctx context.Context, startDate, endDate time.Time) ([]schedule.EmployeeSchedule, error) {
+	result, err := r.db.GetEmployeeSchedulesInRange(ctx, startDate, endDate)
+	if err != nil {
+		return []schedule.EmployeeSchedule{}, err
+	}
+	return result, nil
+}
+
+func (r *scheduleRepository) InsertPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday) (int, error) {
+	id, err := r.db.InsertPublicHoliday(ctx, holiday)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *scheduleRepository) GetPublicHolidayByDate(ctx context.Context, date time.Time) (schedule.PublicHoliday, error) {
+	result, err := r.db.GetPublicHolidayByDate(ctx, date)
+	if err != nil {
+		return schedule.PublicHoliday{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,182 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"payslip-generation-system/internal/entity/schedule"
+	"payslip-generation-system/internal/postgres"
+	"time"
+)
+
+//go:generate mockgen -source=repository_db.go -package=mock -destination=mock/repository_db_mock.go
+type dbRepoProvider interface {
+	InsertShift(ctx context.Context, shift schedule.Shift) (int, error)
+	GetShiftByID(ctx context.Context, id int) (schedule.Shift, error)
+	InsertEmployeeSchedule(ctx context.Context, es schedule.EmployeeSchedule) (int, error)
+	GetEmployeeScheduleByDate(ctx context.Context, userID int, date time.Time) (schedule.EmployeeSchedule, error)
+	GetEmployeeSchedulesInRange(ctx context.Context, startDate, endDate time.Time) ([]schedule.EmployeeSchedule, error)
+	InsertPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday) (int, error)
+	GetPublicHolidayByDate(ctx context.Context, date time.Time) (schedule.PublicHoliday, error)
+}
+
+type dbRepo struct {
+	db *postgres.Postgres
+}
+
+func newDBRepo(
+	db *postgres.Postgres,
+) dbRepoProvider {
+	return &dbRepo{
+		db: db,
+	}
+}
+
+func (r *dbRepo) InsertShift(ctx context.Context, shift schedule.Shift) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryInsertShift,
+		shift.Name,
+		shift.WorkPattern,
+		shift.PatternStartDate,
+		shift.StartTime,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetShiftByID(ctx context.Context, id int) (schedule.Shift, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetShiftByID, id)
+
+	var s schedule.Shift
+	err := row.Scan(
+		&s.ID,
+		&s.Name,
+		&s.WorkPattern,
+		&s.PatternStartDate,
+		&s.StartTime,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schedule.Shift{}, nil
+		}
+		return schedule.Shift{}, err
+	}
+	return s, nil
+}
+
+func (r *dbRepo) InsertEmployeeSchedule(ctx context.Context, es schedule.EmployeeSchedule) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryInsertEmployeeSchedule,
+		es.UserID,
+		es.ShiftID,
+		es.EffectiveFrom,
+		es.EffectiveTo,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetEmployeeScheduleByDate(ctx context.Context, userID int, date time.Time) (schedule.EmployeeSchedule, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetEmployeeScheduleByDate, userID, date)
+
+	var es schedule.EmployeeSchedule
+	err := row.Scan(
+		&es.ID,
+		&es.UserID,
+		&es.ShiftID,
+		&es.EffectiveFrom,
+		&es.EffectiveTo,
+		&es.Shift.ID,
+		&es.Shift.Name,
+		&es.Shift.WorkPattern,
+		&es.Shift.PatternStartDate,
+		&es.Shift.StartTime,
+		&es.CreatedAt,
+		&es.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schedule.EmployeeSchedule{}, nil
+		}
+		return schedule.EmployeeSchedule{}, err
+	}
+	return es, nil
+}
+
+func (r *dbRepo) GetEmployeeSchedulesInRange(ctx context.Context, startDate, endDate time.Time) ([]schedule.EmployeeSchedule, error) {
+	rows, err := r.db.DB.QueryContext(ctx, queryGetEmployeeSchedulesInRange, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []schedule.EmployeeSchedule
+	for rows.Next() {
+		var es schedule.EmployeeSchedule
+		if err := rows.Scan(
+			&es.ID,
+			&es.UserID,
+			&es.ShiftID,
+			&es.EffectiveFrom,
+			&es.EffectiveTo,
+			&es.Shift.ID,
+			&es.Shift.Name,
+			&es.Shift.WorkPattern,
+			&es.Shift.PatternStartDate,
+			&es.Shift.StartTime,
+			&es.CreatedAt,
+			&es.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, es)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *dbRepo) InsertPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday) (int, error) {
+	var id int
+	err := r.db.DB.QueryRowContext(
+		ctx,
+		queryInsertPublicHoliday,
+		holiday.Date,
+		holiday.Name,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *dbRepo) GetPublicHolidayByDate(ctx context.Context, date time.Time) (schedule.PublicHoliday, error) {
+	row := r.db.DB.QueryRowContext(ctx, queryGetPublicHolidayByDate, date)
+
+	var h schedule.PublicHoliday
+	err := row.Scan(
+		&h.ID,
+		&h.Date,
+		&h.Name,
+		&h.CreatedAt,
+		&h.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schedule.PublicHoliday{}, nil
+		}
+		return schedule.PublicHoliday{}, err
+	}
+	return h, nil
+}
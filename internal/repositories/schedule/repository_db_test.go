@@ -0,0 +1,601 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"payslip-generation-system/internal/entity/schedule"
+	"payslip-generation-system/internal/postgres"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newDBRepo(t *testing.T) {
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	type args struct {
+		db *postgres.Postgres
+	}
+	tests := []struct {
+		name string
+		args args
+		want dbRepoProvider
+	}{
+		{
+			name: "Happy Path",
+			args: args{
+				db: &postgres.Postgres{
+					DB: db,
+				},
+			},
+			want: &dbRepo{
+				db: &postgres.Postgres{
+					DB: db,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newDBRepo(tt.args.db); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newDBRepo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_InsertShift(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockShift := getMockShift(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx   context.Context
+		shift schedule.Shift
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertShift)).
+					WithArgs(mockShift.Name, mockShift.WorkPattern, mockShift.PatternStartDate, mockShift.StartTime).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockShift.ID))
+			},
+			args:    args{ctx: context.Background(), shift: mockShift},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:   "Error Insert",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertShift)).
+					WithArgs(mockShift.Name, mockShift.WorkPattern, mockShift.PatternStartDate, mockShift.StartTime).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args:    args{ctx: context.Background(), shift: mockShift},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			got, err := r.InsertShift(tt.args.ctx, tt.args.shift)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetShiftByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockShift := getMockShift(mocktimenow)
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx context.Context
+		id  int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		want    schedule.Shift
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "name", "work_pattern", "pattern_start_date", "start_time", "created_at", "updated_at"}).
+					AddRow(mockShift.ID, mockShift.Name, mockShift.WorkPattern, mockShift.PatternStartDate, mockShift.StartTime, mockShift.CreatedAt, mockShift.UpdatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetShiftByID)).
+					WithArgs(mockShift.ID).
+					WillReturnRows(rows)
+			},
+			args:    args{ctx: context.Background(), id: mockShift.ID},
+			want:    mockShift,
+			wantErr: false,
+		},
+		{
+			name:   "Error - no rows",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetShiftByID)).
+					WithArgs(mockShift.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			args:    args{ctx: context.Background(), id: mockShift.ID},
+			want:    schedule.Shift{},
+			wantErr: false,
+		},
+		{
+			name:   "Error - database",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetShiftByID)).
+					WithArgs(mockShift.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args:    args{ctx: context.Background(), id: mockShift.ID},
+			want:    schedule.Shift{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			got, err := r.GetShiftByID(tt.args.ctx, tt.args.id)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_InsertEmployeeSchedule(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockSchedule := getMockEmployeeSchedule(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx context.Context
+		es  schedule.EmployeeSchedule
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertEmployeeSchedule)).
+					WithArgs(mockSchedule.UserID, mockSchedule.ShiftID, mockSchedule.EffectiveFrom, mockSchedule.EffectiveTo).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockSchedule.ID))
+			},
+			args:    args{ctx: context.Background(), es: mockSchedule},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:   "Error Insert",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertEmployeeSchedule)).
+					WithArgs(mockSchedule.UserID, mockSchedule.ShiftID, mockSchedule.EffectiveFrom, mockSchedule.EffectiveTo).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args:    args{ctx: context.Background(), es: mockSchedule},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			got, err := r.InsertEmployeeSchedule(tt.args.ctx, tt.args.es)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_GetEmployeeScheduleByDate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockSchedule := getMockEmployeeSchedule(mocktimenow)
+	mockDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx    context.Context
+		userID int
+		date   time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		want    schedule.EmployeeSchedule
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeScheduleByDate)).
+					WithArgs(mockSchedule.UserID, mockDate).
+					WillReturnRows(getMockEmployeeScheduleRows([]schedule.EmployeeSchedule{mockSchedule}))
+			},
+			args:    args{ctx: context.Background(), userID: mockSchedule.UserID, date: mockDate},
+			want:    mockSchedule,
+			wantErr: false,
+		},
+		{
+			name:   "Error - no rows",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeScheduleByDate)).
+					WithArgs(mockSchedule.UserID, mockDate).
+					WillReturnError(sql.ErrNoRows)
+			},
+			args:    args{ctx: context.Background(), userID: mockSchedule.UserID, date: mockDate},
+			want:    schedule.EmployeeSchedule{},
+			wantErr: false,
+		},
+		{
+			name:   "Error - database",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeScheduleByDate)).
+					WithArgs(mockSchedule.UserID, mockDate).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args:    args{ctx: context.Background(), userID: mockSchedule.UserID, date: mockDate},
+			want:    schedule.EmployeeSchedule{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			got, err := r.GetEmployeeScheduleByDate(tt.args.ctx, tt.args.userID, tt.args.date)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetEmployeeSchedulesInRange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockData := []schedule.EmployeeSchedule{getMockEmployeeSchedule(mocktimenow)}
+	mockStartDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockEndDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		db *postgres.Postgres
+	}
+	type args struct {
+		ctx       context.Context
+		startDate time.Time
+		endDate   time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		mock    func()
+		args    args
+		want    []schedule.EmployeeSchedule
+		wantErr bool
+	}{
+		{
+			name:   "Happy Path",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeSchedulesInRange)).
+					WithArgs(mockStartDate, mockEndDate).
+					WillReturnRows(getMockEmployeeScheduleRows(mockData))
+			},
+			args:    args{ctx: context.Background(), startDate: mockStartDate, endDate: mockEndDate},
+			want:    mockData,
+			wantErr: false,
+		},
+		{
+			name:   "Error - Query Failed",
+			fields: fields{db: &postgres.Postgres{DB: db}},
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetEmployeeSchedulesInRange)).
+					WithArgs(mockStartDate, mockEndDate).
+					WillReturnError(sql.ErrConnDone)
+			},
+			args:    args{ctx: context.Background(), startDate: mockStartDate, endDate: mockEndDate},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: tt.fields.db,
+			}
+			got, err := r.GetEmployeeSchedulesInRange(tt.args.ctx, tt.args.startDate, tt.args.endDate)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func getMockShift(mocktime time.Time) schedule.Shift {
+	return schedule.Shift{
+		ID:               1,
+		Name:             "Mon-Fri",
+		WorkPattern:      "1111100",
+		PatternStartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		StartTime:        "09:00:00",
+		CreatedAt:        mocktime,
+		UpdatedAt:        mocktime,
+	}
+}
+
+func getMockEmployeeSchedule(mocktime time.Time) schedule.EmployeeSchedule {
+	shift := getMockShift(mocktime)
+	shift.CreatedAt = time.Time{}
+	shift.UpdatedAt = time.Time{}
+
+	return schedule.EmployeeSchedule{
+		ID:            1,
+		UserID:        101,
+		ShiftID:       shift.ID,
+		EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Shift:         shift,
+		CreatedAt:     mocktime,
+		UpdatedAt:     mocktime,
+	}
+}
+
+func getMockEmployeeScheduleRows(data []schedule.EmployeeSchedule) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"id", "user_id", "shift_id", "effective_from", "effective_to",
+		"id", "name", "work_pattern", "pattern_start_date", "start_time",
+		"created_at", "updated_at",
+	})
+	for _, es := range data {
+		rows.AddRow(
+			es.ID, es.UserID, es.ShiftID, es.EffectiveFrom, nil,
+			es.Shift.ID, es.Shift.Name, es.Shift.WorkPattern, es.Shift.PatternStartDate, es.Shift.StartTime,
+			es.CreatedAt, es.UpdatedAt,
+		)
+	}
+	return rows
+}
+
+func Test_dbRepo_InsertPublicHoliday(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mockHoliday := schedule.PublicHoliday{
+		Date: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+		Name: "Independence Day",
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertPublicHoliday)).
+					WithArgs(mockHoliday.Date, mockHoliday.Name).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+			},
+			want:    4,
+			wantErr: false,
+		},
+		{
+			name: "Error Insert",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryInsertPublicHoliday)).
+					WithArgs(mockHoliday.Date, mockHoliday.Name).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.InsertPublicHoliday(context.Background(), mockHoliday)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dbRepo_GetPublicHolidayByDate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Error preparing mock: %s", err)
+	}
+	defer db.Close()
+
+	mocktimenow := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockHoliday := schedule.PublicHoliday{
+		ID:        4,
+		Date:      time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+		Name:      "Independence Day",
+		CreatedAt: mocktimenow,
+		UpdatedAt: mocktimenow,
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    schedule.PublicHoliday
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "date", "name", "created_at", "updated_at"}).
+					AddRow(mockHoliday.ID, mockHoliday.Date, mockHoliday.Name, mockHoliday.CreatedAt, mockHoliday.UpdatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPublicHolidayByDate)).
+					WithArgs(mockHoliday.Date).
+					WillReturnRows(rows)
+			},
+			want:    mockHoliday,
+			wantErr: false,
+		},
+		{
+			name: "Error - no rows",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPublicHolidayByDate)).
+					WithArgs(mockHoliday.Date).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    schedule.PublicHoliday{},
+			wantErr: false,
+		},
+		{
+			name: "Error - database",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetPublicHolidayByDate)).
+					WithArgs(mockHoliday.Date).
+					WillReturnError(sql.ErrConnDone)
+			},
+			want:    schedule.PublicHoliday{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetPublicHolidayByDate(context.Background(), mockHoliday.Date)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -50,6 +50,21 @@ func (mr *MockdbRepoProviderMockRecorder) GetAllEmployees(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmployees", reflect.TypeOf((*MockdbRepoProvider)(nil).GetAllEmployees), ctx)
 }
 
+// GetUserByID mocks base method.
+func (m *MockdbRepoProvider) GetUserByID(ctx context.Context, id int) (auth.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockdbRepoProviderMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockdbRepoProvider)(nil).GetUserByID), ctx, id)
+}
+
 // GetUserByUsername mocks base method.
 func (m *MockdbRepoProvider) GetUserByUsername(ctx context.Context, username string) (auth.User, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +79,31 @@ func (mr *MockdbRepoProviderMockRecorder) GetUserByUsername(ctx, username interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockdbRepoProvider)(nil).GetUserByUsername), ctx, username)
 }
+
+// UpdateUserEmail mocks base method.
+func (m *MockdbRepoProvider) UpdateUserEmail(ctx context.Context, id int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmail", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmail indicates an expected call of UpdateUserEmail.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserEmail(ctx, id, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmail", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserEmail), ctx, id, email)
+}
+
+// UpdateUserManager mocks base method.
+func (m *MockdbRepoProvider) UpdateUserManager(ctx context.Context, id int, managerID *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserManager", ctx, id, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserManager indicates an expected call of UpdateUserManager.
+func (mr *MockdbRepoProviderMockRecorder) UpdateUserManager(ctx, id, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserManager", reflect.TypeOf((*MockdbRepoProvider)(nil).UpdateUserManager), ctx, id, managerID)
+}
@@ -50,6 +50,21 @@ func (mr *MockUserRepositoryProviderMockRecorder) GetAllEmployees(ctx interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEmployees", reflect.TypeOf((*MockUserRepositoryProvider)(nil).GetAllEmployees), ctx)
 }
 
+// GetUserByID mocks base method.
+func (m *MockUserRepositoryProvider) GetUserByID(ctx context.Context, id int) (auth.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepositoryProviderMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepositoryProvider)(nil).GetUserByID), ctx, id)
+}
+
 // GetUserByUsername mocks base method.
 func (m *MockUserRepositoryProvider) GetUserByUsername(ctx context.Context, username string) (auth.User, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +79,31 @@ func (mr *MockUserRepositoryProviderMockRecorder) GetUserByUsername(ctx, usernam
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserRepositoryProvider)(nil).GetUserByUsername), ctx, username)
 }
+
+// UpdateUserEmail mocks base method.
+func (m *MockUserRepositoryProvider) UpdateUserEmail(ctx context.Context, id int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmail", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmail indicates an expected call of UpdateUserEmail.
+func (mr *MockUserRepositoryProviderMockRecorder) UpdateUserEmail(ctx, id, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmail", reflect.TypeOf((*MockUserRepositoryProvider)(nil).UpdateUserEmail), ctx, id, email)
+}
+
+// UpdateUserManager mocks base method.
+func (m *MockUserRepositoryProvider) UpdateUserManager(ctx context.Context, id int, managerID *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserManager", ctx, id, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserManager indicates an expected call of UpdateUserManager.
+func (mr *MockUserRepositoryProviderMockRecorder) UpdateUserManager(ctx, id, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserManager", reflect.TypeOf((*MockUserRepositoryProvider)(nil).UpdateUserManager), ctx, id, managerID)
+}
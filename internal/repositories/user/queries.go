@@ -21,9 +21,49 @@ const (
 			full_name,
 			salary,
 			is_admin,
+			manager_id,
+			grade,
+			legal_entity,
+			cost_center,
+			employee_number,
+			email,
+			birth_date,
 			created_at,
 			updated_at
 		FROM users
 		WHERE is_admin = false;
 	`
-)
\ No newline at end of file
+
+	queryGetUserByID = `
+		SELECT 
+			id,
+			username,
+			password_hash,
+			full_name,
+			salary,
+			is_admin,
+			manager_id,
+			grade,
+			legal_entity,
+			cost_center,
+			employee_number,
+			email,
+			birth_date,
+			created_at,
+			updated_at
+		FROM users
+		WHERE id = $1;
+	`
+
+	queryUpdateUserEmail = `
+		UPDATE users
+		SET email = $2, updated_at = NOW()
+		WHERE id = $1;
+	`
+
+	queryUpdateUserManager = `
+		UPDATE users
+		SET manager_id = $2, updated_at = NOW()
+		WHERE id = $1;
+	`
+)
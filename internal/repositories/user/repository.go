@@ -11,6 +11,9 @@ import (
 type UserRepositoryProvider interface {
 	GetUserByUsername(ctx context.Context,username string) (usermodel.User, error)
 	GetAllEmployees(ctx context.Context) ([]usermodel.User, error)
+	GetUserByID(ctx context.Context, id int) (usermodel.User, error)
+	UpdateUserEmail(ctx context.Context, id int, email string) error
+	UpdateUserManager(ctx context.Context, id int, managerID *int) error
 }
 
 type userRepository struct {
@@ -43,4 +46,28 @@ func (r *userRepository) GetAllEmployees(ctx context.Context) ([]usermodel.User,
 		return nil, err
 	}
 	return employees, nil
-}
\ No newline at end of file
+}
+
+func (r *userRepository) GetUserByID(ctx context.Context, id int) (usermodel.User, error) {
+	user, err := r.db.GetUserByID(ctx, id)
+	if err != nil {
+		return usermodel.User{}, err
+	}
+	return user, nil
+}
+
+func (r *userRepository) UpdateUserEmail(ctx context.Context, id int, email string) error {
+	err := r.db.UpdateUserEmail(ctx, id, email)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *userRepository) UpdateUserManager(ctx context.Context, id int, managerID *int) error {
+	err := r.db.UpdateUserManager(ctx, id, managerID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
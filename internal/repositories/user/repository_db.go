@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"payslip-generation-system/internal/common/errors"
 	usermodel "payslip-generation-system/internal/entity/user"
 	"payslip-generation-system/internal/postgres"
@@ -12,6 +13,9 @@ import (
 type dbRepoProvider interface {
 	GetUserByUsername(ctx context.Context, username string) (usermodel.User, error)
 	GetAllEmployees(ctx context.Context) ([]usermodel.User, error) 
+	GetUserByID(ctx context.Context, id int) (usermodel.User, error)
+	UpdateUserEmail(ctx context.Context, id int, email string) error
+	UpdateUserManager(ctx context.Context, id int, managerID *int) error
 }
 
 type dbRepo struct {
@@ -60,6 +64,13 @@ func (r *dbRepo) GetAllEmployees(ctx context.Context) ([]usermodel.User, error)
 			&u.FullName,
 			&u.Salary,
 			&u.IsAdmin,
+			&u.ManagerID,
+			&u.Grade,
+			&u.LegalEntity,
+			&u.CostCenter,
+			&u.EmployeeNumber,
+			&u.Email,
+			&u.BirthDate,
 			&u.CreatedAt,
 			&u.UpdatedAt,
 		)
@@ -75,3 +86,63 @@ func (r *dbRepo) GetAllEmployees(ctx context.Context) ([]usermodel.User, error)
 
 	return employees, nil
 }
+
+func (r *dbRepo) GetUserByID(ctx context.Context, id int) (usermodel.User, error) {
+	var u usermodel.User
+	err := r.db.DB.QueryRowContext(ctx, queryGetUserByID, id).Scan(
+		&u.ID,
+		&u.Username,
+		&u.PasswordHash,
+		&u.FullName,
+		&u.Salary,
+		&u.IsAdmin,
+		&u.ManagerID,
+		&u.Grade,
+		&u.LegalEntity,
+		&u.CostCenter,
+		&u.EmployeeNumber,
+		&u.Email,
+		&u.BirthDate,
+		&u.CreatedAt,
+		&u.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return usermodel.User{}, nil
+		}
+		return usermodel.User{}, err
+	}
+	return u, nil
+}
+
+// UpdateUserEmail sets the address payslips of the user are emailed to
+func (r *dbRepo) UpdateUserEmail(ctx context.Context, id int, email string) error {
+	result, err := r.db.DB.ExecContext(ctx, queryUpdateUserEmail, id, email)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("user not found")
+	}
+	return nil
+}
+
+// UpdateUserManager sets the manager who reviews the overtime of the user, a nil manager removes it
+func (r *dbRepo) UpdateUserManager(ctx context.Context, id int, managerID *int) error {
+	result, err := r.db.DB.ExecContext(ctx, queryUpdateUserManager, id, managerID)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return fmt.Errorf("user not found")
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -161,4 +162,225 @@ func getMockUser() usermodel.User {
 func getMockUserRows(user usermodel.User) *sqlmock.Rows {
 	return sqlmock.NewRows([]string{"id", "username", "password_hash", "is_admin"}).
 		AddRow(user.ID, user.Username, user.PasswordHash, user.IsAdmin)
-}
\ No newline at end of file
+}
+func Test_dbRepo_GetUserByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	managerID := 2
+	birthDate := time.Date(1990, 8, 15, 0, 0, 0, 0, time.UTC)
+	mockUser := usermodel.User{
+		ID:             1,
+		Username:       "testuser",
+		PasswordHash:   "$2a$10$abcdefghijklmnopqrstuv",
+		FullName:       "Test User",
+		Salary:         5000000,
+		ManagerID:      &managerID,
+		Grade:          "G3",
+		LegalEntity:    "PT Maju Jaya Logistik",
+		CostCenter:     "OPS",
+		EmployeeNumber: "EMP00001",
+		Email:          "testuser@example.com",
+		BirthDate:      &birthDate,
+		CreatedAt:      "2025-06-01T00:00:00Z",
+		UpdatedAt:      "2025-06-01T00:00:00Z",
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		want    usermodel.User
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - User Found",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "username", "password_hash", "full_name", "salary", "is_admin", "manager_id", "grade", "legal_entity", "cost_center", "employee_number", "email", "birth_date", "created_at", "updated_at"}).
+					AddRow(mockUser.ID, mockUser.Username, mockUser.PasswordHash, mockUser.FullName, mockUser.Salary, mockUser.IsAdmin, managerID, mockUser.Grade, mockUser.LegalEntity, mockUser.CostCenter, mockUser.EmployeeNumber, mockUser.Email, birthDate, mockUser.CreatedAt, mockUser.UpdatedAt)
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetUserByID)).
+					WithArgs(mockUser.ID).
+					WillReturnRows(rows)
+			},
+			want:    mockUser,
+			wantErr: false,
+		},
+		{
+			name: "User Not Found",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetUserByID)).
+					WithArgs(mockUser.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    usermodel.User{},
+			wantErr: false,
+		},
+		{
+			name: "Database Error",
+			mock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(queryGetUserByID)).
+					WithArgs(mockUser.ID).
+					WillReturnError(errors.New("connection error"))
+			},
+			want:    usermodel.User{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			got, err := r.GetUserByID(context.Background(), mockUser.ID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateUserEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserEmail)).
+					WithArgs(1, "testuser@example.com").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "User Not Found",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserEmail)).
+					WithArgs(1, "testuser@example.com").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+		{
+			name: "Database Error",
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserEmail)).
+					WithArgs(1, "testuser@example.com").
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateUserEmail(context.Background(), 1, "testuser@example.com")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func Test_dbRepo_UpdateUserManager(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	managerID := 2
+
+	tests := []struct {
+		name      string
+		managerID *int
+		mock      func()
+		wantErr   bool
+	}{
+		{
+			name:      "Happy Path",
+			managerID: &managerID,
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserManager)).
+					WithArgs(1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name:      "Happy Path - Manager Removed",
+			managerID: nil,
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserManager)).
+					WithArgs(1, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name:      "User Not Found",
+			managerID: &managerID,
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserManager)).
+					WithArgs(1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "Database Error",
+			managerID: &managerID,
+			mock: func() {
+				mock.ExpectExec(regexp.QuoteMeta(queryUpdateUserManager)).
+					WithArgs(1, 2).
+					WillReturnError(errors.New("connection error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			r := &dbRepo{
+				db: &postgres.Postgres{DB: db},
+			}
+			err := r.UpdateUserManager(context.Background(), 1, tt.managerID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package admin
+
+import (
+    "bufio"
+    "encoding/csv"
+    "fmt"
+    "io"
+    "payslip-generation-system/internal/entity/attendance"
+    "strings"
+    "time"
+)
+
+// importRow is one attendance day read from an import file, before it is validated against the period
+type importRow struct {
+    row      int
+    user     string
+    date     time.Time
+    clockIn  *time.Time
+    clockOut *time.Time
+}
+
+// parseAttendanceCSV reads a CSV with a header row containing user and date columns,
+// clock_in and clock_out are optional and given as HH:MM or HH:MM:SS in the shift's location.
+// A clock_out that is not after clock_in is taken to be on the next day (overnight shift).
+func parseAttendanceCSV(r io.Reader, loc *time.Location) ([]importRow, []attendance.ImportRowError, error) {
+    reader := csv.NewReader(r)
+    reader.FieldsPerRecord = -1
+    reader.TrimLeadingSpace = true
+
+    header, err := reader.Read()
+    if err == io.EOF {
+        return nil, nil, fmt.Errorf("file is empty")
+    }
+    if err != nil {
+        return nil, nil, fmt.Errorf("invalid csv header: %w", err)
+    }
+    columns := map[string]int{}
+    for i, name := range header {
+        columns[strings.ToLower(strings.TrimSpace(name))] = i
+    }
+    for _, required := range []string{"user", "date"} {
+        if _, ok := columns[required]; !ok {
+            return nil, nil, fmt.Errorf("csv header must contain a %s column", required)
+        }
+    }
+
+    field := func(record []string, name string) string {
+        i, ok := columns[name]
+        if !ok || i >= len(record) {
+            return ""
+        }
+        return strings.TrimSpace(record[i])
+    }
+
+    rows := []importRow{}
+    rowErrors := []attendance.ImportRowError{}
+    for {
+        record, err := reader.Read()
+        if err == io.EOF {
+            break
+        }
+        if err != nil {
+            // a record that fails to parse has no fields to take the position from, the parse error carries the line
+            parseErr, ok := err.(*csv.ParseError)
+            if !ok {
+                return nil, nil, fmt.Errorf("failed to read csv: %w", err)
+            }
+            rowErrors = append(rowErrors, attendance.ImportRowError{Row: parseErr.Line, Error: parseErr.Err.Error()})
+            continue
+        }
+        line, _ := reader.FieldPos(0)
+
+        user := field(record, "user")
+        dateStr := field(record, "date")
+        if user == "" && dateStr == "" {
+            continue
+        }
+        rowErr := attendance.ImportRowError{Row: line, User: user, Date: dateStr}
+
+        date, err := time.Parse("2006-01-02", dateStr)
+        if err != nil {
+            rowErr.Error = "date must be in YYYY-MM-DD format"
+            rowErrors = append(rowErrors, rowErr)
+            continue
+        }
+        row := importRow{row: line, user: user, date: date}
+
+        if clockIn := field(record, "clock_in"); clockIn != "" {
+            row.clockIn, err = parseClockTime(date, clockIn, loc)
+            if err != nil {
+                rowErr.Error = "clock_in must be in HH:MM or HH:MM:SS format"
+                rowErrors = append(rowErrors, rowErr)
+                continue
+            }
+        }
+        if clockOut := field(record, "clock_out"); clockOut != "" {
+            if row.clockIn == nil {
+                rowErr.Error = "clock_out requires clock_in"
+                rowErrors = append(rowErrors, rowErr)
+                continue
+            }
+            row.clockOut, err = parseClockTime(date, clockOut, loc)
+            if err != nil {
+                rowErr.Error = "clock_out must be in HH:MM or HH:MM:SS format"
+                rowErrors = append(rowErrors, rowErr)
+                continue
+            }
+            if !row.clockOut.After(*row.clockIn) {
+                nextDay := row.clockOut.AddDate(0, 0, 1)
+                row.clockOut = &nextDay
+            }
+        }
+        rows = append(rows, row)
+    }
+    return rows, rowErrors, nil
+}
+
+func parseClockTime(date time.Time, value string, loc *time.Location) (*time.Time, error) {
+    layout := "15:04"
+    if strings.Count(value, ":") == 2 {
+        layout = "15:04:05"
+    }
+    clock, err := time.Parse(layout, value)
+    if err != nil {
+        return nil, err
+    }
+    t := time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+    return &t, nil
+}
+
+// parseAttendanceAttlog reads a fingerprint terminal attlog export (ZKTeco style), one punch per line:
+// user PIN, punch date and time, then device specific columns which are ignored.
+// Punches are grouped per user and day, the first punch is the clock-in and the last one the clock-out.
+func parseAttendanceAttlog(r io.Reader, loc *time.Location) ([]importRow, []attendance.ImportRowError, error) {
+    scanner := bufio.NewScanner(r)
+
+    rows := []importRow{}
+    rowErrors := []attendance.ImportRowError{}
+    rowIndex := map[string]int{}
+    line := 0
+    for scanner.Scan() {
+        line++
+        fields := strings.Fields(scanner.Text())
+        if len(fields) == 0 {
+            continue
+        }
+        if len(fields) < 3 {
+            rowErrors = append(rowErrors, attendance.ImportRowError{Row: line, User: fields[0], Error: "line must contain user, date and time"})
+            continue
+        }
+
+        user := fields[0]
+        punch, err := time.ParseInLocation("2006-01-02 15:04:05", fields[1]+" "+fields[2], loc)
+        if err != nil {
+            rowErrors = append(rowErrors, attendance.ImportRowError{Row: line, User: user, Date: fields[1], Error: "punch must be in YYYY-MM-DD HH:MM:SS format"})
+            continue
+        }
+        date := time.Date(punch.Year(), punch.Month(), punch.Day(), 0, 0, 0, 0, time.UTC)
+
+        key := user + "|" + fields[1]
+        i, ok := rowIndex[key]
+        if !ok {
+            rowIndex[key] = len(rows)
+            rows = append(rows, importRow{row: line, user: user, date: date, clockIn: &punch})
+            continue
+        }
+
+        row := &rows[i]
+        switch {
+        case punch.Before(*row.clockIn):
+            if row.clockOut == nil {
+                row.clockOut = row.clockIn
+            }
+            row.clockIn = &punch
+        case punch.After(*row.clockIn) && (row.clockOut == nil || punch.After(*row.clockOut)):
+            row.clockOut = &punch
+        }
+    }
+    if err := scanner.Err(); err != nil {
+        return nil, nil, fmt.Errorf("failed to read attlog: %w", err)
+    }
+    return rows, rowErrors, nil
+}
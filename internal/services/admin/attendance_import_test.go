@@ -0,0 +1,108 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseAttendanceCSV(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name          string
+		input         string
+		wantRows      int
+		wantErrorRows []int
+		wantErr       bool
+	}{
+		{
+			name:     "Happy Path - Date Only And Clock Times",
+			input:    "user,date,clock_in,clock_out\nalice,2025-06-02,,\n11,2025-06-03,08:05,17:00\n",
+			wantRows: 2,
+		},
+		{
+			name:          "Invalid Rows Are Reported",
+			input:         "user,date,clock_in,clock_out\nalice,02/06/2025,,\nbob,2025-06-03,8am,\ncarol,2025-06-03,,17:00\ndave,2025-06-04,09:00,18:00\n",
+			wantRows:      1,
+			wantErrorRows: []int{2, 3, 4},
+		},
+		{
+			name:          "Malformed Row Is Reported",
+			input:         "user,date,clock_in,clock_out\nalice,2025-06-02,,\nb\"ob,2025-06-03,,\ncarol,2025-06-04,,\n",
+			wantRows:      2,
+			wantErrorRows: []int{3},
+		},
+		{
+			name:    "Error - Missing Date Column",
+			input:   "user,clock_in\nalice,08:00\n",
+			wantErr: true,
+		},
+		{
+			name:    "Error - Empty File",
+			input:   "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrors, err := parseAttendanceCSV(strings.NewReader(tt.input), loc)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, rows, tt.wantRows)
+			gotErrorRows := []int{}
+			for _, rowErr := range rowErrors {
+				gotErrorRows = append(gotErrorRows, rowErr.Row)
+			}
+			if tt.wantErrorRows == nil {
+				tt.wantErrorRows = []int{}
+			}
+			assert.Equal(t, tt.wantErrorRows, gotErrorRows)
+		})
+	}
+}
+
+func Test_parseAttendanceCSV_OvernightShift(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+
+	rows, rowErrors, err := parseAttendanceCSV(strings.NewReader("user,date,clock_in,clock_out\nalice,2025-06-02,22:00,06:00\n"), loc)
+	assert.NoError(t, err)
+	assert.Empty(t, rowErrors)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, time.Date(2025, 6, 2, 22, 0, 0, 0, loc), *rows[0].clockIn)
+	assert.Equal(t, time.Date(2025, 6, 3, 6, 0, 0, 0, loc), *rows[0].clockOut)
+}
+
+func Test_parseAttendanceAttlog(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+	input := strings.Join([]string{
+		"     11\t2025-06-02 17:02:11\t1\t1\t1\t0",
+		"     11\t2025-06-02 08:01:45\t1\t0\t1\t0",
+		"     11\t2025-06-02 12:00:03\t1\t2\t1\t0",
+		"     12\t2025-06-02 08:30:00\t1\t0\t1\t0",
+		"",
+		"     13\t2025-06-02",
+		"     13\t02-06-2025 08:00:00\t1\t0\t1\t0",
+	}, "\n")
+
+	rows, rowErrors, err := parseAttendanceAttlog(strings.NewReader(input), loc)
+	assert.NoError(t, err)
+
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "11", rows[0].user)
+	assert.Equal(t, 1, rows[0].row)
+	assert.Equal(t, time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), rows[0].date)
+	assert.Equal(t, time.Date(2025, 6, 2, 8, 1, 45, 0, loc), *rows[0].clockIn)
+	assert.Equal(t, time.Date(2025, 6, 2, 17, 2, 11, 0, loc), *rows[0].clockOut)
+	assert.Equal(t, "12", rows[1].user)
+	assert.Nil(t, rows[1].clockOut)
+
+	assert.Len(t, rowErrors, 2)
+	assert.Equal(t, 6, rowErrors[0].Row)
+	assert.Equal(t, 7, rowErrors[1].Row)
+}
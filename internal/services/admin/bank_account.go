@@ -0,0 +1,146 @@
+package admin
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "payslip-generation-system/internal/entity/audit"
+    "payslip-generation-system/internal/entity/bankaccount"
+    "strings"
+    "time"
+)
+
+// AddBankAccount enters a bank account for an employee, e.g. from a signed form. Like an account an employee
+// submits it is pending until another admin verifies it
+func (s *adminService) AddBankAccount(ctx context.Context, account bankaccount.BankAccount, userID, requestID int) (bankaccount.BankAccount, error) {
+    employee, err := s.userepo.GetUserByID(ctx, account.UserID)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    if employee.ID == 0 || employee.IsAdmin {
+        return bankaccount.BankAccount{}, fmt.Errorf("employee not found")
+    }
+
+    account = bankaccount.Normalize(account)
+    err = bankaccount.Validate(account)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    if account.EffectiveDate.Before(s.today()) {
+        return bankaccount.BankAccount{}, fmt.Errorf("effective_date can't be in the past")
+    }
+
+    pending, err := s.bankrepo.GetBankAccounts(ctx, account.UserID, bankaccount.StatusPending)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    for _, p := range pending {
+        if p.BankCode == account.BankCode && p.AccountNumber == account.AccountNumber {
+            return bankaccount.BankAccount{}, fmt.Errorf("this account is already waiting for verification")
+        }
+    }
+
+    account.Status = bankaccount.StatusPending
+    account.RequestedBy = userID
+    account.ID, err = s.bankrepo.InsertBankAccount(ctx, account)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+
+    newJson, err := json.Marshal(account)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    log := audit.AuditLog{
+        TableName: "employee_bank_accounts",
+        RecordID:  account.ID,
+        Action:    "CREATE",
+        OldData:   []byte("{}"),
+        NewData:   newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    return account, nil
+}
+
+// GetBankAccounts lists the bank accounts of an employee, or of everyone when userID is 0, optionally of a status
+func (s *adminService) GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error) {
+    if status != "" && !bankaccount.IsValidStatus(status) {
+        return nil, fmt.Errorf("unknown status %s", status)
+    }
+    return s.bankrepo.GetBankAccounts(ctx, userID, status)
+}
+
+// ReviewBankAccount verifies or rejects a pending bank account. Changing where salary is paid is the easiest
+// way to steal it, so the admin who entered an account can't verify it and a rejection needs a note
+func (s *adminService) ReviewBankAccount(ctx context.Context, accountID int, status, note string, userID, requestID int) (bankaccount.BankAccount, error) {
+    if status != bankaccount.StatusVerified && status != bankaccount.StatusRejected {
+        return bankaccount.BankAccount{}, fmt.Errorf("status must be %s or %s", bankaccount.StatusVerified, bankaccount.StatusRejected)
+    }
+    if status == bankaccount.StatusRejected && strings.TrimSpace(note) == "" {
+        return bankaccount.BankAccount{}, fmt.Errorf("note is required to reject an account")
+    }
+
+    account, err := s.bankrepo.GetBankAccountByID(ctx, accountID)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    if account.ID == 0 {
+        return bankaccount.BankAccount{}, fmt.Errorf("bank account not found")
+    }
+    if account.Status != bankaccount.StatusPending {
+        return bankaccount.BankAccount{}, fmt.Errorf("bank account has already been %s", account.Status)
+    }
+    if account.RequestedBy == userID || account.UserID == userID {
+        return bankaccount.BankAccount{}, fmt.Errorf("bank account has to be reviewed by another admin")
+    }
+
+    reviewedAt := time.Now()
+    reviewedAccount := account
+    reviewedAccount.Status = status
+    reviewedAccount.VerifiedBy = &userID
+    reviewedAccount.VerifiedAt = &reviewedAt
+    reviewedAccount.ReviewNote = note
+
+    err = s.bankrepo.UpdateBankAccountReview(ctx, reviewedAccount)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+
+    oldJson, err := json.Marshal(account)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    newJson, err := json.Marshal(reviewedAccount)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    log := audit.AuditLog{
+        TableName: "employee_bank_accounts",
+        RecordID:  account.ID,
+        Action:    "UPDATE",
+        OldData:   oldJson,
+        NewData:   newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    return reviewedAccount, nil
+}
+
+// today is the current date in the clock policy's time zone, kept as a UTC date like the DATE columns
+func (s *adminService) today() time.Time {
+    now := time.Now()
+    if s.clockPolicy.Location != nil {
+        now = now.In(s.clockPolicy.Location)
+    }
+    return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
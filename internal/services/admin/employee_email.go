@@ -0,0 +1,72 @@
+package admin
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "net/mail"
+    "payslip-generation-system/internal/entity/audit"
+    usermodel "payslip-generation-system/internal/entity/user"
+    "strings"
+)
+
+// employeeEmail is what the audit log keeps of an email change, the rest of the user isn't logged
+type employeeEmail struct {
+    ID    int    `json:"id"`
+    Email string `json:"email"`
+}
+
+// UpdateEmployeeEmail sets the address the payslips of an employee are emailed to, an empty email removes it.
+// Payslips already queued keep the address they were queued with, a resend takes the new one
+func (s *adminService) UpdateEmployeeEmail(ctx context.Context, employeeID int, email string, userID, requestID int) (usermodel.User, error) {
+    employee, err := s.userepo.GetUserByID(ctx, employeeID)
+    if err != nil {
+        return usermodel.User{}, err
+    }
+    if employee.ID == 0 || employee.IsAdmin {
+        return usermodel.User{}, fmt.Errorf("employee not found")
+    }
+
+    email = strings.TrimSpace(email)
+    if email != "" {
+        address, err := mail.ParseAddress(email)
+        if err != nil || address.Address != email {
+            return usermodel.User{}, fmt.Errorf("invalid email address")
+        }
+    }
+    if email == employee.Email {
+        return usermodel.User{}, fmt.Errorf("employee already has this email address")
+    }
+
+    err = s.userepo.UpdateUserEmail(ctx, employee.ID, email)
+    if err != nil {
+        return usermodel.User{}, err
+    }
+
+    oldJson, err := json.Marshal(employeeEmail{ID: employee.ID, Email: employee.Email})
+    if err != nil {
+        return usermodel.User{}, err
+    }
+    newJson, err := json.Marshal(employeeEmail{ID: employee.ID, Email: email})
+    if err != nil {
+        return usermodel.User{}, err
+    }
+    log := audit.AuditLog{
+        TableName: "users",
+        RecordID:  employee.ID,
+        Action:    "UPDATE",
+        OldData:   oldJson,
+        NewData:   newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return usermodel.User{}, err
+    }
+
+    employee.Email = email
+    employee.PasswordHash = ""
+    return employee, nil
+}
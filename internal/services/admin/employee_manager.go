@@ -0,0 +1,92 @@
+package admin
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "fmt"
+    "payslip-generation-system/internal/entity/audit"
+    usermodel "payslip-generation-system/internal/entity/user"
+)
+
+// employeeManager is what the audit log keeps of a manager change, the rest of the user isn't logged
+type employeeManager struct {
+    ID        int  `json:"id"`
+    ManagerID *int `json:"manager_id"`
+}
+
+// UpdateEmployeeManager sets the manager who reviews the overtime of an employee, a nil manager removes it.
+// The manager must be another employee, and an employee can't end up managing anyone above them
+func (s *adminService) UpdateEmployeeManager(ctx context.Context, employeeID int, managerID *int, userID, requestID int) (usermodel.User, error) {
+    employee, err := s.userepo.GetUserByID(ctx, employeeID)
+    if err != nil {
+        return usermodel.User{}, err
+    }
+    if employee.ID == 0 || employee.IsAdmin {
+        return usermodel.User{}, fmt.Errorf("employee not found")
+    }
+    if managerID == nil && employee.ManagerID == nil || managerID != nil && employee.ManagerID != nil && *managerID == *employee.ManagerID {
+        return usermodel.User{}, fmt.Errorf("employee already has this manager")
+    }
+
+    if managerID != nil {
+        if *managerID == employee.ID {
+            return usermodel.User{}, fmt.Errorf("an employee can't be their own manager")
+        }
+        manager, err := s.userepo.GetUserByID(ctx, *managerID)
+        if err != nil {
+            return usermodel.User{}, err
+        }
+        if manager.ID == 0 || manager.IsAdmin {
+            return usermodel.User{}, fmt.Errorf("manager not found")
+        }
+
+        // walk up from the new manager, reaching the employee means they would end up managing themselves
+        seen := map[int]bool{manager.ID: true}
+        for next := manager.ManagerID; next != nil; {
+            if *next == employee.ID {
+                return usermodel.User{}, fmt.Errorf("%s already reports to %s, assigning this manager would make a cycle", manager.Username, employee.Username)
+            }
+            if seen[*next] {
+                break
+            }
+            seen[*next] = true
+            above, err := s.userepo.GetUserByID(ctx, *next)
+            if err != nil {
+                return usermodel.User{}, err
+            }
+            next = above.ManagerID
+        }
+    }
+
+    err = s.userepo.UpdateUserManager(ctx, employee.ID, managerID)
+    if err != nil {
+        return usermodel.User{}, err
+    }
+
+    oldJson, err := json.Marshal(employeeManager{ID: employee.ID, ManagerID: employee.ManagerID})
+    if err != nil {
+        return usermodel.User{}, err
+    }
+    newJson, err := json.Marshal(employeeManager{ID: employee.ID, ManagerID: managerID})
+    if err != nil {
+        return usermodel.User{}, err
+    }
+    log := audit.AuditLog{
+        TableName: "users",
+        RecordID:  employee.ID,
+        Action:    "UPDATE",
+        OldData:   oldJson,
+        NewData:   newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return usermodel.User{}, err
+    }
+
+    employee.ManagerID = managerID
+    employee.PasswordHash = ""
+    return employee, nil
+}
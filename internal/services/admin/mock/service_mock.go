@@ -6,8 +6,14 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	attendance "payslip-generation-system/internal/entity/attendance"
+	bankaccount "payslip-generation-system/internal/entity/bankaccount"
+	overtime "payslip-generation-system/internal/entity/overtime"
 	payslip "payslip-generation-system/internal/entity/payslip"
+	reimbursement "payslip-generation-system/internal/entity/reimbursement"
+	schedule "payslip-generation-system/internal/entity/schedule"
+	auth "payslip-generation-system/internal/entity/user"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -36,6 +42,36 @@ func (m *MockAdminServiceProvider) EXPECT() *MockAdminServiceProviderMockRecorde
 	return m.recorder
 }
 
+// AddBankAccount mocks base method.
+func (m *MockAdminServiceProvider) AddBankAccount(ctx context.Context, account bankaccount.BankAccount, userID, requestID int) (bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBankAccount", ctx, account, userID, requestID)
+	ret0, _ := ret[0].(bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBankAccount indicates an expected call of AddBankAccount.
+func (mr *MockAdminServiceProviderMockRecorder) AddBankAccount(ctx, account, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBankAccount", reflect.TypeOf((*MockAdminServiceProvider)(nil).AddBankAccount), ctx, account, userID, requestID)
+}
+
+// AddExchangeRate mocks base method.
+func (m *MockAdminServiceProvider) AddExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate, userID, requestID int) (reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExchangeRate", ctx, rate, userID, requestID)
+	ret0, _ := ret[0].(reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExchangeRate indicates an expected call of AddExchangeRate.
+func (mr *MockAdminServiceProviderMockRecorder) AddExchangeRate(ctx, rate, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExchangeRate", reflect.TypeOf((*MockAdminServiceProvider)(nil).AddExchangeRate), ctx, rate, userID, requestID)
+}
+
 // AddPeriod mocks base method.
 func (m *MockAdminServiceProvider) AddPeriod(ctx context.Context, attendancePeriod attendance.AttendancePeriod, userID, requestID int) (int, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +87,156 @@ func (mr *MockAdminServiceProviderMockRecorder) AddPeriod(ctx, attendancePeriod,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeriod", reflect.TypeOf((*MockAdminServiceProvider)(nil).AddPeriod), ctx, attendancePeriod, userID, requestID)
 }
 
+// AddPublicHoliday mocks base method.
+func (m *MockAdminServiceProvider) AddPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday, userID, requestID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPublicHoliday", ctx, holiday, userID, requestID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPublicHoliday indicates an expected call of AddPublicHoliday.
+func (mr *MockAdminServiceProviderMockRecorder) AddPublicHoliday(ctx, holiday, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPublicHoliday", reflect.TypeOf((*MockAdminServiceProvider)(nil).AddPublicHoliday), ctx, holiday, userID, requestID)
+}
+
+// AddReimbursementRate mocks base method.
+func (m *MockAdminServiceProvider) AddReimbursementRate(ctx context.Context, rate reimbursement.Rate, userID, requestID int) (reimbursement.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReimbursementRate", ctx, rate, userID, requestID)
+	ret0, _ := ret[0].(reimbursement.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReimbursementRate indicates an expected call of AddReimbursementRate.
+func (mr *MockAdminServiceProviderMockRecorder) AddReimbursementRate(ctx, rate, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReimbursementRate", reflect.TypeOf((*MockAdminServiceProvider)(nil).AddReimbursementRate), ctx, rate, userID, requestID)
+}
+
+// AddShift mocks base method.
+func (m *MockAdminServiceProvider) AddShift(ctx context.Context, shift schedule.Shift, userID, requestID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddShift", ctx, shift, userID, requestID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddShift indicates an expected call of AddShift.
+func (mr *MockAdminServiceProviderMockRecorder) AddShift(ctx, shift, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddShift", reflect.TypeOf((*MockAdminServiceProvider)(nil).AddShift), ctx, shift, userID, requestID)
+}
+
+// AssignSchedule mocks base method.
+func (m *MockAdminServiceProvider) AssignSchedule(ctx context.Context, employeeSchedule schedule.EmployeeSchedule, userID, requestID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignSchedule", ctx, employeeSchedule, userID, requestID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignSchedule indicates an expected call of AssignSchedule.
+func (mr *MockAdminServiceProviderMockRecorder) AssignSchedule(ctx, employeeSchedule, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignSchedule", reflect.TypeOf((*MockAdminServiceProvider)(nil).AssignSchedule), ctx, employeeSchedule, userID, requestID)
+}
+
+// FetchExchangeRate mocks base method.
+func (m *MockAdminServiceProvider) FetchExchangeRate(ctx context.Context, currency string, userID, requestID int) (reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchExchangeRate", ctx, currency, userID, requestID)
+	ret0, _ := ret[0].(reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchExchangeRate indicates an expected call of FetchExchangeRate.
+func (mr *MockAdminServiceProviderMockRecorder) FetchExchangeRate(ctx, currency, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchExchangeRate", reflect.TypeOf((*MockAdminServiceProvider)(nil).FetchExchangeRate), ctx, currency, userID, requestID)
+}
+
+// GetAttendanceCorrections mocks base method.
+func (m *MockAdminServiceProvider) GetAttendanceCorrections(ctx context.Context, status string) ([]attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceCorrections", ctx, status)
+	ret0, _ := ret[0].([]attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceCorrections indicates an expected call of GetAttendanceCorrections.
+func (mr *MockAdminServiceProviderMockRecorder) GetAttendanceCorrections(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceCorrections", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetAttendanceCorrections), ctx, status)
+}
+
+// GetBankAccounts mocks base method.
+func (m *MockAdminServiceProvider) GetBankAccounts(ctx context.Context, userID int, status string) ([]bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccounts", ctx, userID, status)
+	ret0, _ := ret[0].([]bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccounts indicates an expected call of GetBankAccounts.
+func (mr *MockAdminServiceProviderMockRecorder) GetBankAccounts(ctx, userID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccounts", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetBankAccounts), ctx, userID, status)
+}
+
+// GetDuplicateReport mocks base method.
+func (m *MockAdminServiceProvider) GetDuplicateReport(ctx context.Context, status string, periodID int) ([]reimbursement.DuplicateReportEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDuplicateReport", ctx, status, periodID)
+	ret0, _ := ret[0].([]reimbursement.DuplicateReportEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDuplicateReport indicates an expected call of GetDuplicateReport.
+func (mr *MockAdminServiceProviderMockRecorder) GetDuplicateReport(ctx, status, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateReport", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetDuplicateReport), ctx, status, periodID)
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockAdminServiceProvider) GetExchangeRates(ctx context.Context, currency string) ([]reimbursement.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates", ctx, currency)
+	ret0, _ := ret[0].([]reimbursement.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockAdminServiceProviderMockRecorder) GetExchangeRates(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetExchangeRates), ctx, currency)
+}
+
+// GetOvertimes mocks base method.
+func (m *MockAdminServiceProvider) GetOvertimes(ctx context.Context, status string, periodID, reviewerID int, isAdmin bool) ([]overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimes", ctx, status, periodID, reviewerID, isAdmin)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimes indicates an expected call of GetOvertimes.
+func (mr *MockAdminServiceProviderMockRecorder) GetOvertimes(ctx, status, periodID, reviewerID, isAdmin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimes", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetOvertimes), ctx, status, periodID, reviewerID, isAdmin)
+}
+
 // GetPayslipSummary mocks base method.
 func (m *MockAdminServiceProvider) GetPayslipSummary(ctx context.Context, periodID int) (payslip.PayslipSummaryReport, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +252,126 @@ func (mr *MockAdminServiceProviderMockRecorder) GetPayslipSummary(ctx, periodID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipSummary", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetPayslipSummary), ctx, periodID)
 }
 
+// GetReimbursementCategories mocks base method.
+func (m *MockAdminServiceProvider) GetReimbursementCategories(ctx context.Context) ([]reimbursement.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementCategories", ctx)
+	ret0, _ := ret[0].([]reimbursement.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementCategories indicates an expected call of GetReimbursementCategories.
+func (mr *MockAdminServiceProviderMockRecorder) GetReimbursementCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementCategories", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetReimbursementCategories), ctx)
+}
+
+// GetReimbursementRates mocks base method.
+func (m *MockAdminServiceProvider) GetReimbursementRates(ctx context.Context, calculation string) ([]reimbursement.Rate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementRates", ctx, calculation)
+	ret0, _ := ret[0].([]reimbursement.Rate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursementRates indicates an expected call of GetReimbursementRates.
+func (mr *MockAdminServiceProviderMockRecorder) GetReimbursementRates(ctx, calculation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementRates", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetReimbursementRates), ctx, calculation)
+}
+
+// GetReimbursements mocks base method.
+func (m *MockAdminServiceProvider) GetReimbursements(ctx context.Context, status string, periodID int) ([]reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursements", ctx, status, periodID)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReimbursements indicates an expected call of GetReimbursements.
+func (mr *MockAdminServiceProviderMockRecorder) GetReimbursements(ctx, status, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursements", reflect.TypeOf((*MockAdminServiceProvider)(nil).GetReimbursements), ctx, status, periodID)
+}
+
+// ImportAttendance mocks base method.
+func (m *MockAdminServiceProvider) ImportAttendance(ctx context.Context, periodID int, format string, file io.Reader, userID, requestID int) (attendance.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAttendance", ctx, periodID, format, file, userID, requestID)
+	ret0, _ := ret[0].(attendance.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAttendance indicates an expected call of ImportAttendance.
+func (mr *MockAdminServiceProviderMockRecorder) ImportAttendance(ctx, periodID, format, file, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAttendance", reflect.TypeOf((*MockAdminServiceProvider)(nil).ImportAttendance), ctx, periodID, format, file, userID, requestID)
+}
+
+// ReviewAttendanceCorrection mocks base method.
+func (m *MockAdminServiceProvider) ReviewAttendanceCorrection(ctx context.Context, correctionID int, status, note string, userID, requestID int) (attendance.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAttendanceCorrection", ctx, correctionID, status, note, userID, requestID)
+	ret0, _ := ret[0].(attendance.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAttendanceCorrection indicates an expected call of ReviewAttendanceCorrection.
+func (mr *MockAdminServiceProviderMockRecorder) ReviewAttendanceCorrection(ctx, correctionID, status, note, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAttendanceCorrection", reflect.TypeOf((*MockAdminServiceProvider)(nil).ReviewAttendanceCorrection), ctx, correctionID, status, note, userID, requestID)
+}
+
+// ReviewBankAccount mocks base method.
+func (m *MockAdminServiceProvider) ReviewBankAccount(ctx context.Context, accountID int, status, note string, userID, requestID int) (bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewBankAccount", ctx, accountID, status, note, userID, requestID)
+	ret0, _ := ret[0].(bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewBankAccount indicates an expected call of ReviewBankAccount.
+func (mr *MockAdminServiceProviderMockRecorder) ReviewBankAccount(ctx, accountID, status, note, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewBankAccount", reflect.TypeOf((*MockAdminServiceProvider)(nil).ReviewBankAccount), ctx, accountID, status, note, userID, requestID)
+}
+
+// ReviewOvertimes mocks base method.
+func (m *MockAdminServiceProvider) ReviewOvertimes(ctx context.Context, overtimeIDs []int, status, note string, reviewerID int, isAdmin bool, requestID int) ([]overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewOvertimes", ctx, overtimeIDs, status, note, reviewerID, isAdmin, requestID)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewOvertimes indicates an expected call of ReviewOvertimes.
+func (mr *MockAdminServiceProviderMockRecorder) ReviewOvertimes(ctx, overtimeIDs, status, note, reviewerID, isAdmin, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewOvertimes", reflect.TypeOf((*MockAdminServiceProvider)(nil).ReviewOvertimes), ctx, overtimeIDs, status, note, reviewerID, isAdmin, requestID)
+}
+
+// ReviewReimbursement mocks base method.
+func (m *MockAdminServiceProvider) ReviewReimbursement(ctx context.Context, reimbursementID int, status string, approvedAmount *int, notes string, userID, requestID int) (reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewReimbursement", ctx, reimbursementID, status, approvedAmount, notes, userID, requestID)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewReimbursement indicates an expected call of ReviewReimbursement.
+func (mr *MockAdminServiceProviderMockRecorder) ReviewReimbursement(ctx, reimbursementID, status, approvedAmount, notes, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewReimbursement", reflect.TypeOf((*MockAdminServiceProvider)(nil).ReviewReimbursement), ctx, reimbursementID, status, approvedAmount, notes, userID, requestID)
+}
+
 // RunPayroll mocks base method.
 func (m *MockAdminServiceProvider) RunPayroll(ctx context.Context, periodID, userID, requestID int) error {
 	m.ctrl.T.Helper()
@@ -79,3 +385,48 @@ func (mr *MockAdminServiceProviderMockRecorder) RunPayroll(ctx, periodID, userID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPayroll", reflect.TypeOf((*MockAdminServiceProvider)(nil).RunPayroll), ctx, periodID, userID, requestID)
 }
+
+// UpdateEmployeeEmail mocks base method.
+func (m *MockAdminServiceProvider) UpdateEmployeeEmail(ctx context.Context, employeeID int, email string, userID, requestID int) (auth.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmployeeEmail", ctx, employeeID, email, userID, requestID)
+	ret0, _ := ret[0].(auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmployeeEmail indicates an expected call of UpdateEmployeeEmail.
+func (mr *MockAdminServiceProviderMockRecorder) UpdateEmployeeEmail(ctx, employeeID, email, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployeeEmail", reflect.TypeOf((*MockAdminServiceProvider)(nil).UpdateEmployeeEmail), ctx, employeeID, email, userID, requestID)
+}
+
+// UpdateEmployeeManager mocks base method.
+func (m *MockAdminServiceProvider) UpdateEmployeeManager(ctx context.Context, employeeID int, managerID *int, userID, requestID int) (auth.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmployeeManager", ctx, employeeID, managerID, userID, requestID)
+	ret0, _ := ret[0].(auth.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmployeeManager indicates an expected call of UpdateEmployeeManager.
+func (mr *MockAdminServiceProviderMockRecorder) UpdateEmployeeManager(ctx, employeeID, managerID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployeeManager", reflect.TypeOf((*MockAdminServiceProvider)(nil).UpdateEmployeeManager), ctx, employeeID, managerID, userID, requestID)
+}
+
+// UpdateReimbursementCategory mocks base method.
+func (m *MockAdminServiceProvider) UpdateReimbursementCategory(ctx context.Context, category reimbursement.Category, userID, requestID int) (reimbursement.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReimbursementCategory", ctx, category, userID, requestID)
+	ret0, _ := ret[0].(reimbursement.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReimbursementCategory indicates an expected call of UpdateReimbursementCategory.
+func (mr *MockAdminServiceProviderMockRecorder) UpdateReimbursementCategory(ctx, category, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReimbursementCategory", reflect.TypeOf((*MockAdminServiceProvider)(nil).UpdateReimbursementCategory), ctx, category, userID, requestID)
+}
@@ -5,15 +5,31 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"payslip-generation-system/internal/exchangerate"
 	"payslip-generation-system/internal/entity/attendance"
 	"payslip-generation-system/internal/entity/audit"
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/entity/overtime"
 	"payslip-generation-system/internal/entity/payslip"
+	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/entity/schedule"
+	usermodel "payslip-generation-system/internal/entity/user"
 	attrepo "payslip-generation-system/internal/repositories/attendance"
+	bankrepo "payslip-generation-system/internal/repositories/bankaccount"
 	ovttrepo "payslip-generation-system/internal/repositories/overtime"
 	payrepo "payslip-generation-system/internal/repositories/payslip"
 	rmbrepo "payslip-generation-system/internal/repositories/reimbursement"
+	schedrepo "payslip-generation-system/internal/repositories/schedule"
 	userepo "payslip-generation-system/internal/repositories/user"
 	audsvc "payslip-generation-system/internal/services/audit"
+	"payslip-generation-system/internal/services/rates"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 //go:generate mockgen -source=service.go -package=mock -destination=mock/service_mock.go
@@ -21,6 +37,29 @@ type AdminServiceProvider interface {
     AddPeriod(ctx context.Context, attendancePeriod attendance.AttendancePeriod, userID, requestID int)(int, error) 
     RunPayroll(ctx context.Context, periodID, userID, requestID int)( error) 
     GetPayslipSummary(ctx context.Context, periodID int)(payslip.PayslipSummaryReport, error)
+    AddShift(ctx context.Context, shift schedule.Shift, userID, requestID int)(int, error)
+    AssignSchedule(ctx context.Context, employeeSchedule schedule.EmployeeSchedule, userID, requestID int)(int, error)
+    ImportAttendance(ctx context.Context, periodID int, format string, file io.Reader, userID, requestID int)(attendance.ImportReport, error)
+    GetAttendanceCorrections(ctx context.Context, status string)([]attendance.AttendanceCorrection, error)
+    ReviewAttendanceCorrection(ctx context.Context, correctionID int, status, note string, userID, requestID int)(attendance.AttendanceCorrection, error)
+    GetOvertimes(ctx context.Context, status string, periodID, reviewerID int, isAdmin bool)([]overtime.Overtime, error)
+    ReviewOvertimes(ctx context.Context, overtimeIDs []int, status, note string, reviewerID int, isAdmin bool, requestID int)([]overtime.Overtime, error)
+    AddPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday, userID, requestID int)(int, error)
+    GetReimbursementCategories(ctx context.Context)([]reimbursement.Category, error)
+    UpdateReimbursementCategory(ctx context.Context, category reimbursement.Category, userID, requestID int)(reimbursement.Category, error)
+    GetReimbursements(ctx context.Context, status string, periodID int)([]reimbursement.Reimbursement, error)
+    ReviewReimbursement(ctx context.Context, reimbursementID int, status string, approvedAmount *int, notes string, userID, requestID int)(reimbursement.Reimbursement, error)
+    GetDuplicateReport(ctx context.Context, status string, periodID int)([]reimbursement.DuplicateReportEntry, error)
+    GetExchangeRates(ctx context.Context, currency string)([]reimbursement.ExchangeRate, error)
+    AddExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate, userID, requestID int)(reimbursement.ExchangeRate, error)
+    FetchExchangeRate(ctx context.Context, currency string, userID, requestID int)(reimbursement.ExchangeRate, error)
+    GetReimbursementRates(ctx context.Context, calculation string)([]reimbursement.Rate, error)
+    AddReimbursementRate(ctx context.Context, rate reimbursement.Rate, userID, requestID int)(reimbursement.Rate, error)
+    AddBankAccount(ctx context.Context, account bankaccount.BankAccount, userID, requestID int)(bankaccount.BankAccount, error)
+    GetBankAccounts(ctx context.Context, userID int, status string)([]bankaccount.BankAccount, error)
+    ReviewBankAccount(ctx context.Context, accountID int, status, note string, userID, requestID int)(bankaccount.BankAccount, error)
+    UpdateEmployeeEmail(ctx context.Context, employeeID int, email string, userID, requestID int)(usermodel.User, error)
+    UpdateEmployeeManager(ctx context.Context, employeeID int, managerID *int, userID, requestID int)(usermodel.User, error)
 }
 
 type adminService struct {
@@ -29,7 +68,12 @@ type adminService struct {
     rmbrepo rmbrepo.ReimbursementRepositoryProvider
     ovtrepo ovttrepo.OvertimeRepositoryProvider
     userepo userepo.UserRepositoryProvider
+    schedrepo schedrepo.ScheduleRepositoryProvider
     audsvc audsvc.AuditServiceProvider
+    clockPolicy attendance.ClockPolicy
+    overtimePolicy overtime.Policy
+    rateProvider exchangerate.Provider
+    bankrepo bankrepo.BankAccountRepositoryProvider
 }
 
 func NewAdminService(
@@ -38,7 +82,12 @@ func NewAdminService(
     reimbursRepo rmbrepo.ReimbursementRepositoryProvider,
     overtimeRepo ovttrepo.OvertimeRepositoryProvider,
     userRepo userepo.UserRepositoryProvider,
+    scheduleRepo schedrepo.ScheduleRepositoryProvider,
     auditService audsvc.AuditServiceProvider,
+    clockPolicy attendance.ClockPolicy,
+    overtimePolicy overtime.Policy,
+    rateProvider exchangerate.Provider,
+    bankAccountRepo bankrepo.BankAccountRepositoryProvider,
 ) AdminServiceProvider {
     return &adminService{
         attrepo: attendanceRepo,
@@ -46,7 +95,12 @@ func NewAdminService(
         rmbrepo: reimbursRepo,
         ovtrepo: overtimeRepo,
         userepo: userRepo,
+        schedrepo: scheduleRepo,
         audsvc: auditService,
+        clockPolicy: clockPolicy,
+        overtimePolicy: overtimePolicy,
+        rateProvider: rateProvider,
+        bankrepo: bankAccountRepo,
     }
 }
 
@@ -102,18 +156,60 @@ func (s *adminService) RunPayroll(ctx context.Context, periodID, userID, request
     startDate := attendancePeriod.StartDate
     endDate := attendancePeriod.EndDate
     diff := endDate.Sub(startDate)
-    workingDays := int(diff.Hours() / 24) + 1
+    periodDays := int(diff.Hours() / 24) + 1
 
-    employeeSummaries, err := s.attrepo.GetEmployeeAttendanceSummary(ctx, periodID)
+    schedules, err := s.schedrepo.GetEmployeeSchedulesInRange(ctx, startDate, endDate)
+    if err != nil {
+        return err
+    }
+    schedulesByUser := map[int][]schedule.EmployeeSchedule{}
+    for _, es := range schedules {
+        schedulesByUser[es.UserID] = append(schedulesByUser[es.UserID], es)
+    }
+
+    employeeSummaries, err := s.attrepo.GetEmployeeAttendanceSummary(ctx, periodID, int(s.clockPolicy.MinWorkDuration.Minutes()))
     if err != nil {
         return  err
     }
 
+    reimbursementTotals, err := s.rmbrepo.GetReimbursementTotalsByCategory(ctx, periodID)
+    if err != nil {
+        return err
+    }
+    reimbursementsByUser := map[int][]reimbursement.CategoryTotal{}
+    for _, total := range reimbursementTotals {
+        reimbursementsByUser[total.UserID] = append(reimbursementsByUser[total.UserID], total)
+    }
+
+    // the claims as they are before payroll marks them paid, to be kept in the audit log
+    approved, err := s.approvedReimbursements(ctx, periodID)
+    if err != nil {
+        return err
+    }
+    for _, total := range reimbursementTotals {
+        for _, id := range total.ReimbursementIDs {
+            if _, ok := approved[id]; !ok {
+                return fmt.Errorf("reimbursement %d changed while payroll was running, run payroll again", id)
+            }
+        }
+    }
+
     payslips := []payslip.Payslip{}
     for _, employee := range employeeSummaries {
-        attendanceAmount := int((employee.PresentDays*employee.BaseSalary) / workingDays)
-        overtimeAmount := int((employee.OvertimeHours * employee.BaseSalary) / workingDays)
-        takeHomePay := attendanceAmount + overtimeAmount + employee.ReimbursementTotal
+        workingDays := expectedWorkingDays(schedulesByUser[employee.UserID], startDate, endDate)
+        rateDays := workingDays
+        if rateDays == 0 {
+            // nothing scheduled in the period, extra days are paid at the period-wide daily rate
+            rateDays = periodDays
+        }
+
+        attendanceAmount := int((employee.PresentDays*employee.BaseSalary) / rateDays)
+        overtimeAmount := s.overtimeAmount(employee, rateDays)
+        reimbursementTotal := 0
+        for _, total := range reimbursementsByUser[employee.UserID] {
+            reimbursementTotal += total.Amount
+        }
+        takeHomePay := attendanceAmount + overtimeAmount + reimbursementTotal
 
         payslip := payslip.Payslip{
             UserID: employee.UserID,
@@ -122,11 +218,12 @@ func (s *adminService) RunPayroll(ctx context.Context, periodID, userID, request
             WorkingDays: workingDays,
             PresentDays: employee.PresentDays,
             AttendanceAmount: attendanceAmount,
-            OvertimeHours: employee.OvertimeHours,
+            OvertimeHours: math.Round(float64(employee.OvertimeMinutes)/60*100) / 100,
             OvertimeAmount: overtimeAmount,
-            ReimbursementTotal: employee.ReimbursementTotal,
+            ReimbursementTotal: reimbursementTotal,
             TakeHomePay: takeHomePay,
         }
+        payslip.Items = payslipItems(payslip, reimbursementsByUser[employee.UserID])
         payslips = append(payslips, payslip)
     }
 
@@ -154,9 +251,931 @@ func (s *adminService) RunPayroll(ctx context.Context, periodID, userID, request
         return err
     }
 
+    err = s.recordReimbursementsPaid(ctx, payslips, approved, userID, requestID)
+    if err != nil {
+        return err
+    }
+
+    // every employee gets their payslip by email, the delivery worker sends what is queued
+    err = s.payrepo.QueuePayslipDeliveries(ctx, periodID)
+    if err != nil {
+        return err
+    }
+
     return nil
 }
 
+// approvedReimbursements returns the approved claims of a period by id
+func (s *adminService) approvedReimbursements(ctx context.Context, periodID int) (map[int]reimbursement.Reimbursement, error) {
+    approved := map[int]reimbursement.Reimbursement{}
+    for _, status := range []string{reimbursement.StatusApproved, reimbursement.StatusPartiallyApproved} {
+        reimbursements, err := s.rmbrepo.GetReimbursementsByStatus(ctx, status, periodID)
+        if err != nil {
+            return nil, err
+        }
+        for _, rmb := range reimbursements {
+            approved[rmb.ID] = rmb
+        }
+    }
+    return approved, nil
+}
+
+// recordReimbursementsPaid logs the claims the payslip lines pay, which were marked paid when the payslips
+// were written. Claims approved after the totals were read aren't on a payslip and stay approved
+func (s *adminService) recordReimbursementsPaid(ctx context.Context, payslips []payslip.Payslip, approved map[int]reimbursement.Reimbursement, userID, requestID int) error {
+    for _, p := range payslips {
+        for _, item := range p.Items {
+            for _, id := range item.ReimbursementIDs {
+                rmb := approved[id]
+                paid := rmb
+                paid.Status = reimbursement.StatusPaid
+
+                oldJson, err := json.Marshal(rmb)
+                if err != nil {
+                    return err
+                }
+                newJson, err := json.Marshal(paid)
+                if err != nil {
+                    return err
+                }
+
+                log := audit.AuditLog{
+                    TableName: "reimbursements",
+                    RecordID: rmb.ID,
+                    Action: "UPDATE",
+                    OldData: oldJson,
+                    NewData: newJson,
+                    ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+                    RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+                }
+                _, err = s.audsvc.RecordAuditLog(ctx, log)
+                if err != nil {
+                    return err
+                }
+            }
+        }
+    }
+    return nil
+}
+
+// payslipItems breaks a payslip down into its lines, reimbursements get a line per category
+func payslipItems(p payslip.Payslip, reimbursements []reimbursement.CategoryTotal) []payslip.PayslipItem {
+    items := []payslip.PayslipItem{
+        {
+            Component: payslip.ComponentAttendance,
+            Description: fmt.Sprintf("Attendance (%d of %d working days)", p.PresentDays, p.WorkingDays),
+            Amount: p.AttendanceAmount,
+        },
+    }
+    if p.OvertimeAmount > 0 {
+        items = append(items, payslip.PayslipItem{
+            Component: payslip.ComponentOvertime,
+            Description: fmt.Sprintf("Overtime (%s hours)", strconv.FormatFloat(p.OvertimeHours, 'f', -1, 64)),
+            Amount: p.OvertimeAmount,
+        })
+    }
+    for _, total := range reimbursements {
+        description := "Reimbursement"
+        if total.Name != "" {
+            description = "Reimbursement - " + total.Name
+        }
+        items = append(items, payslip.PayslipItem{
+            Component: payslip.ComponentReimbursement,
+            Category: total.Category,
+            Description: description,
+            Amount: total.Amount,
+            ReimbursementIDs: total.ReimbursementIDs,
+        })
+    }
+    return items
+}
+
+// overtimeAmount pays each overtime hour at the daily rate, weighted by the rate of its overtime type,
+// partial hours are paid pro rata by the minute
+func (s *adminService) overtimeAmount(employee attendance.EmployeeAttendanceSummary, rateDays int) int {
+    regularMinutes := employee.OvertimeMinutes - employee.RestDayOvertimeMinutes - employee.PublicHolidayOvertimeMinutes
+    weightedMinutes := regularMinutes*s.overtimePolicy.RatePercent[overtime.TypeRegular] +
+        employee.RestDayOvertimeMinutes*s.overtimePolicy.RatePercent[overtime.TypeRestDay] +
+        employee.PublicHolidayOvertimeMinutes*s.overtimePolicy.RatePercent[overtime.TypePublicHoliday]
+    return (weightedMinutes * employee.BaseSalary) / (100 * 60 * rateDays)
+}
+
 func (s *adminService) GetPayslipSummary(ctx context.Context, periodID int)(payslip.PayslipSummaryReport, error)  {
    return s.payrepo.GetPayslipSummary(ctx, periodID)
 }
+
+func (s *adminService) AddShift(ctx context.Context, shift schedule.Shift, userID, requestID int)(int, error) {
+    if shift.Name == "" {
+        return 0, fmt.Errorf("name is required")
+    }
+    if !workPatternRegex.MatchString(shift.WorkPattern) {
+        return 0, fmt.Errorf("work_pattern must be 1 to 31 characters of 0 (rest day) and 1 (working day)")
+    }
+    if shift.PatternStartDate.IsZero() {
+        return 0, fmt.Errorf("pattern_start_date is required")
+    }
+    if _, err := shift.StartOffset(); err != nil {
+        return 0, fmt.Errorf("start_time must be in HH:MM format")
+    }
+
+    id, err := s.schedrepo.InsertShift(ctx, shift)
+    if err != nil {
+        return 0, err
+    }
+
+    shiftJson, err := json.Marshal(shift)
+    if err != nil {
+        return 0, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "shifts",
+        RecordID: id,
+        Action: "CREATE",
+        OldData: []byte("{}"),
+        NewData: shiftJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return 0, err
+    }
+    return id, nil
+}
+
+func (s *adminService) AssignSchedule(ctx context.Context, employeeSchedule schedule.EmployeeSchedule, userID, requestID int)(int, error) {
+    if employeeSchedule.EffectiveTo != nil && employeeSchedule.EffectiveTo.Before(employeeSchedule.EffectiveFrom) {
+        return 0, fmt.Errorf("effective_to must not be before effective_from")
+    }
+
+    shift, err := s.schedrepo.GetShiftByID(ctx, employeeSchedule.ShiftID)
+    if err != nil {
+        return 0, err
+    }
+    if shift.ID == 0 {
+        return 0, fmt.Errorf("shift not found")
+    }
+
+    id, err := s.schedrepo.InsertEmployeeSchedule(ctx, employeeSchedule)
+    if err != nil {
+        return 0, err
+    }
+
+    employeeScheduleJson, err := json.Marshal(employeeSchedule)
+    if err != nil {
+        return 0, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "employee_schedules",
+        RecordID: id,
+        Action: "CREATE",
+        OldData: []byte("{}"),
+        NewData: employeeScheduleJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return 0, err
+    }
+    return id, nil
+}
+
+// ImportAttendance validates every row of an attendance file and inserts the valid ones,
+// rejected rows are reported back and do not stop the rest of the batch from being imported
+func (s *adminService) ImportAttendance(ctx context.Context, periodID int, format string, file io.Reader, userID, requestID int)(attendance.ImportReport, error) {
+    attendancePeriod, err := s.attrepo.GetAttendancePeriodByID(ctx, periodID)
+    if err != nil {
+        return attendance.ImportReport{}, err
+    }
+    if attendancePeriod.ID == 0 {
+        return attendance.ImportReport{}, fmt.Errorf("period not found")
+    }
+
+    isExistPeriod, err := s.payrepo.PayslipExistsByPeriodID(ctx, periodID)
+    if err != nil {
+        return attendance.ImportReport{}, err
+    }
+    if isExistPeriod {
+        return attendance.ImportReport{}, fmt.Errorf("payroll already generated")
+    }
+
+    var rows []importRow
+    var rowErrors []attendance.ImportRowError
+    switch format {
+    case attendance.ImportFormatCSV:
+        rows, rowErrors, err = parseAttendanceCSV(file, s.clockPolicy.Location)
+    case attendance.ImportFormatAttlog:
+        rows, rowErrors, err = parseAttendanceAttlog(file, s.clockPolicy.Location)
+    default:
+        return attendance.ImportReport{}, fmt.Errorf("format must be %s or %s", attendance.ImportFormatCSV, attendance.ImportFormatAttlog)
+    }
+    if err != nil {
+        return attendance.ImportReport{}, err
+    }
+
+    employees, err := s.userepo.GetAllEmployees(ctx)
+    if err != nil {
+        return attendance.ImportReport{}, err
+    }
+    employeeIDs := map[string]int{}
+    for _, employee := range employees {
+        employeeIDs[strconv.Itoa(employee.ID)] = employee.ID
+        employeeIDs[employee.Username] = employee.ID
+    }
+
+    imported := []attendance.Attendance{}
+    seen := map[string]bool{}
+    for _, row := range rows {
+        att := attendance.Attendance{
+            UserID: employeeIDs[row.user],
+            PeriodID: periodID,
+            Date: row.date,
+            ClockIn: row.clockIn,
+            ClockOut: row.clockOut,
+        }
+        key := fmt.Sprintf("%d|%s", att.UserID, att.Date.Format("2006-01-02"))
+
+        err := s.validateImportedAttendance(ctx, &att, attendancePeriod, seen[key])
+        if err == nil {
+            att.ID, err = s.attrepo.InsertAttendance(ctx, att)
+        }
+        if err != nil {
+            rowErrors = append(rowErrors, attendance.ImportRowError{
+                Row: row.row,
+                User: row.user,
+                Date: row.date.Format("2006-01-02"),
+                Error: err.Error(),
+            })
+            continue
+        }
+        seen[key] = true
+        imported = append(imported, att)
+    }
+    sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Row < rowErrors[j].Row })
+
+    report := attendance.ImportReport{
+        TotalRows: len(imported) + len(rowErrors),
+        Imported: len(imported),
+        Failed: len(rowErrors),
+        Errors: rowErrors,
+    }
+    if len(imported) == 0 {
+        return report, nil
+    }
+
+    attendancesJson, err := json.Marshal(imported)
+    if err != nil {
+        return report, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "attendances",
+        RecordID: 0,
+        Action: "CREATE",
+        OldData: []byte("{}"),
+        NewData: attendancesJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return report, err
+    }
+    return report, nil
+}
+
+// validateImportedAttendance applies the same rules as the employee submission to an imported row
+// and fills in the late, worked and extra day fields. Rows on rest days are kept as extra days
+// since the admin is recording time that was actually worked.
+func (s *adminService) validateImportedAttendance(ctx context.Context, att *attendance.Attendance, attendancePeriod attendance.AttendancePeriod, isDuplicate bool) error {
+    if att.UserID == 0 {
+        return fmt.Errorf("employee not found")
+    }
+    if att.Date.Before(attendancePeriod.StartDate) || att.Date.After(attendancePeriod.EndDate) {
+        return fmt.Errorf("date must be between %s and %s", attendancePeriod.StartDate.Format("2006-01-02"), attendancePeriod.EndDate.Format("2006-01-02"))
+    }
+    if isDuplicate {
+        return fmt.Errorf("attendance is listed more than once in the file")
+    }
+
+    existingAttendance, err := s.attrepo.GetAttendance(ctx, att.UserID, att.PeriodID, att.Date)
+    if err != nil {
+        return err
+    }
+    if existingAttendance.ID != 0 {
+        return fmt.Errorf("attendance already exists")
+    }
+
+    employeeSchedule, err := s.schedrepo.GetEmployeeScheduleByDate(ctx, att.UserID, att.Date)
+    if err != nil {
+        return err
+    }
+    att.IsExtraDay = !employeeSchedule.IsWorkingDay(att.Date)
+
+    if att.ClockIn == nil {
+        return nil
+    }
+    shiftStart := s.clockPolicy.ShiftStart
+    if employeeSchedule.ID != 0 {
+        shiftStart, err = employeeSchedule.Shift.StartOffset()
+        if err != nil {
+            return err
+        }
+    }
+    att.LateMinutes = attendance.LateMinutes(att.ClockIn.In(s.clockPolicy.Location), shiftStart)
+
+    if att.ClockOut == nil {
+        return nil
+    }
+    worked := att.ClockOut.Sub(*att.ClockIn)
+    if worked > s.clockPolicy.MaxShiftDuration {
+        return fmt.Errorf("shift from %s to %s is longer than %s", att.ClockIn.Format(time.RFC3339), att.ClockOut.Format(time.RFC3339), s.clockPolicy.MaxShiftDuration)
+    }
+    att.WorkedMinutes = int(worked.Minutes())
+    return nil
+}
+
+func (s *adminService) GetAttendanceCorrections(ctx context.Context, status string)([]attendance.AttendanceCorrection, error) {
+    if status == "" {
+        status = attendance.CorrectionStatusPending
+    }
+    return s.attrepo.GetAttendanceCorrectionsByStatus(ctx, status)
+}
+
+// ReviewAttendanceCorrection approves or rejects a pending correction request,
+// an approval adds or removes the attendance row before the request is closed
+func (s *adminService) ReviewAttendanceCorrection(ctx context.Context, correctionID int, status, note string, userID, requestID int)(attendance.AttendanceCorrection, error) {
+    if status != attendance.CorrectionStatusApproved && status != attendance.CorrectionStatusRejected {
+        return attendance.AttendanceCorrection{}, fmt.Errorf("status must be %s or %s", attendance.CorrectionStatusApproved, attendance.CorrectionStatusRejected)
+    }
+
+    correction, err := s.attrepo.GetAttendanceCorrectionByID(ctx, correctionID)
+    if err != nil {
+        return attendance.AttendanceCorrection{}, err
+    }
+    if correction.ID == 0 {
+        return attendance.AttendanceCorrection{}, fmt.Errorf("correction not found")
+    }
+    if correction.Status != attendance.CorrectionStatusPending {
+        return attendance.AttendanceCorrection{}, fmt.Errorf("correction has already been %s", correction.Status)
+    }
+
+    isProcessed, err := s.payrepo.PayslipExistsByPeriodID(ctx, correction.PeriodID)
+    if err != nil {
+        return attendance.AttendanceCorrection{}, err
+    }
+    if isProcessed {
+        return attendance.AttendanceCorrection{}, fmt.Errorf("payroll already generated for this period, attendance can no longer be corrected")
+    }
+
+    if status == attendance.CorrectionStatusApproved {
+        err = s.applyAttendanceCorrection(ctx, correction, userID, requestID)
+        if err != nil {
+            return attendance.AttendanceCorrection{}, err
+        }
+    }
+
+    reviewedAt := time.Now()
+    reviewedCorrection := correction
+    reviewedCorrection.Status = status
+    reviewedCorrection.ReviewedBy = &userID
+    reviewedCorrection.ReviewNote = note
+    reviewedCorrection.ReviewedAt = &reviewedAt
+
+    err = s.attrepo.UpdateAttendanceCorrectionReview(ctx, reviewedCorrection)
+    if err != nil {
+        return attendance.AttendanceCorrection{}, err
+    }
+
+    oldJson, err := json.Marshal(correction)
+    if err != nil {
+        return attendance.AttendanceCorrection{}, err
+    }
+    newJson, err := json.Marshal(reviewedCorrection)
+    if err != nil {
+        return attendance.AttendanceCorrection{}, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "attendance_corrections",
+        RecordID: correction.ID,
+        Action: "UPDATE",
+        OldData: oldJson,
+        NewData: newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return attendance.AttendanceCorrection{}, err
+    }
+    return reviewedCorrection, nil
+}
+
+// applyAttendanceCorrection creates or deletes the attendance row an approved correction asks for
+func (s *adminService) applyAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection, userID, requestID int) error {
+    existingAttendance, err := s.attrepo.GetAttendance(ctx, correction.UserID, correction.PeriodID, correction.Date)
+    if err != nil {
+        return err
+    }
+
+    log := audit.AuditLog{
+        TableName: "attendances",
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+
+    switch correction.Action {
+    case attendance.CorrectionActionAdd:
+        if existingAttendance.ID != 0 {
+            return fmt.Errorf("attendance already exists")
+        }
+
+        employeeSchedule, err := s.schedrepo.GetEmployeeScheduleByDate(ctx, correction.UserID, correction.Date)
+        if err != nil {
+            return err
+        }
+        att := attendance.Attendance{
+            UserID: correction.UserID,
+            PeriodID: correction.PeriodID,
+            Date: correction.Date,
+            IsExtraDay: !employeeSchedule.IsWorkingDay(correction.Date),
+        }
+
+        att.ID, err = s.attrepo.InsertAttendance(ctx, att)
+        if err != nil {
+            return err
+        }
+
+        attendanceJson, err := json.Marshal(att)
+        if err != nil {
+            return err
+        }
+        log.RecordID = att.ID
+        log.Action = "CREATE"
+        log.OldData = []byte("{}")
+        log.NewData = attendanceJson
+    case attendance.CorrectionActionRemove:
+        if existingAttendance.ID == 0 {
+            return fmt.Errorf("there is no attendance on %s to remove", correction.Date.Format("2006-01-02"))
+        }
+
+        existingOvertime, err := s.ovtrepo.GetOvertime(ctx, correction.UserID, correction.PeriodID, correction.Date)
+        if err != nil {
+            return err
+        }
+        if existingOvertime.ID != 0 {
+            return fmt.Errorf("overtime is recorded on %s, it has to be removed before the attendance", correction.Date.Format("2006-01-02"))
+        }
+
+        err = s.attrepo.DeleteAttendance(ctx, existingAttendance.ID)
+        if err != nil {
+            return err
+        }
+
+        attendanceJson, err := json.Marshal(existingAttendance)
+        if err != nil {
+            return err
+        }
+        log.RecordID = existingAttendance.ID
+        log.Action = "DELETE"
+        log.OldData = attendanceJson
+        log.NewData = []byte("{}")
+    default:
+        return fmt.Errorf("unknown correction action %s", correction.Action)
+    }
+
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return err
+    }
+    return nil
+}
+
+// GetOvertimes lists overtime by status, admins see every employee and managers only their direct reports
+func (s *adminService) GetOvertimes(ctx context.Context, status string, periodID, reviewerID int, isAdmin bool)([]overtime.Overtime, error) {
+    if status == "" {
+        status = overtime.StatusPending
+    }
+    managerID := reviewerID
+    if isAdmin {
+        managerID = 0
+    }
+    return s.ovtrepo.GetOvertimesByStatus(ctx, status, periodID, managerID)
+}
+
+// ReviewOvertimes approves or rejects pending overtime, either a single entry or a batch.
+// Every entry is checked and then the batch is updated in one transaction, so it is reviewed entirely or not at all,
+// also when an entry is withdrawn or reviewed by someone else in the meantime.
+// Admins can review any overtime and managers only the overtime of their direct reports.
+func (s *adminService) ReviewOvertimes(ctx context.Context, overtimeIDs []int, status, note string, reviewerID int, isAdmin bool, requestID int)([]overtime.Overtime, error) {
+    if status != overtime.StatusApproved && status != overtime.StatusRejected {
+        return nil, fmt.Errorf("status must be %s or %s", overtime.StatusApproved, overtime.StatusRejected)
+    }
+    if len(overtimeIDs) == 0 {
+        return nil, fmt.Errorf("overtime_ids is required")
+    }
+
+    overtimes, err := s.ovtrepo.GetOvertimesByIDs(ctx, overtimeIDs)
+    if err != nil {
+        return nil, err
+    }
+    found := map[int]bool{}
+    for _, ot := range overtimes {
+        found[ot.ID] = true
+    }
+    for _, id := range overtimeIDs {
+        if !found[id] {
+            return nil, fmt.Errorf("overtime %d not found", id)
+        }
+    }
+
+    processedPeriods := map[int]bool{}
+    managers := map[int]*int{}
+    for _, ot := range overtimes {
+        if ot.Status != overtime.StatusPending {
+            return nil, fmt.Errorf("overtime %d has already been %s", ot.ID, ot.Status)
+        }
+
+        isProcessed, ok := processedPeriods[ot.PeriodID]
+        if !ok {
+            isProcessed, err = s.payrepo.PayslipExistsByPeriodID(ctx, ot.PeriodID)
+            if err != nil {
+                return nil, err
+            }
+            processedPeriods[ot.PeriodID] = isProcessed
+        }
+        if isProcessed {
+            return nil, fmt.Errorf("overtime %d belongs to a period where payroll already generated", ot.ID)
+        }
+
+        if isAdmin {
+            continue
+        }
+        if ot.UserID == reviewerID {
+            return nil, fmt.Errorf("you cannot review your own overtime")
+        }
+        managerID, ok := managers[ot.UserID]
+        if !ok {
+            employee, err := s.userepo.GetUserByID(ctx, ot.UserID)
+            if err != nil {
+                return nil, err
+            }
+            managerID = employee.ManagerID
+            managers[ot.UserID] = managerID
+        }
+        if managerID == nil || *managerID != reviewerID {
+            return nil, fmt.Errorf("overtime %d does not belong to one of your direct reports", ot.ID)
+        }
+    }
+
+    reviewedAt := time.Now()
+    reviewedOvertimes := []overtime.Overtime{}
+    for _, ot := range overtimes {
+        reviewedOvertime := ot
+        reviewedOvertime.Status = status
+        reviewedOvertime.ReviewedBy = &reviewerID
+        reviewedOvertime.ReviewNote = note
+        reviewedOvertime.ReviewedAt = &reviewedAt
+        reviewedOvertimes = append(reviewedOvertimes, reviewedOvertime)
+    }
+    err = s.ovtrepo.UpdateOvertimeReviews(ctx, reviewedOvertimes)
+    if err != nil {
+        return nil, err
+    }
+
+    for i, ot := range overtimes {
+        reviewedOvertime := reviewedOvertimes[i]
+        oldJson, err := json.Marshal(ot)
+        if err != nil {
+            return nil, err
+        }
+        newJson, err := json.Marshal(reviewedOvertime)
+        if err != nil {
+            return nil, err
+        }
+
+        log := audit.AuditLog{
+            TableName: "overtimes",
+            RecordID: ot.ID,
+            Action: "UPDATE",
+            OldData: oldJson,
+            NewData: newJson,
+            ChangedBy: sql.NullInt32{Valid: true, Int32: int32(reviewerID)},
+            RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+        }
+        _, err = s.audsvc.RecordAuditLog(ctx, log)
+        if err != nil {
+            return nil, err
+        }
+    }
+    return reviewedOvertimes, nil
+}
+
+func (s *adminService) AddPublicHoliday(ctx context.Context, holiday schedule.PublicHoliday, userID, requestID int)(int, error) {
+    if holiday.Name == "" {
+        return 0, fmt.Errorf("name is required")
+    }
+
+    existingHoliday, err := s.schedrepo.GetPublicHolidayByDate(ctx, holiday.Date)
+    if err != nil {
+        return 0, err
+    }
+    if existingHoliday.ID != 0 {
+        return 0, fmt.Errorf("%s is already a public holiday (%s)", holiday.Date.Format("2006-01-02"), existingHoliday.Name)
+    }
+
+    id, err := s.schedrepo.InsertPublicHoliday(ctx, holiday)
+    if err != nil {
+        return 0, err
+    }
+
+    holidayJson, err := json.Marshal(holiday)
+    if err != nil {
+        return 0, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "public_holidays",
+        RecordID: id,
+        Action: "CREATE",
+        OldData: []byte("{}"),
+        NewData: holidayJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return 0, err
+    }
+    return id, nil
+}
+
+func (s *adminService) GetReimbursementCategories(ctx context.Context)([]reimbursement.Category, error) {
+    return s.rmbrepo.GetCategories(ctx)
+}
+
+// UpdateReimbursementCategory changes the limits, eligible grades and over-limit action of an existing category,
+// claims that were already paid are not recalculated
+func (s *adminService) UpdateReimbursementCategory(ctx context.Context, category reimbursement.Category, userID, requestID int)(reimbursement.Category, error) {
+    if category.PerClaimLimit < 0 || category.PerPeriodLimit < 0 || category.PerYearLimit < 0 {
+        return category, fmt.Errorf("limits must not be negative")
+    }
+    if category.OverLimitAction != reimbursement.OverLimitReject && category.OverLimitAction != reimbursement.OverLimitPartial {
+        return category, fmt.Errorf("over_limit_action must be %s or %s", reimbursement.OverLimitReject, reimbursement.OverLimitPartial)
+    }
+
+    existingCategory, err := s.rmbrepo.GetCategoryByCode(ctx, category.Code)
+    if err != nil {
+        return category, err
+    }
+    if existingCategory.ID == 0 {
+        return category, fmt.Errorf("reimbursement category %s not found", category.Code)
+    }
+
+    category.ID = existingCategory.ID
+    category.CreatedAt = existingCategory.CreatedAt
+    if category.Name == "" {
+        category.Name = existingCategory.Name
+    }
+    if category.EligibleGrades == nil {
+        category.EligibleGrades = []string{}
+    }
+
+    err = s.rmbrepo.UpdateCategory(ctx, category)
+    if err != nil {
+        return category, err
+    }
+
+    oldJson, err := json.Marshal(existingCategory)
+    if err != nil {
+        return category, err
+    }
+    newJson, err := json.Marshal(category)
+    if err != nil {
+        return category, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "reimbursement_categories",
+        RecordID: category.ID,
+        Action: "UPDATE",
+        OldData: oldJson,
+        NewData: newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return category, err
+    }
+    return category, nil
+}
+
+// GetReimbursements lists claims by status and period, an empty status lists every status and period 0 every period
+func (s *adminService) GetReimbursements(ctx context.Context, status string, periodID int)([]reimbursement.Reimbursement, error) {
+    switch status {
+    case "", reimbursement.StatusSubmitted, reimbursement.StatusOnHold, reimbursement.StatusApproved, reimbursement.StatusPartiallyApproved,
+        reimbursement.StatusRejected, reimbursement.StatusPaid:
+    default:
+        return nil, fmt.Errorf("unknown reimbursement status %s", status)
+    }
+    return s.rmbrepo.GetReimbursementsByStatus(ctx, status, periodID)
+}
+
+// GetDuplicateReport lists the suspected duplicate claims, optionally only the flagged claims
+// with the given status or in the given period
+func (s *adminService) GetDuplicateReport(ctx context.Context, status string, periodID int)([]reimbursement.DuplicateReportEntry, error) {
+    switch status {
+    case "", reimbursement.StatusSubmitted, reimbursement.StatusOnHold, reimbursement.StatusApproved, reimbursement.StatusPartiallyApproved,
+        reimbursement.StatusRejected, reimbursement.StatusPaid:
+    default:
+        return nil, fmt.Errorf("unknown reimbursement status %s", status)
+    }
+    return s.rmbrepo.GetDuplicateReport(ctx, status, periodID)
+}
+
+// ReviewReimbursement approves or rejects a submitted or held claim. An approval pays the claimed amount unless
+// approvedAmount is given, approving less than the claim makes it partially approved.
+// Rejections and partial approvals need reviewer notes so the employee knows why.
+func (s *adminService) ReviewReimbursement(ctx context.Context, reimbursementID int, status string, approvedAmount *int, notes string, userID, requestID int)(reimbursement.Reimbursement, error) {
+    if status != reimbursement.StatusApproved && status != reimbursement.StatusRejected {
+        return reimbursement.Reimbursement{}, fmt.Errorf("status must be %s or %s", reimbursement.StatusApproved, reimbursement.StatusRejected)
+    }
+
+    rmb, err := s.rmbrepo.GetReimbursementByID(ctx, reimbursementID)
+    if err != nil {
+        return reimbursement.Reimbursement{}, err
+    }
+    if rmb.ID == 0 {
+        return reimbursement.Reimbursement{}, fmt.Errorf("reimbursement not found")
+    }
+    if !rmb.IsAwaitingReview() {
+        return rmb, fmt.Errorf("reimbursement %d has already been %s", rmb.ID, strings.ReplaceAll(rmb.Status, "_", " "))
+    }
+
+    isProcessed, err := s.payrepo.PayslipExistsByPeriodID(ctx, rmb.PeriodID)
+    if err != nil {
+        return rmb, err
+    }
+    if isProcessed {
+        return rmb, fmt.Errorf("reimbursement %d belongs to a period where payroll already generated", rmb.ID)
+    }
+
+    reviewed := rmb
+    reviewed.Status = status
+    if status == reimbursement.StatusApproved {
+        amount := rmb.Amount
+        if approvedAmount != nil {
+            amount = *approvedAmount
+        }
+        if amount <= 0 || amount > rmb.Amount {
+            return rmb, fmt.Errorf("approved_amount must be between 1 and the claimed %d", rmb.Amount)
+        }
+        if amount < rmb.Amount {
+            reviewed.Status = reimbursement.StatusPartiallyApproved
+        }
+        reviewed.ApprovedAmount = &amount
+    } else if approvedAmount != nil {
+        return rmb, fmt.Errorf("a rejected reimbursement can't have an approved_amount")
+    }
+    if reviewed.Status != reimbursement.StatusApproved && strings.TrimSpace(notes) == "" {
+        return rmb, fmt.Errorf("notes are required when a reimbursement is not approved in full")
+    }
+
+    reviewedAt := time.Now()
+    reviewed.ReviewedBy = &userID
+    reviewed.ReviewerNotes = notes
+    reviewed.ReviewedAt = &reviewedAt
+
+    err = s.rmbrepo.UpdateReimbursementReview(ctx, reviewed)
+    if err != nil {
+        return rmb, err
+    }
+
+    oldJson, err := json.Marshal(rmb)
+    if err != nil {
+        return reviewed, err
+    }
+    newJson, err := json.Marshal(reviewed)
+    if err != nil {
+        return reviewed, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "reimbursements",
+        RecordID: rmb.ID,
+        Action: "UPDATE",
+        OldData: oldJson,
+        NewData: newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return reviewed, err
+    }
+    return reviewed, nil
+}
+
+func (s *adminService) GetExchangeRates(ctx context.Context, currency string)([]reimbursement.ExchangeRate, error) {
+    currency = strings.ToUpper(strings.TrimSpace(currency))
+    if currency != "" && !reimbursement.IsValidCurrency(currency) {
+        return nil, fmt.Errorf("invalid currency %s", currency)
+    }
+    return s.rmbrepo.GetExchangeRates(ctx, currency)
+}
+
+// AddExchangeRate records a manually maintained rate, a second rate for the same currency and day replaces the first
+func (s *adminService) AddExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate, userID, requestID int)(reimbursement.ExchangeRate, error) {
+    rate.Currency = strings.ToUpper(strings.TrimSpace(rate.Currency))
+    if !reimbursement.IsValidCurrency(rate.Currency) {
+        return rate, fmt.Errorf("currency must be a 3 letter ISO 4217 code")
+    }
+    if rate.Currency == reimbursement.BaseCurrency {
+        return rate, fmt.Errorf("%s is the payroll currency and doesn't need a rate", reimbursement.BaseCurrency)
+    }
+    if rate.Rate <= 0 {
+        return rate, fmt.Errorf("rate must be greater than 0")
+    }
+    if rate.RateDate.IsZero() {
+        return rate, fmt.Errorf("rate_date is required")
+    }
+    rate.Source = reimbursement.RateSourceManual
+    rate.CreatedBy = &userID
+    return s.saveExchangeRate(ctx, rate, userID, requestID)
+}
+
+// FetchExchangeRate stores the current rate of a currency from the configured provider
+func (s *adminService) FetchExchangeRate(ctx context.Context, currency string, userID, requestID int)(reimbursement.ExchangeRate, error) {
+    currency = strings.ToUpper(strings.TrimSpace(currency))
+    if !reimbursement.IsValidCurrency(currency) || currency == reimbursement.BaseCurrency {
+        return reimbursement.ExchangeRate{}, fmt.Errorf("invalid currency %s", currency)
+    }
+    if s.rateProvider == nil {
+        return reimbursement.ExchangeRate{}, fmt.Errorf("no exchange rate provider is configured")
+    }
+
+    rate, err := s.rateProvider.GetRate(ctx, currency)
+    if err != nil {
+        return reimbursement.ExchangeRate{}, err
+    }
+    rate.CreatedBy = &userID
+    return s.saveExchangeRate(ctx, rate, userID, requestID)
+}
+
+// GetReimbursementRates lists the mileage and per diem rates, an empty calculation lists both
+func (s *adminService) GetReimbursementRates(ctx context.Context, calculation string)([]reimbursement.Rate, error) {
+    if calculation != "" && !reimbursement.IsValidCalculation(calculation) {
+        return nil, fmt.Errorf("unknown calculation %s", calculation)
+    }
+    return s.rmbrepo.GetRates(ctx, calculation)
+}
+
+// AddReimbursementRate sets the mileage or per diem rate from a date on, claims already submitted keep the rate they were computed with.
+// A second rate for the same destination and date replaces the first
+func (s *adminService) AddReimbursementRate(ctx context.Context, rate reimbursement.Rate, userID, requestID int)(reimbursement.Rate, error) {
+    if !reimbursement.IsValidCalculation(rate.Calculation) {
+        return rate, fmt.Errorf("calculation must be %s or %s", reimbursement.CalculationMileage, reimbursement.CalculationPerDiem)
+    }
+    rate.Destination = reimbursement.NormalizeDestination(rate.Destination)
+    if rate.Calculation == reimbursement.CalculationMileage && rate.Destination != "" {
+        return rate, fmt.Errorf("mileage rates apply to every destination")
+    }
+    if rate.Rate <= 0 {
+        return rate, fmt.Errorf("rate must be greater than 0")
+    }
+    if rate.EffectiveFrom.IsZero() {
+        return rate, fmt.Errorf("effective_from is required")
+    }
+    rate.CreatedBy = &userID
+    return rates.SaveRate(ctx, s.rmbrepo, s.audsvc, rate, userID, requestID)
+}
+
+func (s *adminService) saveExchangeRate(ctx context.Context, rate reimbursement.ExchangeRate, userID, requestID int)(reimbursement.ExchangeRate, error) {
+    return rates.SaveExchangeRate(ctx, s.rmbrepo, s.audsvc, rate, userID, requestID)
+}
+
+var workPatternRegex = regexp.MustCompile(`^[01]{1,31}$`)
+
+// expectedWorkingDays counts the scheduled working days between startDate and endDate,
+// schedules must be ordered by effective_from descending and days without a schedule in effect count as working days
+func expectedWorkingDays(schedules []schedule.EmployeeSchedule, startDate, endDate time.Time) int {
+    workingDays := 0
+    for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+        employeeSchedule := schedule.EmployeeSchedule{}
+        for _, es := range schedules {
+            if es.Covers(date) {
+                employeeSchedule = es
+                break
+            }
+        }
+        if employeeSchedule.IsWorkingDay(date) {
+            workingDays++
+        }
+    }
+    return workingDays
+}
@@ -7,19 +7,29 @@ import (
 	"errors"
 	"payslip-generation-system/internal/entity/attendance"
 	"payslip-generation-system/internal/entity/audit"
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/entity/overtime"
 	"payslip-generation-system/internal/entity/payslip"
+	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/entity/schedule"
+	usermodel "payslip-generation-system/internal/entity/user"
+	mockrateprovider "payslip-generation-system/internal/exchangerate/mock"
 	attrepo "payslip-generation-system/internal/repositories/attendance"
 	mockattrepo "payslip-generation-system/internal/repositories/attendance/mock"
+	mockbankrepo "payslip-generation-system/internal/repositories/bankaccount/mock"
 	ovttrepo "payslip-generation-system/internal/repositories/overtime"
 	mockovttrepo "payslip-generation-system/internal/repositories/overtime/mock"
 	payrepo "payslip-generation-system/internal/repositories/payslip"
 	mockpayrepo "payslip-generation-system/internal/repositories/payslip/mock"
 	rmbrepo "payslip-generation-system/internal/repositories/reimbursement"
 	mockrmbrepo "payslip-generation-system/internal/repositories/reimbursement/mock"
+	schedrepo "payslip-generation-system/internal/repositories/schedule"
+	mockschedrepo "payslip-generation-system/internal/repositories/schedule/mock"
 	userepo "payslip-generation-system/internal/repositories/user"
 	mockuserepo "payslip-generation-system/internal/repositories/user/mock"
 	audsvc "payslip-generation-system/internal/services/audit"
 	mockaudsvc "payslip-generation-system/internal/services/audit/mock"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,6 +47,9 @@ func TestNewAdminService(t *testing.T) {
 	mockRmbRepo :=mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
 	mockAudSvc :=mockaudsvc.NewMockAuditServiceProvider(ctrl)
 	mockUserRepo:= mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockClockPolicy := attendance.ClockPolicy{MinWorkDuration: 8 * time.Hour}
+	mockOvertimePolicy := overtime.Policy{MaxHours: map[string]int{overtime.TypeRegular: 3}}
 
 	type args struct {
 		attrepo attrepo.AttendanceRepositoryProvider
@@ -44,7 +57,10 @@ func TestNewAdminService(t *testing.T) {
 		rmbrepo rmbrepo.ReimbursementRepositoryProvider
 		ovtrepo ovttrepo.OvertimeRepositoryProvider
 		userepo userepo.UserRepositoryProvider
+		schedrepo schedrepo.ScheduleRepositoryProvider
 		audsvc audsvc.AuditServiceProvider
+		clockPolicy attendance.ClockPolicy
+		overtimePolicy overtime.Policy
 	}
 	tests := []struct {
 		name string
@@ -59,7 +75,10 @@ func TestNewAdminService(t *testing.T) {
 				rmbrepo: mockRmbRepo,
 				ovtrepo: mockOvtRepo,
 				userepo: mockUserRepo,
+				schedrepo: mockSchedRepo,
 				audsvc: mockAudSvc,
+				clockPolicy: mockClockPolicy,
+				overtimePolicy: mockOvertimePolicy,
 			},
 			want: &adminService{
 				attrepo: mockAttRepo,
@@ -67,14 +86,17 @@ func TestNewAdminService(t *testing.T) {
 				rmbrepo: mockRmbRepo,
 				ovtrepo: mockOvtRepo,
 				userepo: mockUserRepo,
+				schedrepo: mockSchedRepo,
 				audsvc: mockAudSvc,
+				clockPolicy: mockClockPolicy,
+				overtimePolicy: mockOvertimePolicy,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := NewAdminService(tt.args.attrepo, tt.args.payrepo, tt.args.rmbrepo, tt.args.ovtrepo, tt.args.userepo, tt.args.audsvc)
-			assert.Equal(t, got, tt.want, "NewAdminService() = %v, want %v", got, tt.want)
+			got := NewAdminService(tt.args.attrepo, tt.args.payrepo, tt.args.rmbrepo, tt.args.ovtrepo, tt.args.userepo, tt.args.schedrepo, tt.args.audsvc, tt.args.clockPolicy, tt.args.overtimePolicy, nil, nil)
+			assert.Equal(t, got, tt.want, "NewAdminService() = %v, want %v", got, tt.want, nil)
 		})
 	}
 }
@@ -203,7 +225,7 @@ func Test_adminService_AddPeriod(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
 
-			s := NewAdminService(mockAttRepo, nil, nil, nil, nil, mockAudSvc)
+			s := NewAdminService(mockAttRepo, nil, nil, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
 
 			got, err := s.AddPeriod(tt.args.ctx, tt.args.attendancePeriod, tt.args.userID, tt.args.requestID)
 			
@@ -223,11 +245,20 @@ func Test_adminService_RunPayroll(t *testing.T) {
 
 	mockPayRepo := mockpayrepo.NewMockPayslipRepositoryProvider(ctrl)
 	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
 	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
 
 	mockPeriodID := 202506
 	mockUserID := 1
 	mockRequestID := 101
+	mockClockPolicy := attendance.ClockPolicy{MinWorkDuration: 8 * time.Hour}
+	mockOvertimePolicy := overtime.Policy{RatePercent: map[string]int{
+		overtime.TypeRegular:       100,
+		overtime.TypeRestDay:       100,
+		overtime.TypePublicHoliday: 100,
+	}}
+	mockMinWorkedMinutes := 480
 
 	mockStartDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
 	mockEndDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
@@ -239,14 +270,19 @@ func Test_adminService_RunPayroll(t *testing.T) {
 	}
 
 	mockSummaries := []attendance.EmployeeAttendanceSummary{
-		{UserID: 10, BaseSalary: 3000000, PresentDays: 8, OvertimeHours: 60, ReimbursementTotal: 100000},
+		{UserID: 10, BaseSalary: 3000000, PresentDays: 8, OvertimeMinutes: 90},
 	}
 
+	mockReimbursementTotals := []reimbursement.CategoryTotal{
+		{UserID: 10, Category: reimbursement.CategoryMeal, Name: "Meal", Amount: 100000, ReimbursementIDs: []int{5}},
+	}
+	mockReimbursementTotal := 100000
+
 	// expected calculation
 	emp := mockSummaries[0]
 	expectedAttendanceAmount := (emp.PresentDays * emp.BaseSalary) / mockWorkingDays      
-	expectedOvertimeAmount := (emp.OvertimeHours * emp.BaseSalary) / mockWorkingDays   
-	expectedTakeHomePay := expectedAttendanceAmount + expectedOvertimeAmount + emp.ReimbursementTotal 
+	expectedOvertimeAmount := (emp.OvertimeMinutes * emp.BaseSalary) / (60 * mockWorkingDays)   
+	expectedTakeHomePay := expectedAttendanceAmount + expectedOvertimeAmount + mockReimbursementTotal
 
 	expectedPayslips := []payslip.Payslip{
 		{
@@ -256,10 +292,15 @@ func Test_adminService_RunPayroll(t *testing.T) {
 			WorkingDays:        mockWorkingDays,
 			PresentDays:        emp.PresentDays,
 			AttendanceAmount:   expectedAttendanceAmount,
-			OvertimeHours:      emp.OvertimeHours,
+			OvertimeHours:      1.5,
 			OvertimeAmount:     expectedOvertimeAmount,
-			ReimbursementTotal: emp.ReimbursementTotal,
+			ReimbursementTotal: mockReimbursementTotal,
 			TakeHomePay:        expectedTakeHomePay,
+			Items: []payslip.PayslipItem{
+				{Component: payslip.ComponentAttendance, Description: "Attendance (8 of 10 working days)", Amount: expectedAttendanceAmount},
+				{Component: payslip.ComponentOvertime, Description: "Overtime (1.5 hours)", Amount: expectedOvertimeAmount},
+				{Component: payslip.ComponentReimbursement, Category: reimbursement.CategoryMeal, Description: "Reimbursement - Meal", Amount: 100000, ReimbursementIDs: []int{5}},
+			},
 		},
 	}
 	expectedPayslipsJSON, _ := json.Marshal(expectedPayslips)
@@ -269,6 +310,56 @@ func Test_adminService_RunPayroll(t *testing.T) {
 		RequestID: sql.NullInt32{Valid: true, Int32: int32(mockRequestID)},
 	}
 
+	mockApprovedAmount := 100000
+	mockApprovedReimbursement := reimbursement.Reimbursement{
+		ID: 5, UserID: emp.UserID, PeriodID: mockPeriodID, Category: reimbursement.CategoryMeal,
+		RequestedAmount: 100000, Amount: 100000, Status: reimbursement.StatusApproved, ApprovedAmount: &mockApprovedAmount,
+	}
+	mockPaidReimbursement := mockApprovedReimbursement
+	mockPaidReimbursement.Status = reimbursement.StatusPaid
+	mockApprovedJSON, _ := json.Marshal(mockApprovedReimbursement)
+	mockPaidJSON, _ := json.Marshal(mockPaidReimbursement)
+	expectedPaidAuditLog := audit.AuditLog{
+		TableName: "reimbursements", RecordID: mockApprovedReimbursement.ID, Action: "UPDATE", OldData: mockApprovedJSON, NewData: mockPaidJSON,
+		ChangedBy: sql.NullInt32{Valid: true, Int32: int32(mockUserID)},
+		RequestID: sql.NullInt32{Valid: true, Int32: int32(mockRequestID)},
+	}
+
+	// 2025-06-02 is a Monday, a Mon-Fri shift has 7 working days between 2025-06-01 and 2025-06-10
+	mockSchedules := []schedule.EmployeeSchedule{
+		{
+			ID:            1,
+			UserID:        emp.UserID,
+			ShiftID:       1,
+			EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Shift: schedule.Shift{
+				ID:               1,
+				WorkPattern:      "1111100",
+				PatternStartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	mockScheduledWorkingDays := 7
+	expectedScheduledPayslips := []payslip.Payslip{
+		{
+			UserID:             emp.UserID,
+			PeriodID:           mockPeriodID,
+			BaseSalary:         emp.BaseSalary,
+			WorkingDays:        mockScheduledWorkingDays,
+			PresentDays:        emp.PresentDays,
+			AttendanceAmount:   (emp.PresentDays * emp.BaseSalary) / mockScheduledWorkingDays,
+			OvertimeHours:      1.5,
+			OvertimeAmount:     (emp.OvertimeMinutes * emp.BaseSalary) / (60 * mockScheduledWorkingDays),
+			ReimbursementTotal: mockReimbursementTotal,
+			TakeHomePay:        (emp.PresentDays*emp.BaseSalary)/mockScheduledWorkingDays + (emp.OvertimeMinutes*emp.BaseSalary)/(60*mockScheduledWorkingDays) + mockReimbursementTotal,
+			Items: []payslip.PayslipItem{
+				{Component: payslip.ComponentAttendance, Description: "Attendance (8 of 7 working days)", Amount: (emp.PresentDays * emp.BaseSalary) / mockScheduledWorkingDays},
+				{Component: payslip.ComponentOvertime, Description: "Overtime (1.5 hours)", Amount: (emp.OvertimeMinutes * emp.BaseSalary) / (60 * mockScheduledWorkingDays)},
+				{Component: payslip.ComponentReimbursement, Category: reimbursement.CategoryMeal, Description: "Reimbursement - Meal", Amount: 100000, ReimbursementIDs: []int{5}},
+			},
+		},
+	}
+
 	type args struct {
 		ctx       context.Context
 		periodID  int
@@ -287,14 +378,50 @@ func Test_adminService_RunPayroll(t *testing.T) {
 				gomock.InOrder(
 					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil),
 					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil),
-					mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID).Return(mockSummaries, nil),
+					mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil),
+					mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(mockSummaries, nil),
+					mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return(mockReimbursementTotals, nil),
+					mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusApproved, mockPeriodID).Return([]reimbursement.Reimbursement{mockApprovedReimbursement}, nil),
+					mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusPartiallyApproved, mockPeriodID).Return([]reimbursement.Reimbursement{}, nil),
 					mockPayRepo.EXPECT().BulkInsertPayslips(gomock.Any(), gomock.Eq(expectedPayslips)).Return(nil),
 					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Eq(expectedAuditLog)).Return(1, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Eq(expectedPaidAuditLog)).Return(2, nil),
+					mockPayRepo.EXPECT().QueuePayslipDeliveries(gomock.Any(), mockPeriodID).Return(nil),
+				)
+			},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Happy Path - Working Days From Schedule",
+			mock: func() {
+				gomock.InOrder(
+					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil),
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(mockSchedules, nil),
+					mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(mockSummaries, nil),
+					mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return(mockReimbursementTotals, nil),
+					mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusApproved, mockPeriodID).Return([]reimbursement.Reimbursement{mockApprovedReimbursement}, nil),
+					mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusPartiallyApproved, mockPeriodID).Return([]reimbursement.Reimbursement{}, nil),
+					mockPayRepo.EXPECT().BulkInsertPayslips(gomock.Any(), gomock.Eq(expectedScheduledPayslips)).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Eq(expectedPaidAuditLog)).Return(2, nil),
+					mockPayRepo.EXPECT().QueuePayslipDeliveries(gomock.Any(), mockPeriodID).Return(nil),
 				)
 			},
 			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Error - GetEmployeeSchedulesInRange failed",
+			mock: func() {
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil)
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil)
+				mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, errors.New("db error"))
+			},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
+			wantErr: assert.Error,
+		},
 		{
 			name: "Error - Payroll Already Exists",
 			mock: func() {
@@ -330,7 +457,33 @@ func Test_adminService_RunPayroll(t *testing.T) {
 			mock: func() {
 				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil)
 				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil)
-				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID).Return(nil, errors.New("db error"))
+				mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil)
+				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(nil, errors.New("db error"))
+			},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
+			wantErr: assert.Error,
+		},
+		{
+			name: "Error - GetReimbursementTotalsByCategory failed",
+			mock: func() {
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil)
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil)
+				mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil)
+				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(mockSummaries, nil)
+				mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return(nil, errors.New("db error"))
+			},
+			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
+			wantErr: assert.Error,
+		},
+		{
+			name: "Error - Reimbursement Changed While Running",
+			mock: func() {
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil)
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil)
+				mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil)
+				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(mockSummaries, nil)
+				mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return(mockReimbursementTotals, nil)
+				mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), gomock.Any(), mockPeriodID).Return([]reimbursement.Reimbursement{}, nil).Times(2)
 			},
 			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
 			wantErr: assert.Error,
@@ -340,7 +493,11 @@ func Test_adminService_RunPayroll(t *testing.T) {
 			mock: func() {
 				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil)
 				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil)
-				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID).Return(mockSummaries, nil)
+				mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil)
+				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(mockSummaries, nil)
+				mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return(mockReimbursementTotals, nil)
+				mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusApproved, mockPeriodID).Return([]reimbursement.Reimbursement{mockApprovedReimbursement}, nil)
+				mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusPartiallyApproved, mockPeriodID).Return([]reimbursement.Reimbursement{}, nil)
 				mockPayRepo.EXPECT().BulkInsertPayslips(gomock.Any(), gomock.Eq(expectedPayslips)).Return(errors.New("bulk insert error"))
 			},
 			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
@@ -351,7 +508,11 @@ func Test_adminService_RunPayroll(t *testing.T) {
 			mock: func() {
 				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil)
 				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil)
-				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID).Return(mockSummaries, nil)
+				mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil)
+				mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return(mockSummaries, nil)
+				mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return(mockReimbursementTotals, nil)
+				mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusApproved, mockPeriodID).Return([]reimbursement.Reimbursement{mockApprovedReimbursement}, nil)
+				mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), reimbursement.StatusPartiallyApproved, mockPeriodID).Return([]reimbursement.Reimbursement{}, nil)
 				mockPayRepo.EXPECT().BulkInsertPayslips(gomock.Any(), gomock.Eq(expectedPayslips)).Return(nil)
 				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Eq(expectedAuditLog)).Return(0, errors.New("audit error"))
 			},
@@ -364,11 +525,15 @@ func Test_adminService_RunPayroll(t *testing.T) {
 				gomock.InOrder(
 					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), mockPeriodID).Return(false, nil),
 					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), mockPeriodID).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeSchedulesInRange(gomock.Any(), mockStartDate, mockEndDate).Return(nil, nil),
 
-					mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID).Return([]attendance.EmployeeAttendanceSummary{}, nil),
+					mockAttRepo.EXPECT().GetEmployeeAttendanceSummary(gomock.Any(), mockPeriodID, mockMinWorkedMinutes).Return([]attendance.EmployeeAttendanceSummary{}, nil),
+					mockRmbRepo.EXPECT().GetReimbursementTotalsByCategory(gomock.Any(), mockPeriodID).Return([]reimbursement.CategoryTotal{}, nil),
+					mockRmbRepo.EXPECT().GetReimbursementsByStatus(gomock.Any(), gomock.Any(), mockPeriodID).Return([]reimbursement.Reimbursement{}, nil).Times(2),
 
 					mockPayRepo.EXPECT().BulkInsertPayslips(gomock.Any(), gomock.Eq([]payslip.Payslip{})).Return(nil),
 					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+					mockPayRepo.EXPECT().QueuePayslipDeliveries(gomock.Any(), mockPeriodID).Return(nil),
 				)
 			},
 			args:    args{ctx: context.Background(), periodID: mockPeriodID, userID: mockUserID, requestID: mockRequestID},
@@ -378,7 +543,7 @@ func Test_adminService_RunPayroll(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
-			s := NewAdminService(mockAttRepo, mockPayRepo, nil, nil, nil, mockAudSvc)
+			s := NewAdminService(mockAttRepo, mockPayRepo, mockRmbRepo, nil, nil, mockSchedRepo, mockAudSvc, mockClockPolicy, mockOvertimePolicy, nil, nil)
 			err := s.RunPayroll(tt.args.ctx, tt.args.periodID, tt.args.userID, tt.args.requestID)
 			tt.wantErr(t, err)
 		})
@@ -446,7 +611,7 @@ func Test_adminService_GetPayslipSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
 
-			s := NewAdminService(nil, mockPayRepo, nil, nil, nil, nil)
+			s := NewAdminService(nil, mockPayRepo, nil, nil, nil, nil, nil, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
 
 			got, err := s.GetPayslipSummary(tt.args.ctx, tt.args.periodID)
 
@@ -458,4 +623,1675 @@ func Test_adminService_GetPayslipSummary(t *testing.T) {
 			assert.Equal(t, tt.want, got)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func Test_adminService_AddShift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	mockUserID := 1
+	mockRequestID := 99
+	validShift := schedule.Shift{
+		Name:             "Tue-Sat",
+		WorkPattern:      "0111110",
+		PatternStartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		StartTime:        "08:00",
+	}
+	validShiftJSON, _ := json.Marshal(validShift)
+
+	type args struct {
+		ctx       context.Context
+		shift     schedule.Shift
+		userID    int
+		requestID int
+	}
+	tests := []struct {
+		name    string
+		mock    func()
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - Success",
+			mock: func() {
+				mockSchedRepo.EXPECT().InsertShift(gomock.Any(), validShift).Return(3, nil)
+				mockAudSvc.EXPECT().
+					RecordAuditLog(gomock.Any(), gomock.Eq(audit.AuditLog{
+						TableName: "shifts",
+						RecordID:  3,
+						Action:    "CREATE",
+						OldData:   []byte("{}"),
+						NewData:   validShiftJSON,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: int32(mockUserID)},
+						RequestID: sql.NullInt32{Valid: true, Int32: int32(mockRequestID)},
+					})).
+					Return(1, nil)
+			},
+			args:    args{ctx: context.Background(), shift: validShift, userID: mockUserID, requestID: mockRequestID},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name: "Error - Invalid Work Pattern",
+			mock: func() {},
+			args: args{
+				ctx: context.Background(),
+				shift: schedule.Shift{
+					Name:             "Broken",
+					WorkPattern:      "11x1100",
+					PatternStartDate: validShift.PatternStartDate,
+					StartTime:        "08:00",
+				},
+				userID:    mockUserID,
+				requestID: mockRequestID,
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Error - Invalid Start Time",
+			mock: func() {},
+			args: args{
+				ctx: context.Background(),
+				shift: schedule.Shift{
+					Name:             "Broken",
+					WorkPattern:      "1111100",
+					PatternStartDate: validShift.PatternStartDate,
+					StartTime:        "8 am",
+				},
+				userID:    mockUserID,
+				requestID: mockRequestID,
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Error - InsertShift failed",
+			mock: func() {
+				mockSchedRepo.EXPECT().InsertShift(gomock.Any(), validShift).Return(0, errors.New("duplicate name"))
+			},
+			args:    args{ctx: context.Background(), shift: validShift, userID: mockUserID, requestID: mockRequestID},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, nil, mockSchedRepo, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.AddShift(tt.args.ctx, tt.args.shift, tt.args.userID, tt.args.requestID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_adminService_AssignSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	mockUserID := 1
+	mockRequestID := 99
+	effectiveTo := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+	validSchedule := schedule.EmployeeSchedule{
+		UserID:        10,
+		ShiftID:       3,
+		EffectiveFrom: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	type args struct {
+		ctx              context.Context
+		employeeSchedule schedule.EmployeeSchedule
+	}
+	tests := []struct {
+		name    string
+		mock    func()
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - Success",
+			mock: func() {
+				mockSchedRepo.EXPECT().GetShiftByID(gomock.Any(), validSchedule.ShiftID).Return(schedule.Shift{ID: validSchedule.ShiftID}, nil)
+				mockSchedRepo.EXPECT().InsertEmployeeSchedule(gomock.Any(), validSchedule).Return(5, nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			args:    args{ctx: context.Background(), employeeSchedule: validSchedule},
+			want:    5,
+			wantErr: false,
+		},
+		{
+			name: "Error - Effective To Before Effective From",
+			mock: func() {},
+			args: args{
+				ctx: context.Background(),
+				employeeSchedule: schedule.EmployeeSchedule{
+					UserID:        validSchedule.UserID,
+					ShiftID:       validSchedule.ShiftID,
+					EffectiveFrom: validSchedule.EffectiveFrom,
+					EffectiveTo:   &effectiveTo,
+				},
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Error - Shift Not Found",
+			mock: func() {
+				mockSchedRepo.EXPECT().GetShiftByID(gomock.Any(), validSchedule.ShiftID).Return(schedule.Shift{}, nil)
+			},
+			args:    args{ctx: context.Background(), employeeSchedule: validSchedule},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Error - RecordAuditLog failed",
+			mock: func() {
+				mockSchedRepo.EXPECT().GetShiftByID(gomock.Any(), validSchedule.ShiftID).Return(schedule.Shift{ID: validSchedule.ShiftID}, nil)
+				mockSchedRepo.EXPECT().InsertEmployeeSchedule(gomock.Any(), validSchedule).Return(5, nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(0, errors.New("audit service down"))
+			},
+			args:    args{ctx: context.Background(), employeeSchedule: validSchedule},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, nil, mockSchedRepo, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.AssignSchedule(tt.args.ctx, tt.args.employeeSchedule, mockUserID, mockRequestID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_adminService_ImportAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockPayRepo := mockpayrepo.NewMockPayslipRepositoryProvider(ctrl)
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	loc := time.FixedZone("WIB", 7*60*60)
+	mockClockPolicy := attendance.ClockPolicy{
+		ShiftStart:       9 * time.Hour,
+		MinWorkDuration:  8 * time.Hour,
+		MaxShiftDuration: 16 * time.Hour,
+		Location:         loc,
+	}
+	mockUserID := 1
+	mockRequestID := 99
+	mockPeriod := attendance.AttendancePeriod{
+		ID:        1,
+		StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+	mockEmployees := []usermodel.User{{ID: 11, Username: "alice"}, {ID: 12, Username: "bob"}}
+
+	clockIn := time.Date(2025, 6, 3, 9, 15, 0, 0, loc)
+	clockOut := time.Date(2025, 6, 3, 17, 45, 0, 0, loc)
+	wantImported := []attendance.Attendance{
+		{ID: 100, UserID: 11, PeriodID: 1, Date: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 101, UserID: 12, PeriodID: 1, Date: time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC), ClockIn: &clockIn, ClockOut: &clockOut, WorkedMinutes: 510, LateMinutes: 15},
+	}
+	wantImportedJSON, _ := json.Marshal(wantImported)
+
+	csvFile := "user,date,clock_in,clock_out\n" +
+		"alice,2025-06-02,,\n" +
+		"12,2025-06-03,09:15,17:45\n" +
+		"carol,2025-06-03,,\n" +
+		"alice,2025-07-01,,\n" +
+		"alice,2025-06-02,,\n"
+
+	type args struct {
+		format string
+		file   string
+	}
+	tests := []struct {
+		name    string
+		mock    func()
+		args    args
+		want    attendance.ImportReport
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - Valid Rows Imported And Invalid Rows Reported",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 1).Return(mockPeriod, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 1).Return(false, nil)
+				mockUserRepo.EXPECT().GetAllEmployees(gomock.Any()).Return(mockEmployees, nil)
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 11, 1, wantImported[0].Date).Return(attendance.Attendance{}, nil)
+				mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 11, wantImported[0].Date).Return(schedule.EmployeeSchedule{}, nil)
+				mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), gomock.Any()).Return(100, nil)
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 12, 1, wantImported[1].Date).Return(attendance.Attendance{}, nil)
+				mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 12, wantImported[1].Date).Return(schedule.EmployeeSchedule{}, nil)
+				mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), gomock.Any()).Return(101, nil)
+				mockAudSvc.EXPECT().
+					RecordAuditLog(gomock.Any(), gomock.Eq(audit.AuditLog{
+						TableName: "attendances",
+						RecordID:  0,
+						Action:    "CREATE",
+						OldData:   []byte("{}"),
+						NewData:   wantImportedJSON,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: int32(mockUserID)},
+						RequestID: sql.NullInt32{Valid: true, Int32: int32(mockRequestID)},
+					})).
+					Return(1, nil)
+			},
+			args: args{format: attendance.ImportFormatCSV, file: csvFile},
+			want: attendance.ImportReport{
+				TotalRows: 5,
+				Imported:  2,
+				Failed:    3,
+				Errors: []attendance.ImportRowError{
+					{Row: 4, User: "carol", Date: "2025-06-03", Error: "employee not found"},
+					{Row: 5, User: "alice", Date: "2025-07-01", Error: "date must be between 2025-06-01 and 2025-06-30"},
+					{Row: 6, User: "alice", Date: "2025-06-02", Error: "attendance is listed more than once in the file"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Nothing Imported - No Audit Log",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 1).Return(mockPeriod, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 1).Return(false, nil)
+				mockUserRepo.EXPECT().GetAllEmployees(gomock.Any()).Return(mockEmployees, nil)
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 11, 1, wantImported[0].Date).Return(attendance.Attendance{ID: 7}, nil)
+			},
+			args: args{format: attendance.ImportFormatCSV, file: "user,date\nalice,2025-06-02\n"},
+			want: attendance.ImportReport{
+				TotalRows: 1,
+				Failed:    1,
+				Errors: []attendance.ImportRowError{
+					{Row: 2, User: "alice", Date: "2025-06-02", Error: "attendance already exists"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error - Payroll Already Generated",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 1).Return(mockPeriod, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 1).Return(true, nil)
+			},
+			args:    args{format: attendance.ImportFormatCSV, file: csvFile},
+			want:    attendance.ImportReport{},
+			wantErr: true,
+		},
+		{
+			name: "Error - Unknown Format",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 1).Return(mockPeriod, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 1).Return(false, nil)
+			},
+			args:    args{format: "xlsx", file: csvFile},
+			want:    attendance.ImportReport{},
+			wantErr: true,
+		},
+		{
+			name: "Error - Period Not Found",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 1).Return(attendance.AttendancePeriod{}, nil)
+			},
+			args:    args{format: attendance.ImportFormatCSV, file: csvFile},
+			want:    attendance.ImportReport{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(mockAttRepo, mockPayRepo, nil, nil, mockUserRepo, mockSchedRepo, mockAudSvc, mockClockPolicy, overtime.Policy{}, nil, nil)
+
+			got, err := s.ImportAttendance(context.Background(), 1, tt.args.format, strings.NewReader(tt.args.file), mockUserID, mockRequestID)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_adminService_ReviewAttendanceCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockPayRepo := mockpayrepo.NewMockPayslipRepositoryProvider(ctrl)
+	mockOvtRepo := mockovttrepo.NewMockOvertimeRepositoryProvider(ctrl)
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	mockUserID := 1
+	mockRequestID := 99
+	mockDate := time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC)
+	addCorrection := attendance.AttendanceCorrection{
+		ID:       3,
+		UserID:   10,
+		PeriodID: 2,
+		Date:     mockDate,
+		Action:   attendance.CorrectionActionAdd,
+		Reason:   "forgot to clock in",
+		Status:   attendance.CorrectionStatusPending,
+	}
+	removeCorrection := addCorrection
+	removeCorrection.Action = attendance.CorrectionActionRemove
+	existingAttendance := attendance.Attendance{ID: 40, UserID: 10, PeriodID: 2, Date: mockDate}
+	existingAttendanceJSON, _ := json.Marshal(existingAttendance)
+	addedAttendanceJSON, _ := json.Marshal(attendance.Attendance{ID: 41, UserID: 10, PeriodID: 2, Date: mockDate})
+
+	type args struct {
+		status string
+	}
+	tests := []struct {
+		name       string
+		mock       func()
+		args       args
+		wantStatus string
+		wantErr    bool
+	}{
+		{
+			name: "Happy Path - Approve Add Creates Attendance",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendanceCorrectionByID(gomock.Any(), 3).Return(addCorrection, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 2, mockDate).Return(attendance.Attendance{}, nil)
+				mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, mockDate).Return(schedule.EmployeeSchedule{}, nil)
+				mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), attendance.Attendance{UserID: 10, PeriodID: 2, Date: mockDate}).Return(41, nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Eq(audit.AuditLog{
+					TableName: "attendances",
+					RecordID:  41,
+					Action:    "CREATE",
+					OldData:   []byte("{}"),
+					NewData:   addedAttendanceJSON,
+					ChangedBy: sql.NullInt32{Valid: true, Int32: int32(mockUserID)},
+					RequestID: sql.NullInt32{Valid: true, Int32: int32(mockRequestID)},
+				})).Return(1, nil)
+				mockAttRepo.EXPECT().UpdateAttendanceCorrectionReview(gomock.Any(), gomock.Any()).Return(nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(2, nil)
+			},
+			args:       args{status: attendance.CorrectionStatusApproved},
+			wantStatus: attendance.CorrectionStatusApproved,
+			wantErr:    false,
+		},
+		{
+			name: "Happy Path - Approve Remove Deletes Attendance",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendanceCorrectionByID(gomock.Any(), 3).Return(removeCorrection, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 2, mockDate).Return(existingAttendance, nil)
+				mockOvtRepo.EXPECT().GetOvertime(gomock.Any(), 10, 2, mockDate).Return(overtime.Overtime{}, nil)
+				mockAttRepo.EXPECT().DeleteAttendance(gomock.Any(), 40).Return(nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Eq(audit.AuditLog{
+					TableName: "attendances",
+					RecordID:  40,
+					Action:    "DELETE",
+					OldData:   existingAttendanceJSON,
+					NewData:   []byte("{}"),
+					ChangedBy: sql.NullInt32{Valid: true, Int32: int32(mockUserID)},
+					RequestID: sql.NullInt32{Valid: true, Int32: int32(mockRequestID)},
+				})).Return(1, nil)
+				mockAttRepo.EXPECT().UpdateAttendanceCorrectionReview(gomock.Any(), gomock.Any()).Return(nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(2, nil)
+			},
+			args:       args{status: attendance.CorrectionStatusApproved},
+			wantStatus: attendance.CorrectionStatusApproved,
+			wantErr:    false,
+		},
+		{
+			name: "Happy Path - Reject Leaves Attendance Untouched",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendanceCorrectionByID(gomock.Any(), 3).Return(addCorrection, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockAttRepo.EXPECT().UpdateAttendanceCorrectionReview(gomock.Any(), gomock.Any()).Return(nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(2, nil)
+			},
+			args:       args{status: attendance.CorrectionStatusRejected},
+			wantStatus: attendance.CorrectionStatusRejected,
+			wantErr:    false,
+		},
+		{
+			name: "Error - Remove Blocked By Overtime",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendanceCorrectionByID(gomock.Any(), 3).Return(removeCorrection, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 2, mockDate).Return(existingAttendance, nil)
+				mockOvtRepo.EXPECT().GetOvertime(gomock.Any(), 10, 2, mockDate).Return(overtime.Overtime{ID: 8}, nil)
+			},
+			args:    args{status: attendance.CorrectionStatusApproved},
+			wantErr: true,
+		},
+		{
+			name: "Error - Period Already Processed",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendanceCorrectionByID(gomock.Any(), 3).Return(addCorrection, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(true, nil)
+			},
+			args:    args{status: attendance.CorrectionStatusApproved},
+			wantErr: true,
+		},
+		{
+			name: "Error - Already Reviewed",
+			mock: func() {
+				reviewed := addCorrection
+				reviewed.Status = attendance.CorrectionStatusRejected
+				mockAttRepo.EXPECT().GetAttendanceCorrectionByID(gomock.Any(), 3).Return(reviewed, nil)
+			},
+			args:    args{status: attendance.CorrectionStatusApproved},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid Status",
+			mock:    func() {},
+			args:    args{status: "maybe"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(mockAttRepo, mockPayRepo, nil, mockOvtRepo, nil, mockSchedRepo, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.ReviewAttendanceCorrection(context.Background(), 3, tt.args.status, "checked", mockUserID, mockRequestID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, &mockUserID, got.ReviewedBy)
+			assert.NotNil(t, got.ReviewedAt)
+		})
+	}
+}
+
+func Test_adminService_ReviewOvertimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvtRepo := mockovttrepo.NewMockOvertimeRepositoryProvider(ctrl)
+	mockPayRepo := mockpayrepo.NewMockPayslipRepositoryProvider(ctrl)
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	managerID := 5
+	otherManagerID := 6
+	mockRequestID := 99
+	pendingOvertimes := []overtime.Overtime{
+		{ID: 1, UserID: 10, PeriodID: 2, Date: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), Minutes: 120, Status: overtime.StatusPending},
+		{ID: 2, UserID: 11, PeriodID: 2, Date: time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC), Minutes: 180, Status: overtime.StatusPending},
+	}
+
+	type args struct {
+		overtimeIDs []int
+		status      string
+		reviewerID  int
+		isAdmin     bool
+	}
+	tests := []struct {
+		name      string
+		mock      func()
+		args      args
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name: "Happy Path - Admin Bulk Approve",
+			mock: func() {
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1, 2}).Return(pendingOvertimes, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil).Times(1)
+				mockOvtRepo.EXPECT().UpdateOvertimeReviews(gomock.Any(), gomock.Any()).Return(nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil).Times(2)
+			},
+			args:      args{overtimeIDs: []int{1, 2}, status: overtime.StatusApproved, reviewerID: 1, isAdmin: true},
+			wantCount: 2,
+			wantErr:   false,
+		},
+		{
+			name: "Happy Path - Manager Rejects Direct Report",
+			mock: func() {
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1}).Return(pendingOvertimes[:1], nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 10).Return(usermodel.User{ID: 10, ManagerID: &managerID}, nil)
+				mockOvtRepo.EXPECT().UpdateOvertimeReviews(gomock.Any(), gomock.Any()).Return(nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			args:      args{overtimeIDs: []int{1}, status: overtime.StatusRejected, reviewerID: managerID},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "Error - Overtime Withdrawn During Review",
+			mock: func() {
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1, 2}).Return(pendingOvertimes, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockOvtRepo.EXPECT().UpdateOvertimeReviews(gomock.Any(), gomock.Any()).Return(errors.New("overtime 2 is no longer pending"))
+			},
+			args:    args{overtimeIDs: []int{1, 2}, status: overtime.StatusApproved, reviewerID: 1, isAdmin: true},
+			wantErr: true,
+		},
+		{
+			name: "Error - Manager Reviews Someone Else's Report",
+			mock: func() {
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1}).Return(pendingOvertimes[:1], nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(false, nil)
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 10).Return(usermodel.User{ID: 10, ManagerID: &otherManagerID}, nil)
+			},
+			args:    args{overtimeIDs: []int{1}, status: overtime.StatusApproved, reviewerID: managerID},
+			wantErr: true,
+		},
+		{
+			name: "Error - Overtime Not Found",
+			mock: func() {
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1, 3}).Return(pendingOvertimes[:1], nil)
+			},
+			args:    args{overtimeIDs: []int{1, 3}, status: overtime.StatusApproved, reviewerID: 1, isAdmin: true},
+			wantErr: true,
+		},
+		{
+			name: "Error - Already Reviewed",
+			mock: func() {
+				approved := pendingOvertimes[0]
+				approved.Status = overtime.StatusApproved
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1}).Return([]overtime.Overtime{approved}, nil)
+			},
+			args:    args{overtimeIDs: []int{1}, status: overtime.StatusRejected, reviewerID: 1, isAdmin: true},
+			wantErr: true,
+		},
+		{
+			name: "Error - Period Already Processed",
+			mock: func() {
+				mockOvtRepo.EXPECT().GetOvertimesByIDs(gomock.Any(), []int{1}).Return(pendingOvertimes[:1], nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), 2).Return(true, nil)
+			},
+			args:    args{overtimeIDs: []int{1}, status: overtime.StatusApproved, reviewerID: 1, isAdmin: true},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid Status",
+			mock:    func() {},
+			args:    args{overtimeIDs: []int{1}, status: overtime.StatusPending, reviewerID: 1, isAdmin: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, mockPayRepo, nil, mockOvtRepo, mockUserRepo, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.ReviewOvertimes(context.Background(), tt.args.overtimeIDs, tt.args.status, "", tt.args.reviewerID, tt.args.isAdmin, mockRequestID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got, tt.wantCount)
+			for _, ot := range got {
+				assert.Equal(t, tt.args.status, ot.Status)
+				assert.Equal(t, tt.args.reviewerID, *ot.ReviewedBy)
+			}
+		})
+	}
+}
+
+func Test_adminService_overtimeAmount(t *testing.T) {
+	s := &adminService{
+		overtimePolicy: overtime.Policy{RatePercent: map[string]int{
+			overtime.TypeRegular:       100,
+			overtime.TypeRestDay:       200,
+			overtime.TypePublicHoliday: 300,
+		}},
+	}
+	employee := attendance.EmployeeAttendanceSummary{
+		BaseSalary:                   2000000,
+		OvertimeMinutes:              810,
+		RestDayOvertimeMinutes:       480,
+		PublicHolidayOvertimeMinutes: 150,
+	}
+
+	// (3h*100 + 8h*200 + 2.5h*300) / 100 = 26.5 weighted hours at 2.000.000 / 20 days
+	assert.Equal(t, 2650000, s.overtimeAmount(employee, 20))
+}
+
+func Test_adminService_AddPublicHoliday(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	mockHoliday := schedule.PublicHoliday{
+		Date: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+		Name: "Independence Day",
+	}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		holiday schedule.PublicHoliday
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - Success",
+			mock: func() {
+				mockSchedRepo.EXPECT().GetPublicHolidayByDate(gomock.Any(), mockHoliday.Date).Return(schedule.PublicHoliday{}, nil)
+				mockSchedRepo.EXPECT().InsertPublicHoliday(gomock.Any(), mockHoliday).Return(4, nil)
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			holiday: mockHoliday,
+			want:    4,
+			wantErr: false,
+		},
+		{
+			name: "Error - Date Already A Holiday",
+			mock: func() {
+				mockSchedRepo.EXPECT().GetPublicHolidayByDate(gomock.Any(), mockHoliday.Date).Return(schedule.PublicHoliday{ID: 2, Name: "Other"}, nil)
+			},
+			holiday: mockHoliday,
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "Error - Missing Name",
+			mock:    func() {},
+			holiday: schedule.PublicHoliday{Date: mockHoliday.Date},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, nil, mockSchedRepo, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.AddPublicHoliday(context.Background(), tt.holiday, 1, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_adminService_UpdateReimbursementCategory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	mockCreatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	existingCategory := reimbursement.Category{
+		ID:              3,
+		Code:            reimbursement.CategoryMeal,
+		Name:            "Meal",
+		PerClaimLimit:   100000,
+		PerPeriodLimit:  1000000,
+		EligibleGrades:  []string{},
+		OverLimitAction: reimbursement.OverLimitPartial,
+		CreatedAt:       mockCreatedAt,
+	}
+	update := reimbursement.Category{
+		Code:            reimbursement.CategoryMeal,
+		PerClaimLimit:   150000,
+		PerPeriodLimit:  1500000,
+		EligibleGrades:  []string{"G1", "G2"},
+		OverLimitAction: reimbursement.OverLimitReject,
+	}
+	expectedCategory := update
+	expectedCategory.ID = existingCategory.ID
+	expectedCategory.Name = existingCategory.Name
+	expectedCategory.CreatedAt = mockCreatedAt
+
+	tests := []struct {
+		name     string
+		mock     func()
+		category reimbursement.Category
+		want     reimbursement.Category
+		wantErr  bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				oldJson, _ := json.Marshal(existingCategory)
+				newJson, _ := json.Marshal(expectedCategory)
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetCategoryByCode(gomock.Any(), reimbursement.CategoryMeal).Return(existingCategory, nil),
+					mockRmbRepo.EXPECT().UpdateCategory(gomock.Any(), expectedCategory).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "reimbursement_categories",
+						RecordID:  existingCategory.ID,
+						Action:    "UPDATE",
+						OldData:   oldJson,
+						NewData:   newJson,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			category: update,
+			want:     expectedCategory,
+			wantErr:  false,
+		},
+		{
+			name: "Error - Category Not Found",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetCategoryByCode(gomock.Any(), "gym").Return(reimbursement.Category{}, nil)
+			},
+			category: reimbursement.Category{Code: "gym", OverLimitAction: reimbursement.OverLimitReject},
+			want:     reimbursement.Category{Code: "gym", OverLimitAction: reimbursement.OverLimitReject},
+			wantErr:  true,
+		},
+		{
+			name:     "Error - Negative Limit",
+			mock:     func() {},
+			category: reimbursement.Category{Code: reimbursement.CategoryMeal, PerClaimLimit: -1, OverLimitAction: reimbursement.OverLimitReject},
+			want:     reimbursement.Category{Code: reimbursement.CategoryMeal, PerClaimLimit: -1, OverLimitAction: reimbursement.OverLimitReject},
+			wantErr:  true,
+		},
+		{
+			name:     "Error - Invalid Over Limit Action",
+			mock:     func() {},
+			category: reimbursement.Category{Code: reimbursement.CategoryMeal, OverLimitAction: "ignore"},
+			want:     reimbursement.Category{Code: reimbursement.CategoryMeal, OverLimitAction: "ignore"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.UpdateReimbursementCategory(context.Background(), tt.category, 1, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_adminService_ReviewReimbursement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+	mockPayRepo := mockpayrepo.NewMockPayslipRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	submitted := reimbursement.Reimbursement{
+		ID:              5,
+		UserID:          10,
+		PeriodID:        202506,
+		Category:        reimbursement.CategoryTravel,
+		RequestedAmount: 300000,
+		Amount:          300000,
+		Status:          reimbursement.StatusSubmitted,
+	}
+	approved := submitted
+	approved.Status = reimbursement.StatusApproved
+	onHold := submitted
+	onHold.Status = reimbursement.StatusOnHold
+	partialAmount := 200000
+	zeroAmount := 0
+
+	tests := []struct {
+		name           string
+		mock           func()
+		status         string
+		approvedAmount *int
+		notes          string
+		wantStatus     string
+		wantApproved   *int
+		wantErr        bool
+	}{
+		{
+			name: "Happy Path - Approved In Full",
+			mock: func() {
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(submitted, nil),
+					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(false, nil),
+					mockRmbRepo.EXPECT().UpdateReimbursementReview(gomock.Any(), gomock.Any()).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			status:       reimbursement.StatusApproved,
+			wantStatus:   reimbursement.StatusApproved,
+			wantApproved: &submitted.Amount,
+		},
+		{
+			name: "Happy Path - Partially Approved",
+			mock: func() {
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(submitted, nil),
+					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(false, nil),
+					mockRmbRepo.EXPECT().UpdateReimbursementReview(gomock.Any(), gomock.Any()).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			status:         reimbursement.StatusApproved,
+			approvedAmount: &partialAmount,
+			notes:          "return trip is not covered",
+			wantStatus:     reimbursement.StatusPartiallyApproved,
+			wantApproved:   &partialAmount,
+		},
+		{
+			name: "Happy Path - Rejected",
+			mock: func() {
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(submitted, nil),
+					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(false, nil),
+					mockRmbRepo.EXPECT().UpdateReimbursementReview(gomock.Any(), gomock.Any()).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			status:     reimbursement.StatusRejected,
+			notes:      "receipt is unreadable",
+			wantStatus: reimbursement.StatusRejected,
+		},
+		{
+			name: "Happy Path - Held Duplicate Rejected",
+			mock: func() {
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(onHold, nil),
+					mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(false, nil),
+					mockRmbRepo.EXPECT().UpdateReimbursementReview(gomock.Any(), gomock.Any()).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			status:     reimbursement.StatusRejected,
+			notes:      "same receipt was paid in May",
+			wantStatus: reimbursement.StatusRejected,
+		},
+		{
+			name: "Error - Partial Approval Without Notes",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(submitted, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(false, nil)
+			},
+			status:         reimbursement.StatusApproved,
+			approvedAmount: &partialAmount,
+			wantErr:        true,
+		},
+		{
+			name: "Error - Approved Amount Out Of Range",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(submitted, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(false, nil)
+			},
+			status:         reimbursement.StatusApproved,
+			approvedAmount: &zeroAmount,
+			wantErr:        true,
+		},
+		{
+			name: "Error - Already Reviewed",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(approved, nil)
+			},
+			status:  reimbursement.StatusRejected,
+			notes:   "duplicate",
+			wantErr: true,
+		},
+		{
+			name: "Error - Period Already Processed",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetReimbursementByID(gomock.Any(), submitted.ID).Return(submitted, nil)
+				mockPayRepo.EXPECT().PayslipExistsByPeriodID(gomock.Any(), submitted.PeriodID).Return(true, nil)
+			},
+			status:  reimbursement.StatusApproved,
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid Status",
+			mock:    func() {},
+			status:  reimbursement.StatusPaid,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, mockPayRepo, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.ReviewReimbursement(context.Background(), submitted.ID, tt.status, tt.approvedAmount, tt.notes, 1, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, got.Status)
+			assert.Equal(t, tt.wantApproved, got.ApprovedAmount)
+			assert.Equal(t, tt.notes, got.ReviewerNotes)
+			assert.NotNil(t, got.ReviewedAt)
+		})
+	}
+}
+
+func Test_adminService_GetDuplicateReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+
+	report := []reimbursement.DuplicateReportEntry{
+		{
+			DuplicateFlag: reimbursement.DuplicateFlag{ID: 1, ReimbursementID: 6, DuplicateOfID: 5, Reason: reimbursement.DuplicateReasonSameReceipt, Similarity: 1},
+			Claim:         reimbursement.ClaimSummary{ID: 6, UserID: 10, Status: reimbursement.StatusOnHold},
+			DuplicateOf:   reimbursement.ClaimSummary{ID: 5, UserID: 10, Status: reimbursement.StatusPaid},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mock     func()
+		status   string
+		periodID int
+		want     []reimbursement.DuplicateReportEntry
+		wantErr  bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetDuplicateReport(gomock.Any(), reimbursement.StatusOnHold, 202506).Return(report, nil)
+			},
+			status:   reimbursement.StatusOnHold,
+			periodID: 202506,
+			want:     report,
+		},
+		{
+			name: "Error - Repository",
+			mock: func() {
+				mockRmbRepo.EXPECT().GetDuplicateReport(gomock.Any(), "", 0).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown Status",
+			mock:    func() {},
+			status:  "pending",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, nil, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.GetDuplicateReport(context.Background(), tt.status, tt.periodID)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_adminService_AddExchangeRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	userID := 1
+	rateDate := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	existingRate := reimbursement.ExchangeRate{ID: 4, Currency: "USD", RateDate: rateDate, Rate: 16000, Source: reimbursement.RateSourceProvider}
+	olderRate := reimbursement.ExchangeRate{ID: 3, Currency: "USD", RateDate: rateDate.AddDate(0, 0, -1), Rate: 15900, Source: reimbursement.RateSourceManual}
+	expectedRate := reimbursement.ExchangeRate{ID: 4, Currency: "USD", RateDate: rateDate, Rate: 16250.5, Source: reimbursement.RateSourceManual, CreatedBy: &userID}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		rate    reimbursement.ExchangeRate
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - New Rate",
+			mock: func() {
+				newJson, _ := json.Marshal(expectedRate)
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetExchangeRate(gomock.Any(), "USD", rateDate).Return(olderRate, nil),
+					mockRmbRepo.EXPECT().UpsertExchangeRate(gomock.Any(), gomock.Any()).Return(4, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "exchange_rates",
+						RecordID:  4,
+						Action:    "CREATE",
+						OldData:   []byte("{}"),
+						NewData:   newJson,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			rate: reimbursement.ExchangeRate{Currency: "usd", RateDate: rateDate, Rate: 16250.5},
+		},
+		{
+			name: "Happy Path - Replaces Rate Of The Same Day",
+			mock: func() {
+				oldJson, _ := json.Marshal(existingRate)
+				newJson, _ := json.Marshal(expectedRate)
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetExchangeRate(gomock.Any(), "USD", rateDate).Return(existingRate, nil),
+					mockRmbRepo.EXPECT().UpsertExchangeRate(gomock.Any(), gomock.Any()).Return(4, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "exchange_rates",
+						RecordID:  4,
+						Action:    "UPDATE",
+						OldData:   oldJson,
+						NewData:   newJson,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			rate: reimbursement.ExchangeRate{Currency: "USD", RateDate: rateDate, Rate: 16250.5},
+		},
+		{
+			name:    "Error - Base Currency",
+			mock:    func() {},
+			rate:    reimbursement.ExchangeRate{Currency: "IDR", RateDate: rateDate, Rate: 1},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid Currency",
+			mock:    func() {},
+			rate:    reimbursement.ExchangeRate{Currency: "dollar", RateDate: rateDate, Rate: 16250.5},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Rate Not Positive",
+			mock:    func() {},
+			rate:    reimbursement.ExchangeRate{Currency: "USD", RateDate: rateDate, Rate: 0},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.AddExchangeRate(context.Background(), tt.rate, userID, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expectedRate, got)
+		})
+	}
+}
+
+func Test_adminService_AddReimbursementRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	userID := 1
+	effectiveFrom := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	defaultRate := reimbursement.Rate{ID: 2, Calculation: reimbursement.CalculationPerDiem, Rate: 300000, EffectiveFrom: effectiveFrom.AddDate(0, -6, 0)}
+	existingRate := reimbursement.Rate{ID: 5, Calculation: reimbursement.CalculationPerDiem, Destination: "Kuala Lumpur", Rate: 700000, EffectiveFrom: effectiveFrom}
+	expectedRate := reimbursement.Rate{ID: 5, Calculation: reimbursement.CalculationPerDiem, Destination: "Kuala Lumpur", Rate: 750000, EffectiveFrom: effectiveFrom, CreatedBy: &userID}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		rate    reimbursement.Rate
+		wantErr bool
+	}{
+		{
+			name: "Happy Path - New Destination",
+			mock: func() {
+				newJson, _ := json.Marshal(expectedRate)
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetRate(gomock.Any(), reimbursement.CalculationPerDiem, "Kuala Lumpur", effectiveFrom).Return(defaultRate, nil),
+					mockRmbRepo.EXPECT().UpsertRate(gomock.Any(), gomock.Any()).Return(5, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "reimbursement_rates",
+						RecordID:  5,
+						Action:    "CREATE",
+						OldData:   []byte("{}"),
+						NewData:   newJson,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			rate: reimbursement.Rate{Calculation: reimbursement.CalculationPerDiem, Destination: "  kuala LUMPUR ", Rate: 750000, EffectiveFrom: effectiveFrom},
+		},
+		{
+			name: "Happy Path - Replaces Rate Of The Same Day",
+			mock: func() {
+				oldJson, _ := json.Marshal(existingRate)
+				newJson, _ := json.Marshal(expectedRate)
+				gomock.InOrder(
+					mockRmbRepo.EXPECT().GetRate(gomock.Any(), reimbursement.CalculationPerDiem, "Kuala Lumpur", effectiveFrom).Return(existingRate, nil),
+					mockRmbRepo.EXPECT().UpsertRate(gomock.Any(), gomock.Any()).Return(5, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "reimbursement_rates",
+						RecordID:  5,
+						Action:    "UPDATE",
+						OldData:   oldJson,
+						NewData:   newJson,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			rate: reimbursement.Rate{Calculation: reimbursement.CalculationPerDiem, Destination: "Kuala Lumpur", Rate: 750000, EffectiveFrom: effectiveFrom},
+		},
+		{
+			name:    "Error - Mileage With Destination",
+			mock:    func() {},
+			rate:    reimbursement.Rate{Calculation: reimbursement.CalculationMileage, Destination: "Bandung", Rate: 2500, EffectiveFrom: effectiveFrom},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown Calculation",
+			mock:    func() {},
+			rate:    reimbursement.Rate{Calculation: "toll", Rate: 2500, EffectiveFrom: effectiveFrom},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Missing Effective Date",
+			mock:    func() {},
+			rate:    reimbursement.Rate{Calculation: reimbursement.CalculationMileage, Rate: 2500},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.AddReimbursementRate(context.Background(), tt.rate, userID, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expectedRate, got)
+		})
+	}
+}
+
+func Test_adminService_FetchExchangeRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+	mockProvider := mockrateprovider.NewMockProvider(ctrl)
+
+	userID := 1
+	rateDate := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	fetchedRate := reimbursement.ExchangeRate{Currency: "SGD", RateDate: rateDate, Rate: 12600, Source: reimbursement.RateSourceProvider}
+
+	t.Run("Happy Path", func(t *testing.T) {
+		gomock.InOrder(
+			mockProvider.EXPECT().GetRate(gomock.Any(), "SGD").Return(fetchedRate, nil),
+			mockRmbRepo.EXPECT().GetExchangeRate(gomock.Any(), "SGD", rateDate).Return(reimbursement.ExchangeRate{}, nil),
+			mockRmbRepo.EXPECT().UpsertExchangeRate(gomock.Any(), gomock.Any()).Return(7, nil),
+			mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+		)
+		s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, mockProvider, nil)
+
+		got, err := s.FetchExchangeRate(context.Background(), "sgd", userID, 99)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, got.ID)
+		assert.Equal(t, 12600.0, got.Rate)
+		assert.Equal(t, reimbursement.RateSourceProvider, got.Source)
+	})
+
+	t.Run("Error - Provider Failed", func(t *testing.T) {
+		mockProvider.EXPECT().GetRate(gomock.Any(), "SGD").Return(reimbursement.ExchangeRate{}, errors.New("provider down"))
+		s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, mockProvider, nil)
+
+		_, err := s.FetchExchangeRate(context.Background(), "SGD", userID, 99)
+		assert.Error(t, err)
+	})
+
+	t.Run("Error - No Provider Configured", func(t *testing.T) {
+		s := NewAdminService(nil, nil, mockRmbRepo, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+		_, err := s.FetchExchangeRate(context.Background(), "SGD", userID, 99)
+		assert.Error(t, err)
+	})
+}
+
+func Test_adminService_AddBankAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockBankRepo := mockbankrepo.NewMockBankAccountRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	now := time.Now()
+	effectiveDate := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 7)
+	employee := usermodel.User{ID: 3, Username: "budi"}
+	expectedAccount := bankaccount.BankAccount{
+		ID:            7,
+		UserID:        3,
+		BankCode:      "014",
+		AccountNumber: "1234567890",
+		AccountHolder: "BUDI SANTOSO",
+		IsPrimary:     true,
+		EffectiveDate: effectiveDate,
+		Status:        bankaccount.StatusPending,
+		RequestedBy:   1,
+	}
+	input := bankaccount.BankAccount{UserID: 3, BankCode: "014", AccountNumber: "123-456 7890", AccountHolder: " budi  santoso", IsPrimary: true, EffectiveDate: effectiveDate}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		account bankaccount.BankAccount
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				newJson, _ := json.Marshal(expectedAccount)
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockBankRepo.EXPECT().GetBankAccounts(gomock.Any(), 3, bankaccount.StatusPending).Return([]bankaccount.BankAccount{}, nil),
+					mockBankRepo.EXPECT().InsertBankAccount(gomock.Any(), gomock.Any()).Return(7, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "employee_bank_accounts",
+						RecordID:  7,
+						Action:    "CREATE",
+						OldData:   []byte("{}"),
+						NewData:   newJson,
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			account: input,
+		},
+		{
+			name: "Error - Already Waiting For Verification",
+			mock: func() {
+				pending := expectedAccount
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockBankRepo.EXPECT().GetBankAccounts(gomock.Any(), 3, bankaccount.StatusPending).Return([]bankaccount.BankAccount{pending}, nil),
+				)
+			},
+			account: input,
+			wantErr: true,
+		},
+		{
+			name: "Error - Wrong Length For The Bank",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil)
+			},
+			account: bankaccount.BankAccount{UserID: 3, BankCode: "008", AccountNumber: "1234567890", AccountHolder: "Budi", EffectiveDate: effectiveDate},
+			wantErr: true,
+		},
+		{
+			name: "Error - Effective Date In The Past",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil)
+			},
+			account: bankaccount.BankAccount{UserID: 3, BankCode: "014", AccountNumber: "1234567890", AccountHolder: "Budi", EffectiveDate: effectiveDate.AddDate(0, 0, -30)},
+			wantErr: true,
+		},
+		{
+			name: "Error - Not An Employee",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(usermodel.User{ID: 3, IsAdmin: true}, nil)
+			},
+			account: input,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, mockUserRepo, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, mockBankRepo)
+
+			got, err := s.AddBankAccount(context.Background(), tt.account, 1, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expectedAccount, got)
+		})
+	}
+}
+
+func Test_adminService_ReviewBankAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBankRepo := mockbankrepo.NewMockBankAccountRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	pending := bankaccount.BankAccount{
+		ID:            7,
+		UserID:        3,
+		BankCode:      "014",
+		AccountNumber: "1234567890",
+		AccountHolder: "BUDI SANTOSO",
+		IsPrimary:     true,
+		EffectiveDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		Status:        bankaccount.StatusPending,
+		RequestedBy:   1,
+	}
+
+	tests := []struct {
+		name     string
+		mock     func()
+		status   string
+		note     string
+		reviewer int
+		wantErr  bool
+	}{
+		{
+			name: "Happy Path - Verified By Another Admin",
+			mock: func() {
+				gomock.InOrder(
+					mockBankRepo.EXPECT().GetBankAccountByID(gomock.Any(), 7).Return(pending, nil),
+					mockBankRepo.EXPECT().UpdateBankAccountReview(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, account bankaccount.BankAccount) error {
+						assert.Equal(t, bankaccount.StatusVerified, account.Status)
+						assert.Equal(t, 2, *account.VerifiedBy)
+						assert.NotNil(t, account.VerifiedAt)
+						return nil
+					}),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log audit.AuditLog) (int, error) {
+						assert.Equal(t, "employee_bank_accounts", log.TableName)
+						assert.Equal(t, "UPDATE", log.Action)
+						assert.Equal(t, 7, log.RecordID)
+						return 1, nil
+					}),
+				)
+			},
+			status:   bankaccount.StatusVerified,
+			reviewer: 2,
+		},
+		{
+			name: "Error - Entered By The Same Admin",
+			mock: func() {
+				mockBankRepo.EXPECT().GetBankAccountByID(gomock.Any(), 7).Return(pending, nil)
+			},
+			status:   bankaccount.StatusVerified,
+			reviewer: 1,
+			wantErr:  true,
+		},
+		{
+			name: "Error - Already Reviewed",
+			mock: func() {
+				verified := pending
+				verified.Status = bankaccount.StatusVerified
+				mockBankRepo.EXPECT().GetBankAccountByID(gomock.Any(), 7).Return(verified, nil)
+			},
+			status:   bankaccount.StatusRejected,
+			note:     "wrong holder",
+			reviewer: 2,
+			wantErr:  true,
+		},
+		{
+			name: "Error - Not Found",
+			mock: func() {
+				mockBankRepo.EXPECT().GetBankAccountByID(gomock.Any(), 7).Return(bankaccount.BankAccount{}, nil)
+			},
+			status:   bankaccount.StatusVerified,
+			reviewer: 2,
+			wantErr:  true,
+		},
+		{
+			name:     "Error - Rejected Without Note",
+			mock:     func() {},
+			status:   bankaccount.StatusRejected,
+			reviewer: 2,
+			wantErr:  true,
+		},
+		{
+			name:     "Error - Unknown Status",
+			mock:     func() {},
+			status:   "approved",
+			reviewer: 2,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, nil, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, mockBankRepo)
+
+			got, err := s.ReviewBankAccount(context.Background(), 7, tt.status, tt.note, tt.reviewer, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, got.Status)
+			assert.Equal(t, tt.reviewer, *got.VerifiedBy)
+		})
+	}
+}
+
+func Test_adminService_UpdateEmployeeEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	employee := usermodel.User{ID: 3, Username: "budi", PasswordHash: "$2a$10$abcdefghijklmnopqrstuv"}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		email   string
+		wantErr bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockUserRepo.EXPECT().UpdateUserEmail(gomock.Any(), 3, "budi@example.com").Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "users",
+						RecordID:  3,
+						Action:    "UPDATE",
+						OldData:   []byte(`{"id":3,"email":""}`),
+						NewData:   []byte(`{"id":3,"email":"budi@example.com"}`),
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			email: " budi@example.com ",
+		},
+		{
+			name: "Error - Invalid Address",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil)
+			},
+			email:   "Budi <budi@example.com>",
+			wantErr: true,
+		},
+		{
+			name: "Error - Not An Employee",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(usermodel.User{ID: 3, IsAdmin: true}, nil)
+			},
+			email:   "budi@example.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, mockUserRepo, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.UpdateEmployeeEmail(context.Background(), 3, tt.email, 1, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "budi@example.com", got.Email)
+			assert.Empty(t, got.PasswordHash)
+		})
+	}
+}
+
+func Test_adminService_UpdateEmployeeManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	intPtr := func(i int) *int { return &i }
+	employee := usermodel.User{ID: 3, Username: "budi", PasswordHash: "$2a$10$abcdefghijklmnopqrstuv", ManagerID: intPtr(4)}
+
+	tests := []struct {
+		name      string
+		mock      func()
+		managerID *int
+		wantErr   bool
+	}{
+		{
+			name: "Happy Path",
+			mock: func() {
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 5).Return(usermodel.User{ID: 5, Username: "sari", ManagerID: intPtr(6)}, nil),
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 6).Return(usermodel.User{ID: 6, Username: "dewi"}, nil),
+					mockUserRepo.EXPECT().UpdateUserManager(gomock.Any(), 3, intPtr(5)).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), audit.AuditLog{
+						TableName: "users",
+						RecordID:  3,
+						Action:    "UPDATE",
+						OldData:   []byte(`{"id":3,"manager_id":4}`),
+						NewData:   []byte(`{"id":3,"manager_id":5}`),
+						ChangedBy: sql.NullInt32{Valid: true, Int32: 1},
+						RequestID: sql.NullInt32{Valid: true, Int32: 99},
+					}).Return(1, nil),
+				)
+			},
+			managerID: intPtr(5),
+		},
+		{
+			name: "Happy Path - Manager Removed",
+			mock: func() {
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockUserRepo.EXPECT().UpdateUserManager(gomock.Any(), 3, nil).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			managerID: nil,
+		},
+		{
+			name: "Error - Own Manager",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil)
+			},
+			managerID: intPtr(3),
+			wantErr:   true,
+		},
+		{
+			name: "Error - Cycle",
+			mock: func() {
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 5).Return(usermodel.User{ID: 5, Username: "sari", ManagerID: intPtr(6)}, nil),
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 6).Return(usermodel.User{ID: 6, Username: "dewi", ManagerID: intPtr(3)}, nil),
+				)
+			},
+			managerID: intPtr(5),
+			wantErr:   true,
+		},
+		{
+			name: "Error - Manager Is Admin",
+			mock: func() {
+				gomock.InOrder(
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil),
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(usermodel.User{ID: 1, IsAdmin: true}, nil),
+				)
+			},
+			managerID: intPtr(1),
+			wantErr:   true,
+		},
+		{
+			name: "Error - Same Manager",
+			mock: func() {
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 3).Return(employee, nil)
+			},
+			managerID: intPtr(4),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewAdminService(nil, nil, nil, nil, mockUserRepo, nil, mockAudSvc, attendance.ClockPolicy{}, overtime.Policy{}, nil, nil)
+
+			got, err := s.UpdateEmployeeManager(context.Background(), 3, tt.managerID, 1, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.managerID, got.ManagerID)
+			assert.Empty(t, got.PasswordHash)
+		})
+	}
+}
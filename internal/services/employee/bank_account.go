@@ -0,0 +1,126 @@
+package employee
+
+import (
+    "context"
+    "fmt"
+    "payslip-generation-system/internal/entity/bankaccount"
+)
+
+// SubmitBankAccount asks for salary to be paid to an account from its effective date, nothing is paid to it
+// until an admin has verified it
+func (s *employeeService) SubmitBankAccount(ctx context.Context, account bankaccount.BankAccount, requestID int) (bankaccount.BankAccount, error) {
+    account = bankaccount.Normalize(account)
+    err := bankaccount.Validate(account)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    if account.EffectiveDate.Before(s.today()) {
+        return bankaccount.BankAccount{}, fmt.Errorf("effective_date can't be in the past")
+    }
+
+    pending, err := s.bankrepo.GetBankAccounts(ctx, account.UserID, bankaccount.StatusPending)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    for _, p := range pending {
+        if p.BankCode == account.BankCode && p.AccountNumber == account.AccountNumber {
+            return bankaccount.BankAccount{}, fmt.Errorf("this account is already waiting for verification")
+        }
+    }
+
+    account.Status = bankaccount.StatusPending
+    account.RequestedBy = account.UserID
+    account.ID, err = s.bankrepo.InsertBankAccount(ctx, account)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+
+    err = s.recordChange(ctx, "employee_bank_accounts", account.ID, "CREATE", struct{}{}, account, account.UserID, requestID)
+    if err != nil {
+        return bankaccount.BankAccount{}, err
+    }
+    return account, nil
+}
+
+// GetBankAccounts lists the user's bank accounts with their verification status, the latest effective first
+func (s *employeeService) GetBankAccounts(ctx context.Context, userID int) ([]bankaccount.BankAccount, error) {
+    return s.bankrepo.GetBankAccounts(ctx, userID, "")
+}
+
+// SetSalaryAllocation sends part of the take home pay to one of the user's verified accounts other than the primary,
+// setting it again for the same account changes it. The account was verified already so this takes effect right away
+func (s *employeeService) SetSalaryAllocation(ctx context.Context, allocation bankaccount.Allocation, requestID int) (bankaccount.Allocation, error) {
+    err := bankaccount.ValidateAllocation(allocation)
+    if err != nil {
+        return bankaccount.Allocation{}, err
+    }
+    account, err := s.bankrepo.GetBankAccountByID(ctx, allocation.BankAccountID)
+    if err != nil {
+        return bankaccount.Allocation{}, err
+    }
+    if account.ID == 0 || account.UserID != allocation.UserID {
+        return bankaccount.Allocation{}, fmt.Errorf("bank account not found")
+    }
+    if account.Status != bankaccount.StatusVerified {
+        return bankaccount.Allocation{}, fmt.Errorf("only a verified account can receive part of the salary")
+    }
+    if account.IsPrimary {
+        return bankaccount.Allocation{}, fmt.Errorf("the primary account already receives what the allocations leave")
+    }
+
+    allocations, err := s.bankrepo.GetAllocations(ctx, allocation.UserID)
+    if err != nil {
+        return bankaccount.Allocation{}, err
+    }
+    var existing *bankaccount.Allocation
+    percentage := allocation.Percentage
+    for i, a := range allocations {
+        if a.BankAccountID == allocation.BankAccountID {
+            existing = &allocations[i]
+            continue
+        }
+        percentage += a.Percentage
+    }
+    if percentage >= 100 {
+        return bankaccount.Allocation{}, fmt.Errorf("percentage allocations must leave part of the salary for the primary account")
+    }
+
+    allocation.CreatedBy = allocation.UserID
+    allocation.ID, err = s.bankrepo.UpsertAllocation(ctx, allocation)
+    if err != nil {
+        return bankaccount.Allocation{}, err
+    }
+    allocation.Account = account
+
+    if existing != nil {
+        err = s.recordChange(ctx, "salary_allocations", allocation.ID, "UPDATE", *existing, allocation, allocation.UserID, requestID)
+    } else {
+        err = s.recordChange(ctx, "salary_allocations", allocation.ID, "CREATE", struct{}{}, allocation, allocation.UserID, requestID)
+    }
+    if err != nil {
+        return bankaccount.Allocation{}, err
+    }
+    return allocation, nil
+}
+
+// RemoveSalaryAllocation stops sending part of the take home pay to an account, it goes to the primary account again
+func (s *employeeService) RemoveSalaryAllocation(ctx context.Context, allocationID, userID, requestID int) error {
+    allocation, err := s.bankrepo.GetAllocationByID(ctx, allocationID)
+    if err != nil {
+        return err
+    }
+    if allocation.ID == 0 || allocation.UserID != userID {
+        return fmt.Errorf("salary allocation not found")
+    }
+
+    err = s.bankrepo.DeleteAllocation(ctx, allocationID)
+    if err != nil {
+        return err
+    }
+    return s.recordChange(ctx, "salary_allocations", allocation.ID, "DELETE", allocation, nil, userID, requestID)
+}
+
+// GetSalaryAllocations lists how the user's take home pay is split, in the order the allocations are applied
+func (s *employeeService) GetSalaryAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error) {
+    return s.bankrepo.GetAllocations(ctx, userID)
+}
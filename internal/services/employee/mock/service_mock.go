@@ -6,8 +6,11 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	attendance "payslip-generation-system/internal/entity/attendance"
+	bankaccount "payslip-generation-system/internal/entity/bankaccount"
 	overtime "payslip-generation-system/internal/entity/overtime"
+	pagination "payslip-generation-system/internal/entity/pagination"
 	payslip "payslip-generation-system/internal/entity/payslip"
 	reimbursement "payslip-generation-system/internal/entity/reimbursement"
 	reflect "reflect"
@@ -38,6 +41,81 @@ func (m *MockEmployeeServiceProvider) EXPECT() *MockEmployeeServiceProviderMockR
 	return m.recorder
 }
 
+// AmendAttendance mocks base method.
+func (m *MockEmployeeServiceProvider) AmendAttendance(ctx context.Context, att attendance.Attendance, requestID int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendAttendance", ctx, att, requestID)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendAttendance indicates an expected call of AmendAttendance.
+func (mr *MockEmployeeServiceProviderMockRecorder) AmendAttendance(ctx, att, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendAttendance", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).AmendAttendance), ctx, att, requestID)
+}
+
+// AmendOvertime mocks base method.
+func (m *MockEmployeeServiceProvider) AmendOvertime(ctx context.Context, ot overtime.Overtime, requestID int) (overtime.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendOvertime", ctx, ot, requestID)
+	ret0, _ := ret[0].(overtime.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendOvertime indicates an expected call of AmendOvertime.
+func (mr *MockEmployeeServiceProviderMockRecorder) AmendOvertime(ctx, ot, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendOvertime", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).AmendOvertime), ctx, ot, requestID)
+}
+
+// AmendReimbursement mocks base method.
+func (m *MockEmployeeServiceProvider) AmendReimbursement(ctx context.Context, rmb reimbursement.Reimbursement, requestID int) (reimbursement.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AmendReimbursement", ctx, rmb, requestID)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AmendReimbursement indicates an expected call of AmendReimbursement.
+func (mr *MockEmployeeServiceProviderMockRecorder) AmendReimbursement(ctx, rmb, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AmendReimbursement", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).AmendReimbursement), ctx, rmb, requestID)
+}
+
+// ClockIn mocks base method.
+func (m *MockEmployeeServiceProvider) ClockIn(ctx context.Context, att attendance.Attendance, requestID int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockIn", ctx, att, requestID)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockIn indicates an expected call of ClockIn.
+func (mr *MockEmployeeServiceProviderMockRecorder) ClockIn(ctx, att, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockIn", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).ClockIn), ctx, att, requestID)
+}
+
+// ClockOut mocks base method.
+func (m *MockEmployeeServiceProvider) ClockOut(ctx context.Context, att attendance.Attendance, requestID int) (attendance.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockOut", ctx, att, requestID)
+	ret0, _ := ret[0].(attendance.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockOut indicates an expected call of ClockOut.
+func (mr *MockEmployeeServiceProviderMockRecorder) ClockOut(ctx, att, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOut", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).ClockOut), ctx, att, requestID)
+}
+
 // GeneratePayslips mocks base method.
 func (m *MockEmployeeServiceProvider) GeneratePayslips(ctx context.Context, userID int) ([]payslip.Payslip, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +131,144 @@ func (mr *MockEmployeeServiceProviderMockRecorder) GeneratePayslips(ctx, userID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GeneratePayslips", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GeneratePayslips), ctx, userID)
 }
 
+// GetAttendances mocks base method.
+func (m *MockEmployeeServiceProvider) GetAttendances(ctx context.Context, userID, periodID int, page pagination.Request) ([]attendance.Attendance, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendances", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]attendance.Attendance)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttendances indicates an expected call of GetAttendances.
+func (mr *MockEmployeeServiceProviderMockRecorder) GetAttendances(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendances", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GetAttendances), ctx, userID, periodID, page)
+}
+
+// GetBankAccounts mocks base method.
+func (m *MockEmployeeServiceProvider) GetBankAccounts(ctx context.Context, userID int) ([]bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBankAccounts", ctx, userID)
+	ret0, _ := ret[0].([]bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBankAccounts indicates an expected call of GetBankAccounts.
+func (mr *MockEmployeeServiceProviderMockRecorder) GetBankAccounts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBankAccounts", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GetBankAccounts), ctx, userID)
+}
+
+// GetOvertimes mocks base method.
+func (m *MockEmployeeServiceProvider) GetOvertimes(ctx context.Context, userID, periodID int, page pagination.Request) ([]overtime.Overtime, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimes", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]overtime.Overtime)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOvertimes indicates an expected call of GetOvertimes.
+func (mr *MockEmployeeServiceProviderMockRecorder) GetOvertimes(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimes", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GetOvertimes), ctx, userID, periodID, page)
+}
+
+// GetReimbursementAttachment mocks base method.
+func (m *MockEmployeeServiceProvider) GetReimbursementAttachment(ctx context.Context, attachmentID, userID int, isAdmin bool) (reimbursement.Attachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursementAttachment", ctx, attachmentID, userID, isAdmin)
+	ret0, _ := ret[0].(reimbursement.Attachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReimbursementAttachment indicates an expected call of GetReimbursementAttachment.
+func (mr *MockEmployeeServiceProviderMockRecorder) GetReimbursementAttachment(ctx, attachmentID, userID, isAdmin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursementAttachment", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GetReimbursementAttachment), ctx, attachmentID, userID, isAdmin)
+}
+
+// GetReimbursements mocks base method.
+func (m *MockEmployeeServiceProvider) GetReimbursements(ctx context.Context, userID, periodID int, page pagination.Request) ([]reimbursement.Reimbursement, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReimbursements", ctx, userID, periodID, page)
+	ret0, _ := ret[0].([]reimbursement.Reimbursement)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReimbursements indicates an expected call of GetReimbursements.
+func (mr *MockEmployeeServiceProviderMockRecorder) GetReimbursements(ctx, userID, periodID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReimbursements", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GetReimbursements), ctx, userID, periodID, page)
+}
+
+// GetSalaryAllocations mocks base method.
+func (m *MockEmployeeServiceProvider) GetSalaryAllocations(ctx context.Context, userID int) ([]bankaccount.Allocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalaryAllocations", ctx, userID)
+	ret0, _ := ret[0].([]bankaccount.Allocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalaryAllocations indicates an expected call of GetSalaryAllocations.
+func (mr *MockEmployeeServiceProviderMockRecorder) GetSalaryAllocations(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalaryAllocations", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).GetSalaryAllocations), ctx, userID)
+}
+
+// RemoveSalaryAllocation mocks base method.
+func (m *MockEmployeeServiceProvider) RemoveSalaryAllocation(ctx context.Context, allocationID, userID, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSalaryAllocation", ctx, allocationID, userID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSalaryAllocation indicates an expected call of RemoveSalaryAllocation.
+func (mr *MockEmployeeServiceProviderMockRecorder) RemoveSalaryAllocation(ctx, allocationID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSalaryAllocation", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).RemoveSalaryAllocation), ctx, allocationID, userID, requestID)
+}
+
+// RequestAttendanceCorrection mocks base method.
+func (m *MockEmployeeServiceProvider) RequestAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection, requestID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAttendanceCorrection", ctx, correction, requestID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestAttendanceCorrection indicates an expected call of RequestAttendanceCorrection.
+func (mr *MockEmployeeServiceProviderMockRecorder) RequestAttendanceCorrection(ctx, correction, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAttendanceCorrection", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).RequestAttendanceCorrection), ctx, correction, requestID)
+}
+
+// SetSalaryAllocation mocks base method.
+func (m *MockEmployeeServiceProvider) SetSalaryAllocation(ctx context.Context, allocation bankaccount.Allocation, requestID int) (bankaccount.Allocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSalaryAllocation", ctx, allocation, requestID)
+	ret0, _ := ret[0].(bankaccount.Allocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSalaryAllocation indicates an expected call of SetSalaryAllocation.
+func (mr *MockEmployeeServiceProviderMockRecorder) SetSalaryAllocation(ctx, allocation, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSalaryAllocation", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SetSalaryAllocation), ctx, allocation, requestID)
+}
+
 // SubmitAttendance mocks base method.
 func (m *MockEmployeeServiceProvider) SubmitAttendance(ctx context.Context, attendance attendance.Attendance, requestID int) (int, error) {
 	m.ctrl.T.Helper()
@@ -68,32 +284,89 @@ func (mr *MockEmployeeServiceProviderMockRecorder) SubmitAttendance(ctx, attenda
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttendance", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SubmitAttendance), ctx, attendance, requestID)
 }
 
+// SubmitBankAccount mocks base method.
+func (m *MockEmployeeServiceProvider) SubmitBankAccount(ctx context.Context, account bankaccount.BankAccount, requestID int) (bankaccount.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitBankAccount", ctx, account, requestID)
+	ret0, _ := ret[0].(bankaccount.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitBankAccount indicates an expected call of SubmitBankAccount.
+func (mr *MockEmployeeServiceProviderMockRecorder) SubmitBankAccount(ctx, account, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitBankAccount", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SubmitBankAccount), ctx, account, requestID)
+}
+
 // SubmitOvertime mocks base method.
-func (m *MockEmployeeServiceProvider) SubmitOvertime(ctx context.Context, overtime overtime.Overtime, requestID int) (int, error) {
+func (m *MockEmployeeServiceProvider) SubmitOvertime(ctx context.Context, ot overtime.Overtime, requestID int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitOvertime", ctx, overtime, requestID)
+	ret := m.ctrl.Call(m, "SubmitOvertime", ctx, ot, requestID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubmitOvertime indicates an expected call of SubmitOvertime.
-func (mr *MockEmployeeServiceProviderMockRecorder) SubmitOvertime(ctx, overtime, requestID interface{}) *gomock.Call {
+func (mr *MockEmployeeServiceProviderMockRecorder) SubmitOvertime(ctx, ot, requestID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOvertime", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SubmitOvertime), ctx, overtime, requestID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOvertime", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SubmitOvertime), ctx, ot, requestID)
 }
 
 // SubmitReimbursement mocks base method.
-func (m *MockEmployeeServiceProvider) SubmitReimbursement(ctx context.Context, reimbursement reimbursement.Reimbursement, requestID int) (int, error) {
+func (m *MockEmployeeServiceProvider) SubmitReimbursement(ctx context.Context, rmb reimbursement.Reimbursement, receipts []reimbursement.Receipt, requestID int) (reimbursement.Reimbursement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitReimbursement", ctx, reimbursement, requestID)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "SubmitReimbursement", ctx, rmb, receipts, requestID)
+	ret0, _ := ret[0].(reimbursement.Reimbursement)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubmitReimbursement indicates an expected call of SubmitReimbursement.
-func (mr *MockEmployeeServiceProviderMockRecorder) SubmitReimbursement(ctx, reimbursement, requestID interface{}) *gomock.Call {
+func (mr *MockEmployeeServiceProviderMockRecorder) SubmitReimbursement(ctx, rmb, receipts, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReimbursement", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SubmitReimbursement), ctx, rmb, receipts, requestID)
+}
+
+// WithdrawAttendance mocks base method.
+func (m *MockEmployeeServiceProvider) WithdrawAttendance(ctx context.Context, attendanceID, userID, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawAttendance", ctx, attendanceID, userID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawAttendance indicates an expected call of WithdrawAttendance.
+func (mr *MockEmployeeServiceProviderMockRecorder) WithdrawAttendance(ctx, attendanceID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawAttendance", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).WithdrawAttendance), ctx, attendanceID, userID, requestID)
+}
+
+// WithdrawOvertime mocks base method.
+func (m *MockEmployeeServiceProvider) WithdrawOvertime(ctx context.Context, overtimeID, userID, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawOvertime", ctx, overtimeID, userID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawOvertime indicates an expected call of WithdrawOvertime.
+func (mr *MockEmployeeServiceProviderMockRecorder) WithdrawOvertime(ctx, overtimeID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawOvertime", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).WithdrawOvertime), ctx, overtimeID, userID, requestID)
+}
+
+// WithdrawReimbursement mocks base method.
+func (m *MockEmployeeServiceProvider) WithdrawReimbursement(ctx context.Context, reimbursementID, userID, requestID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawReimbursement", ctx, reimbursementID, userID, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithdrawReimbursement indicates an expected call of WithdrawReimbursement.
+func (mr *MockEmployeeServiceProviderMockRecorder) WithdrawReimbursement(ctx, reimbursementID, userID, requestID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReimbursement", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).SubmitReimbursement), ctx, reimbursement, requestID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawReimbursement", reflect.TypeOf((*MockEmployeeServiceProvider)(nil).WithdrawReimbursement), ctx, reimbursementID, userID, requestID)
 }
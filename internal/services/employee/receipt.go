@@ -0,0 +1,98 @@
+package employee
+
+import (
+    "bytes"
+    "crypto/rand"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "io"
+    "net/http"
+    "path/filepath"
+    "payslip-generation-system/internal/entity/reimbursement"
+    "strings"
+)
+
+// receiptExtensions are the accepted receipt types keyed by their sniffed content type
+var receiptExtensions = map[string]string{
+    reimbursement.ContentTypePDF:  ".pdf",
+    reimbursement.ContentTypeJPEG: ".jpg",
+    reimbursement.ContentTypePNG:  ".png",
+}
+
+// checkedReceipt is a receipt that passed the size and type checks, held in memory until it is stored
+type checkedReceipt struct {
+    attachment reimbursement.Attachment
+    content    []byte
+}
+
+// checkReceipts reads every receipt up to the size limit and sniffs its content type,
+// the file name and extension sent by the client are never trusted for the type.
+func checkReceipts(receipts []reimbursement.Receipt, policy reimbursement.ReceiptPolicy) ([]checkedReceipt, error) {
+    if len(receipts) < policy.MinFiles {
+        return nil, fmt.Errorf("at least %d receipt is required", policy.MinFiles)
+    }
+    if policy.MaxFiles > 0 && len(receipts) > policy.MaxFiles {
+        return nil, fmt.Errorf("at most %d receipts can be attached", policy.MaxFiles)
+    }
+
+    checked := make([]checkedReceipt, 0, len(receipts))
+    for _, receipt := range receipts {
+        fileName := filepath.Base(strings.ReplaceAll(receipt.FileName, "\\", "/"))
+        if fileName == "." || fileName == "/" {
+            fileName = "receipt"
+        }
+
+        reader := receipt.Content
+        if policy.MaxSizeBytes > 0 {
+            reader = io.LimitReader(receipt.Content, policy.MaxSizeBytes+1)
+        }
+        content, err := io.ReadAll(reader)
+        if err != nil {
+            return nil, fmt.Errorf("failed to read receipt %s: %w", fileName, err)
+        }
+        if len(content) == 0 {
+            return nil, fmt.Errorf("receipt %s is empty", fileName)
+        }
+        if policy.MaxSizeBytes > 0 && int64(len(content)) > policy.MaxSizeBytes {
+            return nil, fmt.Errorf("receipt %s exceeds the maximum size of %d MB", fileName, policy.MaxSizeBytes>>20)
+        }
+
+        contentType := http.DetectContentType(content)
+        if _, ok := receiptExtensions[contentType]; !ok {
+            return nil, fmt.Errorf("receipt %s must be a PDF, JPEG or PNG file", fileName)
+        }
+
+        sum := sha256.Sum256(content)
+        checked = append(checked, checkedReceipt{
+            attachment: reimbursement.Attachment{
+                FileName:    fileName,
+                ContentType: contentType,
+                SizeBytes:   int64(len(content)),
+                Checksum:    hex.EncodeToString(sum[:]),
+            },
+            content: content,
+        })
+    }
+    return checked, nil
+}
+
+// newReceiptFolder names the folder the receipts of a new claim are kept in, receipts are stored before the claim
+// is written so the folder can't be named after its id
+func newReceiptFolder() (string, error) {
+    token := make([]byte, 8)
+    _, err := rand.Read(token)
+    if err != nil {
+        return "", err
+    }
+    return hex.EncodeToString(token), nil
+}
+
+// receiptKey is where a receipt is kept in the blob store, one folder per reimbursement
+func receiptKey(folder string, index int, receipt reimbursement.Attachment) string {
+    return fmt.Sprintf("reimbursements/%s/%d-%s%s", folder, index+1, receipt.Checksum, receiptExtensions[receipt.ContentType])
+}
+
+func (c checkedReceipt) reader() io.Reader {
+    return bytes.NewReader(c.content)
+}
@@ -5,24 +5,55 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"payslip-generation-system/internal/blobstore"
 	"payslip-generation-system/internal/entity/attendance"
 	"payslip-generation-system/internal/entity/audit"
+	"payslip-generation-system/internal/entity/bankaccount"
 	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/pagination"
 	"payslip-generation-system/internal/entity/payslip"
 	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/entity/schedule"
+	"payslip-generation-system/internal/exchangerate"
 	attrepo "payslip-generation-system/internal/repositories/attendance"
+	bankrepo "payslip-generation-system/internal/repositories/bankaccount"
 	ovtrepo "payslip-generation-system/internal/repositories/overtime"
 	payreporepo "payslip-generation-system/internal/repositories/payslip"
 	rmbrepo "payslip-generation-system/internal/repositories/reimbursement"
+	schedrepo "payslip-generation-system/internal/repositories/schedule"
+	userepo "payslip-generation-system/internal/repositories/user"
 	audsvc "payslip-generation-system/internal/services/audit"
+	"payslip-generation-system/internal/services/rates"
+	"strings"
+	"time"
 )
 
 //go:generate mockgen -source=service.go -package=mock -destination=mock/service_mock.go
 type EmployeeServiceProvider interface {
     SubmitAttendance(ctx context.Context, attendance attendance.Attendance, requestID int)(int, error) 
-	SubmitOvertime(ctx context.Context, overtime overtime.Overtime, requestID int)(int, error) 
-	SubmitReimbursement(ctx context.Context, reimbursement reimbursement.Reimbursement, requestID int)(int, error)
+    ClockIn(ctx context.Context, att attendance.Attendance, requestID int)(attendance.Attendance, error)
+    ClockOut(ctx context.Context, att attendance.Attendance, requestID int)(attendance.Attendance, error)
+    RequestAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection, requestID int)(int, error)
+	SubmitOvertime(ctx context.Context, ot overtime.Overtime, requestID int)(int, error) 
+	SubmitReimbursement(ctx context.Context, rmb reimbursement.Reimbursement, receipts []reimbursement.Receipt, requestID int)(reimbursement.Reimbursement, error)
+	GetReimbursementAttachment(ctx context.Context, attachmentID int, userID int, isAdmin bool)(reimbursement.Attachment, io.ReadCloser, error)
 	GeneratePayslips(ctx context.Context, userID int)([]payslip.Payslip, error)
+	GetAttendances(ctx context.Context, userID, periodID int, page pagination.Request)([]attendance.Attendance, int, error)
+	GetOvertimes(ctx context.Context, userID, periodID int, page pagination.Request)([]overtime.Overtime, int, error)
+	GetReimbursements(ctx context.Context, userID, periodID int, page pagination.Request)([]reimbursement.Reimbursement, int, error)
+	AmendAttendance(ctx context.Context, att attendance.Attendance, requestID int)(attendance.Attendance, error)
+	WithdrawAttendance(ctx context.Context, attendanceID, userID, requestID int) error
+	AmendOvertime(ctx context.Context, ot overtime.Overtime, requestID int)(overtime.Overtime, error)
+	WithdrawOvertime(ctx context.Context, overtimeID, userID, requestID int) error
+	AmendReimbursement(ctx context.Context, rmb reimbursement.Reimbursement, requestID int)(reimbursement.Reimbursement, error)
+	WithdrawReimbursement(ctx context.Context, reimbursementID, userID, requestID int) error
+	SubmitBankAccount(ctx context.Context, account bankaccount.BankAccount, requestID int)(bankaccount.BankAccount, error)
+	GetBankAccounts(ctx context.Context, userID int)([]bankaccount.BankAccount, error)
+	SetSalaryAllocation(ctx context.Context, allocation bankaccount.Allocation, requestID int)(bankaccount.Allocation, error)
+	RemoveSalaryAllocation(ctx context.Context, allocationID, userID, requestID int) error
+	GetSalaryAllocations(ctx context.Context, userID int)([]bankaccount.Allocation, error)
 }
 
 type employeeService struct {
@@ -30,7 +61,17 @@ type employeeService struct {
 	ovtrepo ovtrepo.OvertimeRepositoryProvider
 	rmbrepo rmbrepo.ReimbursementRepositoryProvider
 	payrepo payreporepo.PayslipRepositoryProvider
+	schedrepo schedrepo.ScheduleRepositoryProvider
+	userepo userepo.UserRepositoryProvider
     audsvc audsvc.AuditServiceProvider
+    clockPolicy attendance.ClockPolicy
+    overtimePolicy overtime.Policy
+    blobStore blobstore.BlobStore
+    receiptPolicy reimbursement.ReceiptPolicy
+    rateProvider exchangerate.Provider
+    ratePolicy reimbursement.ExchangeRatePolicy
+    duplicatePolicy reimbursement.DuplicatePolicy
+    bankrepo bankrepo.BankAccountRepositoryProvider
 }
 
 func NewEmployeeService(
@@ -38,14 +79,34 @@ func NewEmployeeService(
 	overtimeRepo ovtrepo.OvertimeRepositoryProvider,
 	reimbursRepo rmbrepo.ReimbursementRepositoryProvider,
 	payslipRepo payreporepo.PayslipRepositoryProvider,
+	scheduleRepo schedrepo.ScheduleRepositoryProvider,
+	userRepo userepo.UserRepositoryProvider,
     auditService audsvc.AuditServiceProvider,
+    clockPolicy attendance.ClockPolicy,
+    overtimePolicy overtime.Policy,
+    blobStore blobstore.BlobStore,
+    receiptPolicy reimbursement.ReceiptPolicy,
+    rateProvider exchangerate.Provider,
+    ratePolicy reimbursement.ExchangeRatePolicy,
+    duplicatePolicy reimbursement.DuplicatePolicy,
+    bankAccountRepo bankrepo.BankAccountRepositoryProvider,
 ) EmployeeServiceProvider {
     return &employeeService{
         attrepo: attendanceRepo,
 		ovtrepo: overtimeRepo,
 		rmbrepo: reimbursRepo,
 		payrepo: payslipRepo,
+		schedrepo: scheduleRepo,
+		userepo: userRepo,
         audsvc: auditService,
+        clockPolicy: clockPolicy,
+        overtimePolicy: overtimePolicy,
+        blobStore: blobStore,
+        receiptPolicy: receiptPolicy,
+        rateProvider: rateProvider,
+        ratePolicy: ratePolicy,
+        duplicatePolicy: duplicatePolicy,
+        bankrepo: bankAccountRepo,
     }
 }
 
@@ -73,6 +134,12 @@ func (s *employeeService) SubmitAttendance(ctx context.Context, attendance atten
         return 0, fmt.Errorf("date must be between %s and %s", attendancePeriod.StartDate, attendancePeriod.EndDate)
     }
 
+    employeeSchedule, err := s.scheduleFor(ctx, attendance)
+    if err != nil {
+        return 0, err
+    }
+    attendance.IsExtraDay = attendance.IsExtraDay && !employeeSchedule.IsWorkingDay(attendance.Date)
+
     id, err := s.attrepo.InsertAttendance(ctx, attendance)
     if err != nil {
         return 0, err
@@ -99,44 +166,250 @@ func (s *employeeService) SubmitAttendance(ctx context.Context, attendance atten
     return id, nil
 }
 
-func (s *employeeService) SubmitOvertime(ctx context.Context, overtime overtime.Overtime, requestID int)(int, error) {
-	existingAttendance , err:= s.attrepo.GetAttendance(ctx, overtime.UserID, overtime.PeriodID, overtime.Date)
+func (s *employeeService) ClockIn(ctx context.Context, attendance attendance.Attendance, requestID int)(attendance.Attendance, error) {
+    if attendance.ClockIn == nil {
+        return attendance, fmt.Errorf("clock_in is required")
+    }
+
+    openAttendance, err := s.attrepo.GetOpenAttendance(ctx, attendance.UserID, attendance.PeriodID)
+    if err != nil {
+        return attendance, err
+    }
+    if openAttendance.ID != 0 && attendance.ClockIn.Sub(*openAttendance.ClockIn) <= s.clockPolicy.MaxShiftDuration {
+        return attendance, fmt.Errorf("you are still clocked in since %s", openAttendance.ClockIn.Format(time.RFC3339))
+    }
+
+    localClockIn := attendance.ClockIn.In(s.clockPolicy.Location)
+    attendance.Date = time.Date(localClockIn.Year(), localClockIn.Month(), localClockIn.Day(), 0, 0, 0, 0, time.UTC)
+
+    existingAttendance, err := s.attrepo.GetAttendance(ctx, attendance.UserID, attendance.PeriodID, attendance.Date)
+    if err != nil {
+        return attendance, err
+    }
+    if existingAttendance.ID != 0 {
+        return attendance, fmt.Errorf("attendance already exists")
+    }
+
+    attendancePeriod, err := s.attrepo.GetAttendancePeriodByID(ctx, attendance.PeriodID)
+    if err != nil {
+        return attendance, err
+    }
+    if attendancePeriod.ID == 0 {
+        return attendance, fmt.Errorf("period not found")
+    }
+
+    if attendance.Date.Before(attendancePeriod.StartDate) || attendance.Date.After(attendancePeriod.EndDate) {
+        return attendance, fmt.Errorf("date must be between %s and %s", attendancePeriod.StartDate, attendancePeriod.EndDate)
+    }
+
+    employeeSchedule, err := s.scheduleFor(ctx, attendance)
+    if err != nil {
+        return attendance, err
+    }
+    attendance.IsExtraDay = attendance.IsExtraDay && !employeeSchedule.IsWorkingDay(attendance.Date)
+
+    shiftStart := s.clockPolicy.ShiftStart
+    if employeeSchedule.ID != 0 {
+        shiftStart, err = employeeSchedule.Shift.StartOffset()
+        if err != nil {
+            return attendance, err
+        }
+    }
+    attendance.LateMinutes = lateMinutes(localClockIn, shiftStart)
+
+    id, err := s.attrepo.InsertAttendance(ctx, attendance)
+    if err != nil {
+        return attendance, err
+    }
+    attendance.ID = id
+
+    attendanceJson, err := json.Marshal(attendance)
+    if err != nil {
+        return attendance, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "attendances",
+        RecordID: id,
+        Action: "CREATE",
+        OldData: []byte("{}"),
+        NewData: attendanceJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(attendance.UserID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return attendance, err
+    }
+    return attendance, nil
+}
+
+func (s *employeeService) ClockOut(ctx context.Context, attendance attendance.Attendance, requestID int)(attendance.Attendance, error) {
+    if attendance.ClockOut == nil {
+        return attendance, fmt.Errorf("clock_out is required")
+    }
+
+    openAttendance, err := s.attrepo.GetOpenAttendance(ctx, attendance.UserID, attendance.PeriodID)
+    if err != nil {
+        return attendance, err
+    }
+    if openAttendance.ID == 0 {
+        return attendance, fmt.Errorf("you need to clock in first before clocking out")
+    }
+
+    worked := attendance.ClockOut.Sub(*openAttendance.ClockIn)
+    if worked <= 0 {
+        return attendance, fmt.Errorf("clock_out must be after clock_in")
+    }
+    // a clock-in left open past the longest allowed shift is treated as a missing clock-out,
+    // the day stays recorded without worked hours and is not counted as present
+    if worked > s.clockPolicy.MaxShiftDuration {
+        return attendance, fmt.Errorf("clock-in at %s has no clock-out within %s, the day is recorded without worked hours", openAttendance.ClockIn.Format(time.RFC3339), s.clockPolicy.MaxShiftDuration)
+    }
+
+    updatedAttendance := openAttendance
+    updatedAttendance.ClockOut = attendance.ClockOut
+    updatedAttendance.WorkedMinutes = int(worked.Minutes())
+
+    err = s.attrepo.UpdateAttendanceClockOut(ctx, updatedAttendance)
+    if err != nil {
+        return attendance, err
+    }
+
+    oldJson, err := json.Marshal(openAttendance)
+    if err != nil {
+        return attendance, err
+    }
+    newJson, err := json.Marshal(updatedAttendance)
+    if err != nil {
+        return attendance, err
+    }
+
+    log := audit.AuditLog{
+        TableName: "attendances",
+        RecordID: updatedAttendance.ID,
+        Action: "UPDATE",
+        OldData: oldJson,
+        NewData: newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(attendance.UserID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return attendance, err
+    }
+    return updatedAttendance, nil
+}
+
+func (s *employeeService) RequestAttendanceCorrection(ctx context.Context, correction attendance.AttendanceCorrection, requestID int)(int, error) {
+    if correction.Action != attendance.CorrectionActionAdd && correction.Action != attendance.CorrectionActionRemove {
+        return 0, fmt.Errorf("action must be %s or %s", attendance.CorrectionActionAdd, attendance.CorrectionActionRemove)
+    }
+    if strings.TrimSpace(correction.Reason) == "" {
+        return 0, fmt.Errorf("reason is required")
+    }
+
+    attendancePeriod, err := s.attrepo.GetAttendancePeriodByID(ctx, correction.PeriodID)
     if err != nil {
         return 0, err
     }
+    if attendancePeriod.ID == 0 {
+        return 0, fmt.Errorf("period not found")
+    }
+    if correction.Date.Before(attendancePeriod.StartDate) || correction.Date.After(attendancePeriod.EndDate) {
+        return 0, fmt.Errorf("date must be between %s and %s", attendancePeriod.StartDate, attendancePeriod.EndDate)
+    }
 
-    if existingAttendance.ID == 0 {
-        return 0, fmt.Errorf("you need to submit attendance first before submitting overtime")
+    isProcessed, err := s.payrepo.PayslipExistsByPeriodID(ctx, correction.PeriodID)
+    if err != nil {
+        return 0, err
+    }
+    if isProcessed {
+        return 0, fmt.Errorf("payroll already generated for this period, attendance can no longer be corrected")
     }
 
-	existingOvertime , err:= s.ovtrepo.GetOvertime(ctx, overtime.UserID, overtime.PeriodID, overtime.Date)
+    existingAttendance, err := s.attrepo.GetAttendance(ctx, correction.UserID, correction.PeriodID, correction.Date)
     if err != nil {
         return 0, err
     }
+    if correction.Action == attendance.CorrectionActionAdd && existingAttendance.ID != 0 {
+        return 0, fmt.Errorf("attendance already exists")
+    }
+    if correction.Action == attendance.CorrectionActionRemove && existingAttendance.ID == 0 {
+        return 0, fmt.Errorf("there is no attendance on %s to remove", correction.Date.Format("2006-01-02"))
+    }
 
-	if existingOvertime.ID != 0 {
-		return 0, fmt.Errorf("overtime already exists")
-	}
+    pendingCorrection, err := s.attrepo.GetPendingAttendanceCorrection(ctx, correction.UserID, correction.PeriodID, correction.Date)
+    if err != nil {
+        return 0, err
+    }
+    if pendingCorrection.ID != 0 {
+        return 0, fmt.Errorf("a correction for %s is already waiting for review", correction.Date.Format("2006-01-02"))
+    }
 
-	attendancePeriod, err:= s.attrepo.GetAttendancePeriodByID(ctx, overtime.PeriodID)
+    correction.Status = attendance.CorrectionStatusPending
+    id, err := s.attrepo.InsertAttendanceCorrection(ctx, correction)
     if err != nil {
         return 0, err
     }
-    if attendancePeriod.ID == 0{
-        return 0, fmt.Errorf("period not found")
+
+    correctionJson, err := json.Marshal(correction)
+    if err != nil {
+        return 0, err
     }
 
-	if overtime.Hours > 3 || overtime.Hours < 1 {
-		return 0, fmt.Errorf("hours must be between 1 and 3")
-	}
+    log := audit.AuditLog{
+        TableName: "attendance_corrections",
+        RecordID: id,
+        Action: "CREATE",
+        OldData: []byte("{}"),
+        NewData: correctionJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(correction.UserID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    if err != nil {
+        return 0, err
+    }
+    return id, nil
+}
+
+// scheduleFor returns the employee schedule in effect on the attendance date,
+// days outside of the schedule are rejected unless they are flagged as extra days
+func (s *employeeService) scheduleFor(ctx context.Context, att attendance.Attendance) (schedule.EmployeeSchedule, error) {
+    employeeSchedule, err := s.schedrepo.GetEmployeeScheduleByDate(ctx, att.UserID, att.Date)
+    if err != nil {
+        return schedule.EmployeeSchedule{}, err
+    }
+
+    if !employeeSchedule.IsWorkingDay(att.Date) && !att.IsExtraDay {
+        return schedule.EmployeeSchedule{}, fmt.Errorf("%s is outside your work schedule, flag it as an extra day to submit it", att.Date.Format("2006-01-02"))
+    }
+    return employeeSchedule, nil
+}
+
+// lateMinutes returns how many whole minutes the clock-in is past the shift start
+func lateMinutes(localClockIn time.Time, shiftStart time.Duration) int {
+    return attendance.LateMinutes(localClockIn, shiftStart)
+}
 
-    s.ovtrepo.InsertOvertime(ctx, overtime)
-    id, err := s.ovtrepo.InsertOvertime(ctx, overtime)
+func (s *employeeService) SubmitOvertime(ctx context.Context, ot overtime.Overtime, requestID int)(int, error) {
+    err := s.checkOvertime(ctx, &ot, overtime.Overtime{})
     if err != nil {
         return 0, err
     }
+    changedBy := ot.UserID
+    if ot.LimitOverriddenBy != nil {
+        changedBy = *ot.LimitOverriddenBy
+    }
 
-    overtimeJson, err := json.Marshal(overtime)
+    ot.Status = overtime.StatusPending
+    id, err := s.ovtrepo.InsertOvertime(ctx, ot)
+    if err != nil {
+        return 0, err
+    }
+
+    overtimeJson, err := json.Marshal(ot)
     if err != nil {
         return 0, err
     }
@@ -147,7 +420,7 @@ func (s *employeeService) SubmitOvertime(ctx context.Context, overtime overtime.
         Action: "CREATE",
         OldData: []byte("{}"),
         NewData: overtimeJson,
-        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(overtime.UserID)},
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(changedBy)},
         RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
     }
     _, err= s.audsvc.RecordAuditLog(ctx, log)
@@ -158,39 +431,898 @@ func (s *employeeService) SubmitOvertime(ctx context.Context, overtime overtime.
 	return id, nil
 }
 
-func (s *employeeService) SubmitReimbursement(ctx context.Context, reimbursement reimbursement.Reimbursement, requestID int)(int, error) {
-	attendancePeriod, err:= s.attrepo.GetAttendancePeriodByID(ctx, reimbursement.PeriodID)
+// checkOvertime validates new or amended overtime and sets its date, type and rounded duration.
+// original is the overtime being amended, it doesn't count as an overlap or towards the limits
+func (s *employeeService) checkOvertime(ctx context.Context, ot *overtime.Overtime, original overtime.Overtime) error {
+    if ot.StartAt == nil || ot.EndAt == nil {
+        return fmt.Errorf("start_at and end_at are required")
+    }
+    if !ot.EndAt.After(*ot.StartAt) {
+        return fmt.Errorf("end_at must be after start_at")
+    }
+    // overtime belongs to the day it starts on, overnight overtime ends on the next day
+    localStart := ot.StartAt.In(s.clockPolicy.Location)
+    ot.Date = time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, time.UTC)
+
+    if ot.Type == "" {
+        ot.Type = overtime.TypeRegular
+    }
+    maxHours, ok := s.overtimePolicy.MaxHours[ot.Type]
+    if !ok {
+        return fmt.Errorf("type must be %s, %s or %s", overtime.TypeRegular, overtime.TypeRestDay, overtime.TypePublicHoliday)
+    }
+
+	existingAttendance , err:= s.attrepo.GetAttendance(ctx, ot.UserID, ot.PeriodID, ot.Date)
     if err != nil {
-        return 0, err
+        return err
+    }
+
+    // regular overtime extends a worked day, rest day and public holiday overtime is the whole day
+    // and is paid through the overtime rate instead of an attendance day
+    if ot.Type == overtime.TypeRegular && existingAttendance.ID == 0 {
+        return fmt.Errorf("you need to submit attendance first before submitting overtime")
+    }
+    if ot.Type != overtime.TypeRegular && existingAttendance.ID != 0 {
+        return fmt.Errorf("attendance is already recorded on %s, submit it as %s overtime", ot.Date.Format("2006-01-02"), overtime.TypeRegular)
+    }
+    // attendance recorded with clock times bounds the overtime, date-only attendance can't be checked
+    if ot.Type == overtime.TypeRegular && existingAttendance.ClockIn != nil {
+        if existingAttendance.ClockOut == nil {
+            return fmt.Errorf("you need to clock out first before submitting overtime")
+        }
+        if ot.StartAt.Before(*existingAttendance.ClockIn) || ot.EndAt.After(*existingAttendance.ClockOut) {
+            return fmt.Errorf("overtime must be between your clock-in at %s and clock-out at %s",
+                existingAttendance.ClockIn.In(s.clockPolicy.Location).Format(time.RFC3339),
+                existingAttendance.ClockOut.In(s.clockPolicy.Location).Format(time.RFC3339))
+        }
+    }
+
+    overlapping, err := s.ovtrepo.GetOverlappingOvertime(ctx, ot.UserID, *ot.StartAt, *ot.EndAt, original.ID)
+    if err != nil {
+        return err
+    }
+    if overlapping.ID != 0 {
+        return fmt.Errorf("overtime overlaps your overtime from %s to %s",
+            overlapping.StartAt.In(s.clockPolicy.Location).Format(time.RFC3339),
+            overlapping.EndAt.In(s.clockPolicy.Location).Format(time.RFC3339))
+    }
+
+	attendancePeriod, err:= s.attrepo.GetAttendancePeriodByID(ctx, ot.PeriodID)
+    if err != nil {
+        return err
     }
     if attendancePeriod.ID == 0{
-        return 0, fmt.Errorf("period not found")
+        return fmt.Errorf("period not found")
+    }
+
+    if ot.Date.Before(attendancePeriod.StartDate) || ot.Date.After(attendancePeriod.EndDate) {
+        return fmt.Errorf("date must be between %s and %s", attendancePeriod.StartDate, attendancePeriod.EndDate)
+    }
+
+    switch ot.Type {
+    case overtime.TypeRestDay:
+        isRestDay, err := s.isRestDay(ctx, ot.UserID, ot.Date)
+        if err != nil {
+            return err
+        }
+        if !isRestDay {
+            return fmt.Errorf("%s is a working day in your schedule", ot.Date.Format("2006-01-02"))
+        }
+    case overtime.TypePublicHoliday:
+        holiday, err := s.schedrepo.GetPublicHolidayByDate(ctx, ot.Date)
+        if err != nil {
+            return err
+        }
+        if holiday.ID == 0 {
+            return fmt.Errorf("%s is not a public holiday", ot.Date.Format("2006-01-02"))
+        }
+    }
+
+    ot.Minutes = s.overtimePolicy.RoundMinutes(int(ot.EndAt.Sub(*ot.StartAt).Minutes()))
+    if ot.Minutes < 1 {
+        return fmt.Errorf("overtime must be at least %d minutes", s.overtimePolicy.RoundingMinutes)
+    }
+    if ot.LimitOverriddenBy != nil {
+        // an admin recording overtime above the limits has to say why, the reason is kept with the overtime
+        ot.LimitOverrideReason = strings.TrimSpace(ot.LimitOverrideReason)
+        if ot.LimitOverrideReason == "" {
+            return fmt.Errorf("override_reason is required to override the overtime limits")
+        }
+    } else {
+        ot.LimitOverrideReason = ""
+        err = s.checkOvertimeLimits(ctx, *ot, original, maxHours, attendancePeriod)
+        if err != nil {
+            return err
+        }
     }
+    return nil
+}
 
-    id, err := s.rmbrepo.InsertReimbursement(ctx, reimbursement)
+// overtimeWindow is a date range overtime is limited in, such as a day or a week
+type overtimeWindow struct {
+    name       string
+    from       time.Time
+    to         time.Time
+    limitHours int
+}
+
+// checkOvertimeLimits checks the overtime against the day limit of its type and, for regular overtime,
+// the weekly and period limits. Rest day and public holiday overtime doesn't count towards the
+// statutory weekly limit, it only has its own day limit. Pending and approved overtime both use up the allowance,
+// except the original overtime when it is being amended.
+func (s *employeeService) checkOvertimeLimits(ctx context.Context, ot overtime.Overtime, original overtime.Overtime, maxHours int, period attendance.AttendancePeriod) error {
+    weekStart := ot.Date.AddDate(0, 0, -((int(ot.Date.Weekday()) + 6) % 7))
+    windows := []overtimeWindow{
+        {name: "daily", from: ot.Date, to: ot.Date, limitHours: maxHours},
+    }
+    if ot.Type == overtime.TypeRegular {
+        windows = append(windows,
+            overtimeWindow{name: "weekly", from: weekStart, to: weekStart.AddDate(0, 0, 6), limitHours: s.overtimePolicy.WeeklyLimitHours},
+            overtimeWindow{name: "period", from: period.StartDate, to: period.EndDate, limitHours: s.overtimePolicy.PeriodLimitHours},
+        )
+    }
+
+    for _, window := range windows {
+        if window.limitHours <= 0 {
+            continue
+        }
+        usedMinutes, err := s.ovtrepo.GetOvertimeMinutesInRange(ctx, ot.UserID, ot.Type, window.from, window.to)
+        if err != nil {
+            return err
+        }
+        if original.ID != 0 && original.Type == ot.Type && !original.Date.Before(window.from) && !original.Date.After(window.to) {
+            usedMinutes -= original.Minutes
+        }
+        limitMinutes := window.limitHours * 60
+        if usedMinutes+ot.Minutes <= limitMinutes {
+            continue
+        }
+        remainingMinutes := limitMinutes - usedMinutes
+        if remainingMinutes < 0 {
+            remainingMinutes = 0
+        }
+        return fmt.Errorf("overtime of %s exceeds the %s limit of %d hours for %s to %s: %s already submitted, %s remaining",
+            formatMinutes(ot.Minutes), window.name, window.limitHours,
+            window.from.Format("2006-01-02"), window.to.Format("2006-01-02"),
+            formatMinutes(usedMinutes), formatMinutes(remainingMinutes))
+    }
+    return nil
+}
+
+// formatMinutes formats a duration in minutes as hours and minutes, e.g. 1h30m
+func formatMinutes(minutes int) string {
+    switch {
+    case minutes%60 == 0:
+        return fmt.Sprintf("%dh", minutes/60)
+    case minutes < 60:
+        return fmt.Sprintf("%dm", minutes)
+    default:
+        return fmt.Sprintf("%dh%02dm", minutes/60, minutes%60)
+    }
+}
+
+// isRestDay reports whether the date is a rest day in the employee's schedule,
+// employees without a schedule work every day and have no rest days
+func (s *employeeService) isRestDay(ctx context.Context, userID int, date time.Time) (bool, error) {
+    employeeSchedule, err := s.schedrepo.GetEmployeeScheduleByDate(ctx, userID, date)
     if err != nil {
-        return 0, err
+        return false, err
     }
+    return !employeeSchedule.IsWorkingDay(date), nil
+}
 
-    reimbursementJson, err := json.Marshal(reimbursement)
+// SubmitReimbursement checks the claim against its category's grade eligibility and limits,
+// a claim over a limit is rejected or, when the category allows it, paid up to the limit
+func (s *employeeService) SubmitReimbursement(ctx context.Context, rmb reimbursement.Reimbursement, receipts []reimbursement.Receipt, requestID int)(reimbursement.Reimbursement, error) {
+	attendancePeriod, err:= s.attrepo.GetAttendancePeriodByID(ctx, rmb.PeriodID)
     if err != nil {
-        return 0, err
+        return rmb, err
+    }
+    if attendancePeriod.ID == 0{
+        return rmb, fmt.Errorf("period not found")
+    }
+
+    if rmb.Category == "" {
+        return rmb, fmt.Errorf("category is required")
+    }
+    category, err := s.rmbrepo.GetCategoryByCode(ctx, rmb.Category)
+    if err != nil {
+        return rmb, err
+    }
+    if category.ID == 0 {
+        return rmb, fmt.Errorf("unknown reimbursement category %s", rmb.Category)
+    }
+
+    employee, err := s.userepo.GetUserByID(ctx, rmb.UserID)
+    if err != nil {
+        return rmb, err
+    }
+    if !category.IsEligible(employee.Grade) {
+        return rmb, fmt.Errorf("your grade is not eligible for %s reimbursements", category.Name)
+    }
+
+    receiptPolicy := s.receiptPolicy
+    if category.Calculation != "" {
+        err = s.calculateReimbursement(ctx, &rmb, category)
+        // the rate table replaces the receipt as proof of the amount, receipts are still accepted
+        receiptPolicy.MinFiles = 0
+    } else if rmb.Calculation != nil {
+        err = fmt.Errorf("%s claims take an amount, not a distance or travel days", category.Name)
+    } else {
+        err = s.convertReimbursement(ctx, &rmb, requestID)
+    }
+    if err != nil {
+        return rmb, err
+    }
+
+    usage, err := s.rmbrepo.GetCategoryUsage(ctx, rmb.UserID, category.ID, rmb.PeriodID, attendancePeriod.StartDate.Year())
+    if err != nil {
+        return rmb, err
+    }
+    rmb.CategoryID = &category.ID
+    rmb.RequestedAmount = rmb.Amount
+    allowed, limit := category.Allowed(rmb.Amount, usage)
+    if allowed < rmb.Amount {
+        if category.OverLimitAction == reimbursement.OverLimitReject || allowed == 0 {
+            return rmb, fmt.Errorf("claim of %d exceeds the %s %s limit, at most %d can be reimbursed", rmb.Amount, category.Name, limit, allowed)
+        }
+        rmb.Amount = allowed
+    }
+
+    // receipts are checked before anything is stored or written so a bad file is refused up front
+    checked, err := checkReceipts(receipts, receiptPolicy)
+    if err != nil {
+        return rmb, err
+    }
+
+    duplicateFlags, err := s.findDuplicates(ctx, rmb, checked)
+    if err != nil {
+        return rmb, err
+    }
+
+    // every claim waits for an admin review before it is paid, suspected duplicates are held
+    // so the reviewer sees them apart from the regular queue
+    rmb.Status = reimbursement.StatusSubmitted
+    if len(duplicateFlags) > 0 {
+        rmb.Status = reimbursement.StatusOnHold
+    }
+    rmb.DuplicateFlags = duplicateFlags
+
+    // receipts are stored first and the claim is written with them in one go, receipts of a claim
+    // that couldn't be written are removed again so nothing is left behind either way
+    folder, err := newReceiptFolder()
+    if err != nil {
+        return rmb, err
+    }
+    rmb.Attachments = []reimbursement.Attachment{}
+    for i, receipt := range checked {
+        attachment := receipt.attachment
+        attachment.UploadedBy = rmb.UserID
+        attachment.StorageKey = receiptKey(folder, i, attachment)
+        err = s.blobStore.Put(ctx, attachment.StorageKey, receipt.reader())
+        if err != nil {
+            s.deleteReceipts(ctx, rmb.Attachments)
+            return rmb, fmt.Errorf("failed to store receipt %s: %w", attachment.FileName, err)
+        }
+        rmb.Attachments = append(rmb.Attachments, attachment)
+    }
+
+    inserted, err := s.rmbrepo.InsertReimbursement(ctx, rmb)
+    if err != nil {
+        s.deleteReceipts(ctx, rmb.Attachments)
+        return rmb, err
+    }
+    rmb = inserted
+
+    reimbursementJson, err := json.Marshal(rmb)
+    if err != nil {
+        return rmb, err
     }
 
     log := audit.AuditLog{
         TableName: "reimbursements",
-        RecordID: id,
+        RecordID: rmb.ID,
         Action: "CREATE",
         OldData: []byte("{}"),
         NewData: reimbursementJson,
-        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(reimbursement.UserID)},
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(rmb.UserID)},
         RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
     }
     _, err= s.audsvc.RecordAuditLog(ctx, log)
     if err != nil {
-        return 0, err
+        return rmb, err
     }
-	return id, nil
+	return rmb, nil
+}
+
+// deleteReceipts removes stored receipt files, a file that can't be removed is only unused storage
+func (s *employeeService) deleteReceipts(ctx context.Context, attachments []reimbursement.Attachment) {
+    for _, attachment := range attachments {
+        _ = s.blobStore.Delete(ctx, attachment.StorageKey)
+    }
+}
+
+// findDuplicates compares a new claim with the same user's recent claims and with every receipt already submitted,
+// a claim is flagged at most once per earlier claim and reason
+func (s *employeeService) findDuplicates(ctx context.Context, rmb reimbursement.Reimbursement, receipts []checkedReceipt) ([]reimbursement.DuplicateFlag, error) {
+    flags := []reimbursement.DuplicateFlag{}
+
+    if s.duplicatePolicy.WindowDays > 0 {
+        since := time.Now().AddDate(0, 0, -s.duplicatePolicy.WindowDays)
+        recent, err := s.rmbrepo.GetRecentReimbursementsByUserID(ctx, rmb.UserID, since)
+        if err != nil {
+            return nil, err
+        }
+        flags = append(flags, reimbursement.SimilarClaims(rmb, recent, s.duplicatePolicy)...)
+    }
+
+    if len(receipts) == 0 {
+        return flags, nil
+    }
+    checksums := make([]string, 0, len(receipts))
+    for _, receipt := range receipts {
+        checksums = append(checksums, receipt.attachment.Checksum)
+    }
+    attachments, err := s.rmbrepo.GetAttachmentsByChecksums(ctx, checksums)
+    if err != nil {
+        return nil, err
+    }
+    flagged := map[int]bool{}
+    for _, attachment := range attachments {
+        if flagged[attachment.ReimbursementID] {
+            continue
+        }
+        flagged[attachment.ReimbursementID] = true
+        flags = append(flags, reimbursement.DuplicateFlag{
+            DuplicateOfID: attachment.ReimbursementID,
+            Reason: reimbursement.DuplicateReasonSameReceipt,
+            Similarity: 1,
+        })
+    }
+    return flags, nil
+}
+
+// calculateReimbursement computes the amount of a mileage or per diem claim from the rate in effect today,
+// whatever amount was typed in is ignored and the inputs and rate are kept on the claim
+func (s *employeeService) calculateReimbursement(ctx context.Context, rmb *reimbursement.Reimbursement, category reimbursement.Category) error {
+    if rmb.Calculation == nil {
+        rmb.Calculation = &reimbursement.Calculation{}
+    }
+    calculation := *rmb.Calculation
+    calculation.Method = category.Calculation
+    calculation.Destination = reimbursement.NormalizeDestination(calculation.Destination)
+
+    rateDestination := ""
+    switch calculation.Method {
+    case reimbursement.CalculationMileage:
+        calculation.DistanceKm = reimbursement.RoundDistance(calculation.DistanceKm)
+        if calculation.DistanceKm <= 0 {
+            return fmt.Errorf("distance_km must be greater than 0")
+        }
+        calculation.TravelDays = 0
+    case reimbursement.CalculationPerDiem:
+        if calculation.TravelDays <= 0 {
+            return fmt.Errorf("travel_days must be greater than 0")
+        }
+        if calculation.Destination == "" {
+            return fmt.Errorf("destination is required")
+        }
+        calculation.DistanceKm = 0
+        rateDestination = calculation.Destination
+    default:
+        return fmt.Errorf("unknown calculation %s", calculation.Method)
+    }
+
+    rate, err := s.rmbrepo.GetRate(ctx, calculation.Method, rateDestination, s.today())
+    if err != nil {
+        return err
+    }
+    if rate.ID == 0 {
+        return fmt.Errorf("there is no %s rate yet, ask an admin to add one", strings.ReplaceAll(calculation.Method, "_", " "))
+    }
+    calculation.RateID = rate.ID
+    calculation.UnitRate = rate.Rate
+
+    rmb.Calculation = &calculation
+    rmb.Currency = reimbursement.BaseCurrency
+    rmb.ExchangeRate = 1
+    rmb.ExchangeRateDate = nil
+    rmb.Amount = calculation.Amount()
+    if rmb.Amount <= 0 {
+        return fmt.Errorf("amount is less than 1 %s", reimbursement.BaseCurrency)
+    }
+    rmb.OriginalAmount = float64(rmb.Amount)
+    return nil
+}
+
+// today is the current date in the clock policy's time zone, kept as a UTC date like the DATE columns
+func (s *employeeService) today() time.Time {
+    now := time.Now()
+    if s.clockPolicy.Location != nil {
+        now = now.In(s.clockPolicy.Location)
+    }
+    return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// convertReimbursement sets the IDR amount of a claim from what was spent in its currency,
+// foreign currency claims use the latest rate that is not older than the rate policy allows
+func (s *employeeService) convertReimbursement(ctx context.Context, rmb *reimbursement.Reimbursement, requestID int) error {
+    rmb.Currency = strings.ToUpper(strings.TrimSpace(rmb.Currency))
+    if rmb.Currency == "" {
+        rmb.Currency = reimbursement.BaseCurrency
+    }
+    if !reimbursement.IsValidCurrency(rmb.Currency) {
+        return fmt.Errorf("currency must be a 3 letter ISO 4217 code")
+    }
+    if rmb.OriginalAmount <= 0 {
+        return fmt.Errorf("amount must be greater than 0")
+    }
+
+    if rmb.Currency == reimbursement.BaseCurrency {
+        if rmb.OriginalAmount != math.Trunc(rmb.OriginalAmount) {
+            return fmt.Errorf("%s amounts must be whole numbers", reimbursement.BaseCurrency)
+        }
+        rmb.ExchangeRate = 1
+        rmb.ExchangeRateDate = nil
+        rmb.Amount = int(rmb.OriginalAmount)
+        return nil
+    }
+
+    rate, err := s.exchangeRate(ctx, rmb.Currency, rmb.UserID, requestID)
+    if err != nil {
+        return err
+    }
+    rmb.ExchangeRate = rate.Rate
+    rmb.ExchangeRateDate = &rate.RateDate
+    rmb.Amount = rate.Convert(rmb.OriginalAmount)
+    if rmb.Amount <= 0 {
+        return fmt.Errorf("amount is less than 1 %s", reimbursement.BaseCurrency)
+    }
+    return nil
+}
+
+// exchangeRate looks up the rate of a currency for today, a missing or outdated rate is fetched
+// from the provider when one is configured and stored so later claims reuse it
+func (s *employeeService) exchangeRate(ctx context.Context, currency string, userID, requestID int) (reimbursement.ExchangeRate, error) {
+    today := s.today()
+
+    rate, err := s.rmbrepo.GetExchangeRate(ctx, currency, today)
+    if err != nil {
+        return rate, err
+    }
+    oldestAllowed := today.AddDate(0, 0, -s.ratePolicy.MaxAgeDays)
+    if rate.ID != 0 && (s.ratePolicy.MaxAgeDays <= 0 || !rate.RateDate.Before(oldestAllowed)) {
+        return rate, nil
+    }
+
+    if s.rateProvider == nil {
+        if rate.ID == 0 {
+            return rate, fmt.Errorf("there is no exchange rate for %s yet, ask an admin to add one", currency)
+        }
+        return rate, fmt.Errorf("the latest %s exchange rate is from %s, ask an admin to update it", currency, rate.RateDate.Format("2006-01-02"))
+    }
+
+    fetched, err := s.rateProvider.GetRate(ctx, currency)
+    if err != nil {
+        return fetched, err
+    }
+    return rates.SaveExchangeRate(ctx, s.rmbrepo, s.audsvc, fetched, userID, requestID)
+}
+
+// GetReimbursementAttachment opens a stored receipt, employees can only download receipts of their own claims.
+// A receipt of someone else's claim is reported as not found so its existence isn't leaked.
+func (s *employeeService) GetReimbursementAttachment(ctx context.Context, attachmentID int, userID int, isAdmin bool)(reimbursement.Attachment, io.ReadCloser, error) {
+    attachment, err := s.rmbrepo.GetAttachmentByID(ctx, attachmentID)
+    if err != nil {
+        return reimbursement.Attachment{}, nil, err
+    }
+    if attachment.ID == 0 {
+        return reimbursement.Attachment{}, nil, fmt.Errorf("attachment not found")
+    }
+
+    if !isAdmin {
+        rmb, err := s.rmbrepo.GetReimbursementByID(ctx, attachment.ReimbursementID)
+        if err != nil {
+            return reimbursement.Attachment{}, nil, err
+        }
+        if rmb.ID == 0 || rmb.UserID != userID {
+            return reimbursement.Attachment{}, nil, fmt.Errorf("attachment not found")
+        }
+    }
+
+    content, err := s.blobStore.Get(ctx, attachment.StorageKey)
+    if err != nil {
+        if err == blobstore.ErrNotFound {
+            return reimbursement.Attachment{}, nil, fmt.Errorf("attachment file is missing")
+        }
+        return reimbursement.Attachment{}, nil, err
+    }
+    return attachment, content, nil
+}
+
+// GetAttendances lists the employee's own attendances newest first, period 0 lists every period
+func (s *employeeService) GetAttendances(ctx context.Context, userID, periodID int, page pagination.Request)([]attendance.Attendance, int, error) {
+    err := s.checkPeriodFilter(ctx, periodID)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    attendances, total, err := s.attrepo.GetAttendancesByUserID(ctx, userID, periodID, page)
+    if err != nil {
+        return nil, 0, err
+    }
+    return attendances, total, nil
+}
+
+// GetOvertimes lists the employee's own overtime newest first, whatever its review status
+func (s *employeeService) GetOvertimes(ctx context.Context, userID, periodID int, page pagination.Request)([]overtime.Overtime, int, error) {
+    err := s.checkPeriodFilter(ctx, periodID)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    overtimes, total, err := s.ovtrepo.GetOvertimesByUserID(ctx, userID, periodID, page)
+    if err != nil {
+        return nil, 0, err
+    }
+    return overtimes, total, nil
+}
+
+// GetReimbursements lists the employee's own claims newest first with their receipts and review outcome
+func (s *employeeService) GetReimbursements(ctx context.Context, userID, periodID int, page pagination.Request)([]reimbursement.Reimbursement, int, error) {
+    err := s.checkPeriodFilter(ctx, periodID)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    reimbursements, total, err := s.rmbrepo.GetReimbursementsByUserID(ctx, userID, periodID, page)
+    if err != nil {
+        return nil, 0, err
+    }
+    return reimbursements, total, nil
+}
+
+func (s *employeeService) checkPeriodFilter(ctx context.Context, periodID int) error {
+    if periodID == 0 {
+        return nil
+    }
+    attendancePeriod, err := s.attrepo.GetAttendancePeriodByID(ctx, periodID)
+    if err != nil {
+        return err
+    }
+    if attendancePeriod.ID == 0 {
+        return fmt.Errorf("period not found")
+    }
+    return nil
+}
+
+// AmendAttendance changes the date or extra day flag of a date-only attendance while its period is still open,
+// clocked attendance keeps its clock times and has to be changed through an attendance correction
+func (s *employeeService) AmendAttendance(ctx context.Context, att attendance.Attendance, requestID int)(attendance.Attendance, error) {
+    existingAttendance, err := s.attrepo.GetAttendanceByID(ctx, att.ID)
+    if err != nil {
+        return att, err
+    }
+    if existingAttendance.ID == 0 || existingAttendance.UserID != att.UserID {
+        return att, fmt.Errorf("attendance not found")
+    }
+    if existingAttendance.ClockIn != nil {
+        return att, fmt.Errorf("clocked attendance can't be amended, request an attendance correction instead")
+    }
+    err = s.checkPeriodOpen(ctx, existingAttendance.PeriodID, "attendance")
+    if err != nil {
+        return att, err
+    }
+    err = s.checkNoPendingCorrection(ctx, existingAttendance)
+    if err != nil {
+        return att, err
+    }
+
+    attendancePeriod, err := s.attrepo.GetAttendancePeriodByID(ctx, existingAttendance.PeriodID)
+    if err != nil {
+        return att, err
+    }
+    if att.Date.Before(attendancePeriod.StartDate) || att.Date.After(attendancePeriod.EndDate) {
+        return att, fmt.Errorf("date must be between %s and %s", attendancePeriod.StartDate, attendancePeriod.EndDate)
+    }
+
+    if !att.Date.Equal(existingAttendance.Date) {
+        otherAttendance, err := s.attrepo.GetAttendance(ctx, att.UserID, existingAttendance.PeriodID, att.Date)
+        if err != nil {
+            return att, err
+        }
+        if otherAttendance.ID != 0 {
+            return att, fmt.Errorf("attendance already exists")
+        }
+
+        existingOvertime, err := s.ovtrepo.GetOvertime(ctx, att.UserID, existingAttendance.PeriodID, existingAttendance.Date)
+        if err != nil {
+            return att, err
+        }
+        if existingOvertime.ID != 0 {
+            return att, fmt.Errorf("overtime is recorded on %s, it has to be withdrawn before the attendance is moved", existingAttendance.Date.Format("2006-01-02"))
+        }
+    }
+
+    updatedAttendance := existingAttendance
+    updatedAttendance.Date = att.Date
+    updatedAttendance.IsExtraDay = att.IsExtraDay
+    employeeSchedule, err := s.scheduleFor(ctx, updatedAttendance)
+    if err != nil {
+        return att, err
+    }
+    updatedAttendance.IsExtraDay = updatedAttendance.IsExtraDay && !employeeSchedule.IsWorkingDay(updatedAttendance.Date)
+
+    err = s.attrepo.UpdateAttendance(ctx, updatedAttendance)
+    if err != nil {
+        return att, err
+    }
+
+    err = s.recordChange(ctx, "attendances", existingAttendance.ID, "UPDATE", existingAttendance, updatedAttendance, att.UserID, requestID)
+    if err != nil {
+        return att, err
+    }
+    return updatedAttendance, nil
+}
+
+// WithdrawAttendance removes the employee's own attendance while its period is still open,
+// overtime recorded on the same day has to be withdrawn first
+func (s *employeeService) WithdrawAttendance(ctx context.Context, attendanceID, userID, requestID int) error {
+    existingAttendance, err := s.attrepo.GetAttendanceByID(ctx, attendanceID)
+    if err != nil {
+        return err
+    }
+    if existingAttendance.ID == 0 || existingAttendance.UserID != userID {
+        return fmt.Errorf("attendance not found")
+    }
+    err = s.checkPeriodOpen(ctx, existingAttendance.PeriodID, "attendance")
+    if err != nil {
+        return err
+    }
+    err = s.checkNoPendingCorrection(ctx, existingAttendance)
+    if err != nil {
+        return err
+    }
+
+    existingOvertime, err := s.ovtrepo.GetOvertime(ctx, userID, existingAttendance.PeriodID, existingAttendance.Date)
+    if err != nil {
+        return err
+    }
+    if existingOvertime.ID != 0 {
+        return fmt.Errorf("overtime is recorded on %s, it has to be withdrawn before the attendance", existingAttendance.Date.Format("2006-01-02"))
+    }
+
+    err = s.attrepo.DeleteAttendance(ctx, existingAttendance.ID)
+    if err != nil {
+        return err
+    }
+
+    return s.recordChange(ctx, "attendances", existingAttendance.ID, "DELETE", existingAttendance, nil, userID, requestID)
+}
+
+// checkNoPendingCorrection refuses changes to an attendance while a correction of its date is waiting for review,
+// the review would otherwise apply to an attendance that has moved or is gone
+func (s *employeeService) checkNoPendingCorrection(ctx context.Context, att attendance.Attendance) error {
+    pendingCorrection, err := s.attrepo.GetPendingAttendanceCorrection(ctx, att.UserID, att.PeriodID, att.Date)
+    if err != nil {
+        return err
+    }
+    if pendingCorrection.ID != 0 {
+        return fmt.Errorf("a correction for %s is waiting for review", att.Date.Format("2006-01-02"))
+    }
+    return nil
+}
+
+// AmendOvertime changes the times or type of the employee's own pending overtime, the amended overtime
+// is checked like new overtime except that it doesn't overlap or count against itself
+func (s *employeeService) AmendOvertime(ctx context.Context, ot overtime.Overtime, requestID int)(overtime.Overtime, error) {
+    existingOvertime, err := s.ownPendingOvertime(ctx, ot.ID, ot.UserID)
+    if err != nil {
+        return ot, err
+    }
+    // overtime an admin recorded above the limits would have to pass the limits again
+    if existingOvertime.LimitOverriddenBy != nil {
+        return ot, fmt.Errorf("overtime recorded by an admin can't be amended, withdraw it and submit it again")
+    }
+
+    updatedOvertime := existingOvertime
+    updatedOvertime.StartAt = ot.StartAt
+    updatedOvertime.EndAt = ot.EndAt
+    updatedOvertime.Type = ot.Type
+    err = s.checkOvertime(ctx, &updatedOvertime, existingOvertime)
+    if err != nil {
+        return ot, err
+    }
+
+    err = s.ovtrepo.UpdateOvertime(ctx, updatedOvertime)
+    if err != nil {
+        return ot, err
+    }
+
+    err = s.recordChange(ctx, "overtimes", existingOvertime.ID, "UPDATE", existingOvertime, updatedOvertime, ot.UserID, requestID)
+    if err != nil {
+        return ot, err
+    }
+    return updatedOvertime, nil
+}
+
+// WithdrawOvertime removes the employee's own overtime before it is reviewed
+func (s *employeeService) WithdrawOvertime(ctx context.Context, overtimeID, userID, requestID int) error {
+    existingOvertime, err := s.ownPendingOvertime(ctx, overtimeID, userID)
+    if err != nil {
+        return err
+    }
+
+    err = s.ovtrepo.DeleteOvertime(ctx, existingOvertime.ID)
+    if err != nil {
+        return err
+    }
+
+    return s.recordChange(ctx, "overtimes", existingOvertime.ID, "DELETE", existingOvertime, nil, userID, requestID)
+}
+
+// ownPendingOvertime returns the user's overtime when it can still be changed,
+// reviewed overtime stays as it was reviewed
+func (s *employeeService) ownPendingOvertime(ctx context.Context, overtimeID, userID int) (overtime.Overtime, error) {
+    overtimes, err := s.ovtrepo.GetOvertimesByIDs(ctx, []int{overtimeID})
+    if err != nil {
+        return overtime.Overtime{}, err
+    }
+    if len(overtimes) == 0 || overtimes[0].UserID != userID {
+        return overtime.Overtime{}, fmt.Errorf("overtime not found")
+    }
+    existingOvertime := overtimes[0]
+    if existingOvertime.Status != overtime.StatusPending {
+        return overtime.Overtime{}, fmt.Errorf("overtime is already %s", existingOvertime.Status)
+    }
+    err = s.checkPeriodOpen(ctx, existingOvertime.PeriodID, "overtime")
+    if err != nil {
+        return overtime.Overtime{}, err
+    }
+    return existingOvertime, nil
+}
+
+// AmendReimbursement changes the amount, currency or description of a claim, or the distance, travel days
+// and destination of a calculated claim, while it waits for review. The amount is converted or calculated
+// again and checked against the category limits without the claim's own amount, its receipts and status stay.
+func (s *employeeService) AmendReimbursement(ctx context.Context, rmb reimbursement.Reimbursement, requestID int)(reimbursement.Reimbursement, error) {
+    existingReimbursement, err := s.ownReimbursementAwaitingReview(ctx, rmb.ID, rmb.UserID)
+    if err != nil {
+        return rmb, err
+    }
+
+    category, err := s.rmbrepo.GetCategoryByCode(ctx, existingReimbursement.Category)
+    if err != nil {
+        return rmb, err
+    }
+    if category.ID == 0 {
+        return rmb, fmt.Errorf("unknown reimbursement category %s", existingReimbursement.Category)
+    }
+
+    updatedReimbursement := existingReimbursement
+    updatedReimbursement.Currency = rmb.Currency
+    updatedReimbursement.OriginalAmount = rmb.OriginalAmount
+    updatedReimbursement.Description = rmb.Description
+    updatedReimbursement.Calculation = rmb.Calculation
+    if category.Calculation != "" {
+        err = s.calculateReimbursement(ctx, &updatedReimbursement, category)
+    } else if rmb.Calculation != nil {
+        err = fmt.Errorf("%s claims take an amount, not a distance or travel days", category.Name)
+    } else {
+        err = s.convertReimbursement(ctx, &updatedReimbursement, requestID)
+    }
+    if err != nil {
+        return rmb, err
+    }
+
+    attendancePeriod, err := s.attrepo.GetAttendancePeriodByID(ctx, existingReimbursement.PeriodID)
+    if err != nil {
+        return rmb, err
+    }
+    usage, err := s.rmbrepo.GetCategoryUsage(ctx, rmb.UserID, category.ID, existingReimbursement.PeriodID, attendancePeriod.StartDate.Year())
+    if err != nil {
+        return rmb, err
+    }
+    usage.PeriodTotal -= existingReimbursement.Amount
+    usage.YearTotal -= existingReimbursement.Amount
+    updatedReimbursement.RequestedAmount = updatedReimbursement.Amount
+    allowed, limit := category.Allowed(updatedReimbursement.Amount, usage)
+    if allowed < updatedReimbursement.Amount {
+        if category.OverLimitAction == reimbursement.OverLimitReject || allowed == 0 {
+            return rmb, fmt.Errorf("claim of %d exceeds the %s %s limit, at most %d can be reimbursed", updatedReimbursement.Amount, category.Name, limit, allowed)
+        }
+        updatedReimbursement.Amount = allowed
+    }
+
+    err = s.rmbrepo.UpdateReimbursementClaim(ctx, updatedReimbursement)
+    if err != nil {
+        return rmb, err
+    }
+
+    err = s.recordChange(ctx, "reimbursements", existingReimbursement.ID, "UPDATE", existingReimbursement, updatedReimbursement, rmb.UserID, requestID)
+    if err != nil {
+        return rmb, err
+    }
+    return updatedReimbursement, nil
+}
+
+// WithdrawReimbursement removes a claim that is still waiting for review together with its receipts
+func (s *employeeService) WithdrawReimbursement(ctx context.Context, reimbursementID, userID, requestID int) error {
+    existingReimbursement, err := s.ownReimbursementAwaitingReview(ctx, reimbursementID, userID)
+    if err != nil {
+        return err
+    }
+
+    err = s.rmbrepo.DeleteReimbursement(ctx, existingReimbursement.ID)
+    if err != nil {
+        return err
+    }
+    // the rows are gone with the claim, a receipt file left behind is only unused storage
+    for _, attachment := range existingReimbursement.Attachments {
+        _ = s.blobStore.Delete(ctx, attachment.StorageKey)
+    }
+
+    return s.recordChange(ctx, "reimbursements", existingReimbursement.ID, "DELETE", existingReimbursement, nil, userID, requestID)
+}
+
+// ownReimbursementAwaitingReview returns the user's claim when it can still be changed,
+// reviewed claims stay as they were reviewed
+func (s *employeeService) ownReimbursementAwaitingReview(ctx context.Context, reimbursementID, userID int) (reimbursement.Reimbursement, error) {
+    existingReimbursement, err := s.rmbrepo.GetReimbursementByID(ctx, reimbursementID)
+    if err != nil {
+        return existingReimbursement, err
+    }
+    if existingReimbursement.ID == 0 || existingReimbursement.UserID != userID {
+        return reimbursement.Reimbursement{}, fmt.Errorf("reimbursement not found")
+    }
+    if !existingReimbursement.IsAwaitingReview() {
+        return reimbursement.Reimbursement{}, fmt.Errorf("reimbursement is already %s", existingReimbursement.Status)
+    }
+    err = s.checkPeriodOpen(ctx, existingReimbursement.PeriodID, "reimbursements")
+    if err != nil {
+        return reimbursement.Reimbursement{}, err
+    }
+    return existingReimbursement, nil
+}
+
+// checkPeriodOpen refuses changes to submissions of a period payroll has already been generated for
+func (s *employeeService) checkPeriodOpen(ctx context.Context, periodID int, what string) error {
+    isProcessed, err := s.payrepo.PayslipExistsByPeriodID(ctx, periodID)
+    if err != nil {
+        return err
+    }
+    if isProcessed {
+        return fmt.Errorf("payroll already generated for this period, %s can no longer be changed", what)
+    }
+    return nil
+}
+
+// recordChange writes the audit log of an amended or withdrawn submission with the record as it was before,
+// a withdrawn record has no new data
+func (s *employeeService) recordChange(ctx context.Context, tableName string, recordID int, action string, oldRecord, newRecord any, userID, requestID int) error {
+    oldJson, err := json.Marshal(oldRecord)
+    if err != nil {
+        return err
+    }
+    newJson := []byte("{}")
+    if newRecord != nil {
+        newJson, err = json.Marshal(newRecord)
+        if err != nil {
+            return err
+        }
+    }
+
+    log := audit.AuditLog{
+        TableName: tableName,
+        RecordID: recordID,
+        Action: action,
+        OldData: oldJson,
+        NewData: newJson,
+        ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+        RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+    }
+    _, err = s.audsvc.RecordAuditLog(ctx, log)
+    return err
 }
 
 func (s *employeeService) GeneratePayslips(ctx context.Context, userID int)([]payslip.Payslip, error) {
@@ -0,0 +1,573 @@
+package employee
+
+import (
+	"context"
+	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/overtime"
+	"payslip-generation-system/internal/entity/reimbursement"
+	"payslip-generation-system/internal/entity/schedule"
+	usermodel "payslip-generation-system/internal/entity/user"
+	mockattrepo "payslip-generation-system/internal/repositories/attendance/mock"
+	mockovtrepo "payslip-generation-system/internal/repositories/overtime/mock"
+	mockrmbrepo "payslip-generation-system/internal/repositories/reimbursement/mock"
+	mockschedrepo "payslip-generation-system/internal/repositories/schedule/mock"
+	mockuserepo "payslip-generation-system/internal/repositories/user/mock"
+	mockaudsvc "payslip-generation-system/internal/services/audit/mock"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockLocation = time.FixedZone("WIB", 7*60*60)
+	mockPeriod   = attendance.AttendancePeriod{
+		ID:        6,
+		StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+	// a Mon-Fri shift starting at 08:00, 2025-06-02 is a Monday
+	mockSchedule = schedule.EmployeeSchedule{
+		ID:            1,
+		UserID:        10,
+		ShiftID:       1,
+		EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Shift: schedule.Shift{
+			ID:               1,
+			WorkPattern:      "1111100",
+			PatternStartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+			StartTime:        "08:00",
+		},
+	}
+)
+
+func localTime(day, hour, minute int) *time.Time {
+	t := time.Date(2025, 6, day, hour, minute, 0, 0, mockLocation)
+	return &t
+}
+
+func Test_employeeService_ClockIn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	clockPolicy := attendance.ClockPolicy{ShiftStart: 9 * time.Hour, MaxShiftDuration: 16 * time.Hour, Location: mockLocation}
+	monday := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		mock            func()
+		att             attendance.Attendance
+		wantLateMinutes int
+		wantExtraDay    bool
+		wantErr         bool
+	}{
+		{
+			name: "Happy Path - Late Against The Scheduled Shift",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, monday).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, monday).Return(mockSchedule, nil),
+					mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), gomock.Any()).Return(1, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			att:             attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(2, 8, 20)},
+			wantLateMinutes: 20,
+		},
+		{
+			name: "Happy Path - No Schedule Uses The Default Shift Start",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, saturday).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, saturday).Return(schedule.EmployeeSchedule{}, nil),
+					mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), gomock.Any()).Return(1, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			att:             attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(7, 9, 5)},
+			wantLateMinutes: 5,
+		},
+		{
+			name: "Happy Path - Extra Day On A Rest Day",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, saturday).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, saturday).Return(mockSchedule, nil),
+					mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), gomock.Any()).Return(1, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			att:             attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(7, 7, 50), IsExtraDay: true},
+			wantLateMinutes: 0,
+			wantExtraDay:    true,
+		},
+		{
+			name: "Error - Rest Day Without Extra Day Flag",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, saturday).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, saturday).Return(mockSchedule, nil),
+				)
+			},
+			att:     attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(7, 8, 0)},
+			wantErr: true,
+		},
+		{
+			name: "Error - Already Attended Today",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, monday).Return(attendance.Attendance{ID: 1}, nil),
+				)
+			},
+			att:     attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(2, 8, 0)},
+			wantErr: true,
+		},
+		{
+			name: "Error - Still Clocked In",
+			mock: func() {
+				mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{ID: 1, ClockIn: localTime(2, 8, 0)}, nil)
+			},
+			att:     attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(2, 20, 0)},
+			wantErr: true,
+		},
+		{
+			name: "Happy Path - Clock In Left Open Past The Longest Shift",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{ID: 1, ClockIn: localTime(2, 8, 0)}, nil),
+					mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)).Return(attendance.Attendance{}, nil),
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+					mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)).Return(mockSchedule, nil),
+					mockAttRepo.EXPECT().InsertAttendance(gomock.Any(), gomock.Any()).Return(1, nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			att: attendance.Attendance{UserID: 10, PeriodID: 6, ClockIn: localTime(3, 7, 55)},
+		},
+		{
+			name:    "Error - Missing Clock In",
+			mock:    func() {},
+			att:     attendance.Attendance{UserID: 10, PeriodID: 6},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewEmployeeService(mockAttRepo, nil, nil, nil, mockSchedRepo, nil, mockAudSvc, clockPolicy, overtime.Policy{}, nil, reimbursement.ReceiptPolicy{}, nil, reimbursement.ExchangeRatePolicy{}, reimbursement.DuplicatePolicy{}, nil)
+
+			got, err := s.ClockIn(context.Background(), tt.att, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 1, got.ID)
+			assert.Equal(t, tt.wantLateMinutes, got.LateMinutes)
+			assert.Equal(t, tt.wantExtraDay, got.IsExtraDay)
+		})
+	}
+}
+
+func Test_employeeService_ClockOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	clockPolicy := attendance.ClockPolicy{MaxShiftDuration: 16 * time.Hour, Location: mockLocation}
+	openAttendance := attendance.Attendance{ID: 1, UserID: 10, PeriodID: 6, ClockIn: localTime(2, 22, 0)}
+
+	tests := []struct {
+		name              string
+		mock              func()
+		clockOut          *time.Time
+		wantWorkedMinutes int
+		wantErr           bool
+	}{
+		{
+			name: "Happy Path - Overnight Shift",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(openAttendance, nil),
+					mockAttRepo.EXPECT().UpdateAttendanceClockOut(gomock.Any(), gomock.Any()).Return(nil),
+					mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+				)
+			},
+			clockOut:          localTime(3, 6, 30),
+			wantWorkedMinutes: 510,
+		},
+		{
+			name: "Error - Open Past The Longest Shift",
+			mock: func() {
+				mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(openAttendance, nil)
+			},
+			clockOut: localTime(3, 14, 1),
+			wantErr:  true,
+		},
+		{
+			name: "Error - Clock Out Before Clock In",
+			mock: func() {
+				mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(openAttendance, nil)
+			},
+			clockOut: localTime(2, 21, 0),
+			wantErr:  true,
+		},
+		{
+			name: "Error - Not Clocked In",
+			mock: func() {
+				mockAttRepo.EXPECT().GetOpenAttendance(gomock.Any(), 10, 6).Return(attendance.Attendance{}, nil)
+			},
+			clockOut: localTime(3, 6, 30),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewEmployeeService(mockAttRepo, nil, nil, nil, nil, nil, mockAudSvc, clockPolicy, overtime.Policy{}, nil, reimbursement.ReceiptPolicy{}, nil, reimbursement.ExchangeRatePolicy{}, reimbursement.DuplicatePolicy{}, nil)
+
+			got, err := s.ClockOut(context.Background(), attendance.Attendance{UserID: 10, PeriodID: 6, ClockOut: tt.clockOut}, 99)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantWorkedMinutes, got.WorkedMinutes)
+			assert.Equal(t, tt.clockOut, got.ClockOut)
+		})
+	}
+}
+
+func Test_employeeService_SubmitOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockOvtRepo := mockovtrepo.NewMockOvertimeRepositoryProvider(ctrl)
+	mockSchedRepo := mockschedrepo.NewMockScheduleRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	clockPolicy := attendance.ClockPolicy{Location: mockLocation}
+	overtimePolicy := overtime.Policy{
+		MaxHours: map[string]int{
+			overtime.TypeRegular:       4,
+			overtime.TypeRestDay:       8,
+			overtime.TypePublicHoliday: 8,
+		},
+		WeeklyLimitHours: 18,
+	}
+
+	// 2025-06-08 is the Sunday that ends the week of 2025-06-02, 2025-06-09 starts the next week
+	sunday := time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	dateOnlyAttendance := attendance.Attendance{ID: 3, UserID: 10, PeriodID: 6}
+
+	regularUntilChecks := func(date time.Time) {
+		mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, date).Return(dateOnlyAttendance, nil)
+		mockOvtRepo.EXPECT().GetOverlappingOvertime(gomock.Any(), 10, gomock.Any(), gomock.Any(), 0).Return(overtime.Overtime{}, nil)
+		mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil)
+	}
+
+	var inserted overtime.Overtime
+	insertOvertime := func() {
+		mockOvtRepo.EXPECT().InsertOvertime(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ot overtime.Overtime) (int, error) {
+			inserted = ot
+			return 7, nil
+		})
+	}
+
+	tests := []struct {
+		name        string
+		mock        func()
+		ot          overtime.Overtime
+		wantMinutes int
+		wantErr     string
+	}{
+		{
+			name: "Happy Path - Regular Overtime",
+			mock: func() {
+				regularUntilChecks(monday)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, monday, monday).Return(60, nil)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, monday, monday.AddDate(0, 0, 6)).Return(60, nil)
+				insertOvertime()
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			ot:          overtime.Overtime{UserID: 10, PeriodID: 6, StartAt: localTime(9, 18, 0), EndAt: localTime(9, 20, 30)},
+			wantMinutes: 150,
+		},
+		{
+			name: "Error - Over The Daily Limit",
+			mock: func() {
+				regularUntilChecks(monday)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, monday, monday).Return(180, nil)
+			},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, StartAt: localTime(9, 18, 0), EndAt: localTime(9, 20, 0)},
+			wantErr: "overtime of 2h exceeds the daily limit of 4 hours for 2025-06-09 to 2025-06-09: 3h already submitted, 1h remaining",
+		},
+		{
+			name: "Error - Over The Weekly Limit On The Last Day Of The Week",
+			mock: func() {
+				regularUntilChecks(sunday)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, sunday, sunday).Return(0, nil)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), sunday).Return(17*60, nil)
+			},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, StartAt: localTime(8, 18, 0), EndAt: localTime(8, 20, 0)},
+			wantErr: "overtime of 2h exceeds the weekly limit of 18 hours for 2025-06-02 to 2025-06-08: 17h already submitted, 1h remaining",
+		},
+		{
+			name: "Happy Path - First Day Of The Week Starts A New Weekly Allowance",
+			mock: func() {
+				regularUntilChecks(monday)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, monday, monday).Return(0, nil)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRegular, monday, monday.AddDate(0, 0, 6)).Return(0, nil)
+				insertOvertime()
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			ot:          overtime.Overtime{UserID: 10, PeriodID: 6, StartAt: localTime(9, 18, 0), EndAt: localTime(9, 20, 0)},
+			wantMinutes: 120,
+		},
+		{
+			name: "Happy Path - Rest Day Overtime Has Only A Day Limit",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, sunday).Return(attendance.Attendance{}, nil)
+				mockOvtRepo.EXPECT().GetOverlappingOvertime(gomock.Any(), 10, gomock.Any(), gomock.Any(), 0).Return(overtime.Overtime{}, nil)
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil)
+				mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, sunday).Return(mockSchedule, nil)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRestDay, sunday, sunday).Return(0, nil)
+				insertOvertime()
+				mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			ot:          overtime.Overtime{UserID: 10, PeriodID: 6, Type: overtime.TypeRestDay, StartAt: localTime(8, 8, 0), EndAt: localTime(8, 15, 0)},
+			wantMinutes: 420,
+		},
+		{
+			name: "Error - Rest Day Overtime Over The Day Limit",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, sunday).Return(attendance.Attendance{}, nil)
+				mockOvtRepo.EXPECT().GetOverlappingOvertime(gomock.Any(), 10, gomock.Any(), gomock.Any(), 0).Return(overtime.Overtime{}, nil)
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil)
+				mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, sunday).Return(mockSchedule, nil)
+				mockOvtRepo.EXPECT().GetOvertimeMinutesInRange(gomock.Any(), 10, overtime.TypeRestDay, sunday, sunday).Return(0, nil)
+			},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, Type: overtime.TypeRestDay, StartAt: localTime(8, 8, 0), EndAt: localTime(8, 17, 0)},
+			wantErr: "overtime of 9h exceeds the daily limit of 8 hours for 2025-06-08 to 2025-06-08: 0h already submitted, 8h remaining",
+		},
+		{
+			name: "Error - Rest Day Overtime Without A Schedule",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, sunday).Return(attendance.Attendance{}, nil)
+				mockOvtRepo.EXPECT().GetOverlappingOvertime(gomock.Any(), 10, gomock.Any(), gomock.Any(), 0).Return(overtime.Overtime{}, nil)
+				mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil)
+				mockSchedRepo.EXPECT().GetEmployeeScheduleByDate(gomock.Any(), 10, sunday).Return(schedule.EmployeeSchedule{}, nil)
+			},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, Type: overtime.TypeRestDay, StartAt: localTime(8, 8, 0), EndAt: localTime(8, 12, 0)},
+			wantErr: "2025-06-08 is a working day in your schedule",
+		},
+		{
+			name: "Error - Regular Overtime Without Attendance",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, monday).Return(attendance.Attendance{}, nil)
+			},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, StartAt: localTime(9, 18, 0), EndAt: localTime(9, 20, 0)},
+			wantErr: "you need to submit attendance first before submitting overtime",
+		},
+		{
+			name: "Error - Overlapping Overtime",
+			mock: func() {
+				mockAttRepo.EXPECT().GetAttendance(gomock.Any(), 10, 6, monday).Return(dateOnlyAttendance, nil)
+				mockOvtRepo.EXPECT().GetOverlappingOvertime(gomock.Any(), 10, gomock.Any(), gomock.Any(), 0).
+					Return(overtime.Overtime{ID: 2, StartAt: localTime(9, 19, 0), EndAt: localTime(9, 21, 0)}, nil)
+			},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, StartAt: localTime(9, 18, 0), EndAt: localTime(9, 20, 0)},
+			wantErr: "overtime overlaps your overtime from 2025-06-09T19:00:00+07:00 to 2025-06-09T21:00:00+07:00",
+		},
+		{
+			name:    "Error - Unknown Type",
+			mock:    func() {},
+			ot:      overtime.Overtime{UserID: 10, PeriodID: 6, Type: "night", StartAt: localTime(9, 18, 0), EndAt: localTime(9, 20, 0)},
+			wantErr: "type must be regular, rest_day or public_holiday",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := &employeeService{
+				attrepo:        mockAttRepo,
+				ovtrepo:        mockOvtRepo,
+				schedrepo:      mockSchedRepo,
+				audsvc:         mockAudSvc,
+				clockPolicy:    clockPolicy,
+				overtimePolicy: overtimePolicy,
+			}
+
+			got, err := s.SubmitOvertime(context.Background(), tt.ot, 99)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 7, got)
+			assert.Equal(t, tt.wantMinutes, inserted.Minutes)
+			assert.Equal(t, overtime.StatusPending, inserted.Status)
+		})
+	}
+}
+
+func Test_employeeService_SubmitReimbursement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	travel := reimbursement.Category{ID: 3, Code: "travel", Name: "Travel", PerClaimLimit: 500000, PerPeriodLimit: 1000000, PerYearLimit: 5000000, OverLimitAction: reimbursement.OverLimitReject}
+	partialTravel := travel
+	partialTravel.OverLimitAction = reimbursement.OverLimitPartial
+	managersOnly := travel
+	managersOnly.EligibleGrades = []string{"M1"}
+
+	untilUsage := func(category reimbursement.Category, usage reimbursement.CategoryUsage) {
+		gomock.InOrder(
+			mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+			mockRmbRepo.EXPECT().GetCategoryByCode(gomock.Any(), "travel").Return(category, nil),
+			mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 10).Return(usermodel.User{ID: 10, Grade: "S1"}, nil),
+			mockRmbRepo.EXPECT().GetCategoryUsage(gomock.Any(), 10, 3, 6, 2025).Return(usage, nil),
+		)
+	}
+	insertReimbursement := func() {
+		gomock.InOrder(
+			mockRmbRepo.EXPECT().InsertReimbursement(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, rmb reimbursement.Reimbursement) (reimbursement.Reimbursement, error) {
+				rmb.ID = 1
+				return rmb, nil
+			}),
+			mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil),
+		)
+	}
+
+	tests := []struct {
+		name          string
+		mock          func()
+		amount        float64
+		wantAmount    int
+		wantRequested int
+		wantErr       string
+	}{
+		{
+			name: "Happy Path - Within Every Limit",
+			mock: func() {
+				untilUsage(travel, reimbursement.CategoryUsage{PeriodTotal: 200000, YearTotal: 1200000})
+				insertReimbursement()
+			},
+			amount:        300000,
+			wantAmount:    300000,
+			wantRequested: 300000,
+		},
+		{
+			name: "Happy Path - Exactly At The Period Limit",
+			mock: func() {
+				untilUsage(travel, reimbursement.CategoryUsage{PeriodTotal: 600000, YearTotal: 600000})
+				insertReimbursement()
+			},
+			amount:        400000,
+			wantAmount:    400000,
+			wantRequested: 400000,
+		},
+		{
+			name: "Happy Path - Partial Category Pays Up To The Period Limit",
+			mock: func() {
+				untilUsage(partialTravel, reimbursement.CategoryUsage{PeriodTotal: 800000, YearTotal: 800000})
+				insertReimbursement()
+			},
+			amount:        450000,
+			wantAmount:    200000,
+			wantRequested: 450000,
+		},
+		{
+			name: "Happy Path - Partial Category Pays Up To The Claim Limit",
+			mock: func() {
+				untilUsage(partialTravel, reimbursement.CategoryUsage{})
+				insertReimbursement()
+			},
+			amount:        750000,
+			wantAmount:    500000,
+			wantRequested: 750000,
+		},
+		{
+			name: "Error - Over The Claim Limit",
+			mock: func() {
+				untilUsage(travel, reimbursement.CategoryUsage{})
+			},
+			amount:  500001,
+			wantErr: "claim of 500001 exceeds the Travel per claim limit, at most 500000 can be reimbursed",
+		},
+		{
+			name: "Error - Over The Year Limit",
+			mock: func() {
+				untilUsage(travel, reimbursement.CategoryUsage{PeriodTotal: 0, YearTotal: 4900000})
+			},
+			amount:  300000,
+			wantErr: "claim of 300000 exceeds the Travel per year limit, at most 100000 can be reimbursed",
+		},
+		{
+			name: "Error - Partial Category With Nothing Left",
+			mock: func() {
+				untilUsage(partialTravel, reimbursement.CategoryUsage{PeriodTotal: 1000000, YearTotal: 1000000})
+			},
+			amount:  100000,
+			wantErr: "claim of 100000 exceeds the Travel per period limit, at most 0 can be reimbursed",
+		},
+		{
+			name: "Error - Grade Not Eligible",
+			mock: func() {
+				gomock.InOrder(
+					mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(mockPeriod, nil),
+					mockRmbRepo.EXPECT().GetCategoryByCode(gomock.Any(), "travel").Return(managersOnly, nil),
+					mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 10).Return(usermodel.User{ID: 10, Grade: "S1"}, nil),
+				)
+			},
+			amount:  100000,
+			wantErr: "your grade is not eligible for Travel reimbursements",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			s := NewEmployeeService(mockAttRepo, nil, mockRmbRepo, nil, nil, mockUserRepo, mockAudSvc, attendance.ClockPolicy{Location: mockLocation}, overtime.Policy{}, nil, reimbursement.ReceiptPolicy{}, nil, reimbursement.ExchangeRatePolicy{}, reimbursement.DuplicatePolicy{}, nil)
+
+			rmb := reimbursement.Reimbursement{UserID: 10, PeriodID: 6, Category: "travel", OriginalAmount: tt.amount}
+			got, err := s.SubmitReimbursement(context.Background(), rmb, nil, 99)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAmount, got.Amount)
+			assert.Equal(t, tt.wantRequested, got.RequestedAmount)
+			assert.Equal(t, reimbursement.StatusSubmitted, got.Status)
+			assert.Equal(t, reimbursement.BaseCurrency, got.Currency)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package payslip
+
+import (
+	"context"
+	"fmt"
+	"payslip-generation-system/internal/disbursement"
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/entity/payslip"
+	usermodel "payslip-generation-system/internal/entity/user"
+	"strings"
+	"time"
+)
+
+// PreparePayments records the bank transfers that pay the payslips of a period, to be executed on executionDate.
+// Take home pay is split over the employee's salary allocations with the remainder going to their verified primary
+// bank account, which every employee with a remainder to pay needs. The control total and number of employees paid
+// have to match the payslip summary of the period. Once a batch was sent only the failed and returned payments are
+// paid again, see retryPayments. The payments replace those prepared before in one go and are pending until the
+// batch is marked sent, the disbursement file is written from them
+func (s *payslipService) PreparePayments(ctx context.Context, periodID int, executionDate time.Time, userID, requestID int) ([]payment.Payment, error) {
+	period, err := s.attrepo.GetAttendancePeriodByID(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+	if period.ID == 0 {
+		return nil, fmt.Errorf("period not found")
+	}
+
+	payslips, err := s.payrepo.GetPayslipsByPeriodID(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+	if len(payslips) == 0 {
+		return nil, fmt.Errorf("payroll has not been run for this period")
+	}
+	employeeByID, err := s.payslipEmployees(ctx, payslips)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.paymentrepo.GetPayments(ctx, periodID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// salaries are paid to the accounts verified and in effect on the day the transfers are made
+	accounts, err := s.paymentAccountsOn(ctx, executionDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []payment.Payment
+	if batchSent(existing) {
+		payments, err = retryPayments(periodID, executionDate, existing, accounts, employeeByID)
+	} else {
+		payments, err = s.payrollPayments(ctx, periodID, executionDate, payslips, accounts, employeeByID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the bank would refuse the whole file for one transfer it can't make, so it is checked before anything is recorded
+	err = s.disbursementBatch(payments, employeeByID, "").Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	removed, inserted, err := s.paymentrepo.ReplacePendingPayments(ctx, periodID, payments)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range removed {
+		err = s.recordChange(ctx, "payslip_payments", p.ID, "DELETE", p, struct{}{}, userID, requestID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range inserted {
+		err = s.recordChange(ctx, "payslip_payments", p.ID, "CREATE", struct{}{}, p, userID, requestID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return inserted, nil
+}
+
+// batchSent reports whether a batch of the period was handed to the bank, another batch of the whole payroll
+// could then pay the same payslips twice
+func batchSent(payments []payment.Payment) bool {
+	for _, p := range payments {
+		if p.Status != payment.StatusPending {
+			return true
+		}
+	}
+	return false
+}
+
+// payrollPayments splits the take home pay of every payslip of the period over the accounts it is paid into
+func (s *payslipService) payrollPayments(ctx context.Context, periodID int, executionDate time.Time, payslips []payslip.Payslip, accounts paymentAccounts, employeeByID map[int]usermodel.User) ([]payment.Payment, error) {
+	reference := fmt.Sprintf("PAYROLL-%d", periodID)
+	missing := []string{}
+	payments := []payment.Payment{}
+	total, paid := 0, 0
+	for _, p := range payslips {
+		// nothing is transferred for a payslip without take home pay, it isn't counted in the control total either
+		if p.TakeHomePay <= 0 {
+			continue
+		}
+		transfers, ok := accounts.transfers(p)
+		if !ok {
+			missing = append(missing, employeeByID[p.UserID].Username)
+			continue
+		}
+		for _, t := range transfers {
+			payments = append(payments, payment.Payment{
+				PayslipID:      p.ID,
+				PeriodID:       periodID,
+				UserID:         p.UserID,
+				Reference:      t.reference,
+				BatchReference: reference,
+				ExecutionDate:  executionDate,
+				BankCode:       t.account.BankCode,
+				AccountNumber:  t.account.AccountNumber,
+				AccountHolder:  t.account.AccountHolder,
+				Amount:         t.amount,
+				Status:         payment.StatusPending,
+			})
+			total += t.amount
+		}
+		paid++
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no verified bank account for %s", strings.Join(missing, ", "))
+	}
+
+	summary, err := s.payrepo.GetPayslipSummary(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+	summaryCount := 0
+	for _, perUser := range summary.PerUser {
+		if perUser.TotalTakeHome > 0 {
+			summaryCount++
+		}
+	}
+	if total != summary.Total || paid != summaryCount {
+		return nil, fmt.Errorf("transfers of %d for %d employees don't match the payslip summary of %d for %d employees",
+			total, paid, summary.Total, summaryCount)
+	}
+	return payments, nil
+}
+
+// retryPayments pays the failed and returned payments of a period again, each once, in a batch of its own. The
+// account that failed may be closed or wrong, so every retry goes to the primary account of the employee in effect
+// on the execution date, an allocation's share included. Retries are referenced PAYSLIP-<payslip id>-R<payment id>
+func retryPayments(periodID int, executionDate time.Time, existing []payment.Payment, accounts paymentAccounts, employeeByID map[int]usermodel.User) ([]payment.Payment, error) {
+	// pending retries are replaced, so only the ones that were sent count as paid again
+	retried := map[int]bool{}
+	batches := map[string]bool{}
+	for _, p := range existing {
+		if p.Status == payment.StatusPending {
+			continue
+		}
+		if p.RetryOf != nil {
+			retried[*p.RetryOf] = true
+		}
+		batches[p.BatchReference] = true
+	}
+	reference := fmt.Sprintf("PAYROLL-%d-R%d", periodID, len(batches))
+
+	missing := []string{}
+	payments := []payment.Payment{}
+	for _, p := range existing {
+		if (p.Status != payment.StatusFailed && p.Status != payment.StatusReturned) || retried[p.ID] {
+			continue
+		}
+		account, ok := accounts.primary[p.UserID]
+		if !ok {
+			missing = append(missing, employeeByID[p.UserID].Username)
+			continue
+		}
+		retryOf := p.ID
+		payments = append(payments, payment.Payment{
+			PayslipID:      p.PayslipID,
+			PeriodID:       periodID,
+			UserID:         p.UserID,
+			Reference:      fmt.Sprintf("PAYSLIP-%d-R%d", p.PayslipID, p.ID),
+			BatchReference: reference,
+			ExecutionDate:  executionDate,
+			BankCode:       account.BankCode,
+			AccountNumber:  account.AccountNumber,
+			AccountHolder:  account.AccountHolder,
+			Amount:         p.Amount,
+			Status:         payment.StatusPending,
+			RetryOf:        &retryOf,
+		})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no verified bank account for %s", strings.Join(missing, ", "))
+	}
+	if len(payments) == 0 {
+		return nil, fmt.Errorf("payments of this period have already been sent and none are left to pay again")
+	}
+	return payments, nil
+}
+
+// disbursementBatch is the batch the payments of one batch reference are sent to the bank in
+func (s *payslipService) disbursementBatch(payments []payment.Payment, employeeByID map[int]usermodel.User, description string) disbursement.Batch {
+	batch := disbursement.Batch{
+		CompanyCode: s.originator.CompanyCode,
+		Debtor:      s.originator.Account,
+		CreatedAt:   time.Now(),
+	}
+	if len(payments) > 0 {
+		batch.Reference = payments[0].BatchReference
+		batch.ExecutionDate = payments[0].ExecutionDate
+	}
+	for _, p := range payments {
+		batch.Instructions = append(batch.Instructions, disbursement.Instruction{
+			Reference:      p.Reference,
+			EmployeeNumber: employeeByID[p.UserID].EmployeeNumber,
+			Account: disbursement.Account{
+				BankCode: p.BankCode,
+				Number:   p.AccountNumber,
+				Holder:   p.AccountHolder,
+			},
+			Amount:      p.Amount,
+			Description: description,
+		})
+	}
+	return batch
+}
+
+// GetDisbursementFile writes the pending payments of a period in the given format, the file only reads the payments
+// prepared for it so downloading it again writes the same transfers
+func (s *payslipService) GetDisbursementFile(ctx context.Context, periodID int, format string) (string, []byte, error) {
+	if !disbursement.IsValidFormat(format) {
+		return "", nil, fmt.Errorf("unknown format %s", format)
+	}
+	period, err := s.attrepo.GetAttendancePeriodByID(ctx, periodID)
+	if err != nil {
+		return "", nil, err
+	}
+	if period.ID == 0 {
+		return "", nil, fmt.Errorf("period not found")
+	}
+
+	payments, err := s.paymentrepo.GetPayments(ctx, periodID, payment.StatusPending)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(payments) == 0 {
+		return "", nil, fmt.Errorf("no pending payments for this period, prepare the payments first")
+	}
+	payslips, err := s.payrepo.GetPayslipsByPeriodID(ctx, periodID)
+	if err != nil {
+		return "", nil, err
+	}
+	employeeByID, err := s.payslipEmployees(ctx, payslips)
+	if err != nil {
+		return "", nil, err
+	}
+
+	description := "Salary " + period.StartDate.Format("02/01/2006") + "-" + period.EndDate.Format("02/01/2006")
+	batch := s.disbursementBatch(payments, employeeByID, description)
+	content, err := disbursement.Encode(format, batch)
+	if err != nil {
+		return "", nil, err
+	}
+	return disbursement.FileName(format, batch), content, nil
+}
@@ -0,0 +1,126 @@
+package payslip
+
+import (
+	"context"
+	"fmt"
+	journalmodel "payslip-generation-system/internal/entity/journal"
+	"payslip-generation-system/internal/entity/payslip"
+	"payslip-generation-system/internal/journal"
+	"payslip-generation-system/internal/payslipdoc"
+	"strings"
+)
+
+// journalComponents maps the components of payslip lines to the journal components they are posted to. Payroll
+// withholds nothing yet, so there are no tax or BPJS lines to map and their payables are never credited
+var journalComponents = map[string]string{
+	payslip.ComponentAttendance:    journalmodel.ComponentSalary,
+	payslip.ComponentOvertime:      journalmodel.ComponentOvertime,
+	payslip.ComponentReimbursement: journalmodel.ComponentReimbursement,
+}
+
+// GetJournalAccounts lists the chart of accounts mapping the payroll journal is posted with
+func (s *payslipService) GetJournalAccounts(ctx context.Context) ([]journalmodel.Account, error) {
+	return s.journalrepo.GetJournalAccounts(ctx)
+}
+
+// SetJournalAccount maps a component of a cost center to an account, an empty cost center sets the account
+// used by every cost center without its own
+func (s *payslipService) SetJournalAccount(ctx context.Context, account journalmodel.Account, userID, requestID int) (journalmodel.Account, error) {
+	if !journalmodel.IsValidComponent(account.Component) {
+		return journalmodel.Account{}, fmt.Errorf("component must be one of %s", strings.Join(journalmodel.Components, ", "))
+	}
+	account.CostCenter = strings.TrimSpace(account.CostCenter)
+	account.Code = strings.TrimSpace(account.Code)
+	account.Name = strings.TrimSpace(account.Name)
+	if account.Code == "" || account.Name == "" {
+		return journalmodel.Account{}, fmt.Errorf("account_code and account_name are required")
+	}
+
+	existing, err := s.journalrepo.GetJournalAccount(ctx, account.Component, account.CostCenter)
+	if err != nil {
+		return journalmodel.Account{}, err
+	}
+	account.UpdatedBy = &userID
+	_, err = s.journalrepo.UpsertJournalAccount(ctx, account)
+	if err != nil {
+		return journalmodel.Account{}, err
+	}
+	updated, err := s.journalrepo.GetJournalAccount(ctx, account.Component, account.CostCenter)
+	if err != nil {
+		return journalmodel.Account{}, err
+	}
+
+	if existing.ID == 0 {
+		err = s.recordChange(ctx, "journal_accounts", updated.ID, "CREATE", struct{}{}, updated, userID, requestID)
+	} else {
+		err = s.recordChange(ctx, "journal_accounts", updated.ID, "UPDATE", existing, updated, userID, requestID)
+	}
+	if err != nil {
+		return journalmodel.Account{}, err
+	}
+	return updated, nil
+}
+
+// GetJournalFile writes the payroll of a period as a journal entry in the given format. What the payslips earn
+// is debited to the expense accounts and the take home pay is credited to net pay payable, each booked to the cost
+// center of the employee. Nothing is withheld from pay yet, so take home pay is all that is credited. A journal
+// that doesn't balance or has a line without an account is refused
+func (s *payslipService) GetJournalFile(ctx context.Context, periodID int, format string) (string, []byte, error) {
+	if !journal.IsValidFormat(format) {
+		return "", nil, fmt.Errorf("unknown format %s", format)
+	}
+	period, err := s.attrepo.GetAttendancePeriodByID(ctx, periodID)
+	if err != nil {
+		return "", nil, err
+	}
+	if period.ID == 0 {
+		return "", nil, fmt.Errorf("period not found")
+	}
+
+	payslips, err := s.payrepo.GetPayslipsByPeriodID(ctx, periodID)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(payslips) == 0 {
+		return "", nil, fmt.Errorf("payroll has not been run for this period")
+	}
+	employeeByID, err := s.payslipEmployees(ctx, payslips)
+	if err != nil {
+		return "", nil, err
+	}
+	accounts, err := s.journalrepo.GetJournalAccounts(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	postings := []journal.Posting{}
+	for _, p := range payslips {
+		costCenter := employeeByID[p.UserID].CostCenter
+		data := payslipdoc.Data{Payslip: p}
+		for _, item := range data.Earnings() {
+			component, ok := journalComponents[item.Component]
+			if !ok {
+				return "", nil, fmt.Errorf("payslip %d has a %s line that isn't posted to the journal", p.ID, item.Component)
+			}
+			postings = append(postings, journal.Posting{Component: component, CostCenter: costCenter, Amount: item.Amount})
+		}
+		postings = append(postings, journal.Posting{Component: journalmodel.ComponentNetPay, CostCenter: costCenter, Amount: p.TakeHomePay})
+	}
+
+	entry, err := journal.Build(journal.Journal{
+		Reference:   fmt.Sprintf("PAYROLL-%d", periodID),
+		PeriodID:    periodID,
+		PeriodStart: period.StartDate,
+		PeriodEnd:   period.EndDate,
+		Date:        period.EndDate,
+		Description: "Payroll " + period.StartDate.Format("02/01/2006") + "-" + period.EndDate.Format("02/01/2006"),
+	}, journal.NewChart(accounts), postings)
+	if err != nil {
+		return "", nil, err
+	}
+	content, err := journal.Encode(format, entry)
+	if err != nil {
+		return "", nil, err
+	}
+	return journal.FileName(format, entry), content, nil
+}
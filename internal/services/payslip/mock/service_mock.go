@@ -0,0 +1,345 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	io "io"
+	journal "payslip-generation-system/internal/entity/journal"
+	payment "payslip-generation-system/internal/entity/payment"
+	payslip "payslip-generation-system/internal/entity/payslip"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPayslipServiceProvider is a mock of PayslipServiceProvider interface.
+type MockPayslipServiceProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockPayslipServiceProviderMockRecorder
+}
+
+// MockPayslipServiceProviderMockRecorder is the mock recorder for MockPayslipServiceProvider.
+type MockPayslipServiceProviderMockRecorder struct {
+	mock *MockPayslipServiceProvider
+}
+
+// NewMockPayslipServiceProvider creates a new mock instance.
+func NewMockPayslipServiceProvider(ctrl *gomock.Controller) *MockPayslipServiceProvider {
+	mock := &MockPayslipServiceProvider{ctrl: ctrl}
+	mock.recorder = &MockPayslipServiceProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPayslipServiceProvider) EXPECT() *MockPayslipServiceProviderMockRecorder {
+	return m.recorder
+}
+
+// ActivateTemplate mocks base method.
+func (m *MockPayslipServiceProvider) ActivateTemplate(ctx context.Context, templateID, userID, requestID int) (payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateTemplate", ctx, templateID, userID, requestID)
+	ret0, _ := ret[0].(payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateTemplate indicates an expected call of ActivateTemplate.
+func (mr *MockPayslipServiceProviderMockRecorder) ActivateTemplate(ctx, templateID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTemplate", reflect.TypeOf((*MockPayslipServiceProvider)(nil).ActivateTemplate), ctx, templateID, userID, requestID)
+}
+
+// DeliverQueuedPayslips mocks base method.
+func (m *MockPayslipServiceProvider) DeliverQueuedPayslips(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverQueuedPayslips", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeliverQueuedPayslips indicates an expected call of DeliverQueuedPayslips.
+func (mr *MockPayslipServiceProviderMockRecorder) DeliverQueuedPayslips(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverQueuedPayslips", reflect.TypeOf((*MockPayslipServiceProvider)(nil).DeliverQueuedPayslips), ctx)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockPayslipServiceProvider) GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, periodID, status)
+	ret0, _ := ret[0].([]payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockPayslipServiceProviderMockRecorder) GetDeliveries(ctx, periodID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetDeliveries), ctx, periodID, status)
+}
+
+// GetDisbursementFile mocks base method.
+func (m *MockPayslipServiceProvider) GetDisbursementFile(ctx context.Context, periodID int, format string) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisbursementFile", ctx, periodID, format)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDisbursementFile indicates an expected call of GetDisbursementFile.
+func (mr *MockPayslipServiceProviderMockRecorder) GetDisbursementFile(ctx, periodID, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisbursementFile", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetDisbursementFile), ctx, periodID, format)
+}
+
+// GetJournalAccounts mocks base method.
+func (m *MockPayslipServiceProvider) GetJournalAccounts(ctx context.Context) ([]journal.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalAccounts", ctx)
+	ret0, _ := ret[0].([]journal.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalAccounts indicates an expected call of GetJournalAccounts.
+func (mr *MockPayslipServiceProviderMockRecorder) GetJournalAccounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalAccounts", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetJournalAccounts), ctx)
+}
+
+// GetJournalFile mocks base method.
+func (m *MockPayslipServiceProvider) GetJournalFile(ctx context.Context, periodID int, format string) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalFile", ctx, periodID, format)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetJournalFile indicates an expected call of GetJournalFile.
+func (mr *MockPayslipServiceProviderMockRecorder) GetJournalFile(ctx, periodID, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalFile", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetJournalFile), ctx, periodID, format)
+}
+
+// GetPayments mocks base method.
+func (m *MockPayslipServiceProvider) GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayments", ctx, periodID, status)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPayments indicates an expected call of GetPayments.
+func (mr *MockPayslipServiceProviderMockRecorder) GetPayments(ctx, periodID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayments", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetPayments), ctx, periodID, status)
+}
+
+// GetPayslipPDF mocks base method.
+func (m *MockPayslipServiceProvider) GetPayslipPDF(ctx context.Context, payslipID, userID int, isAdmin bool) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPayslipPDF", ctx, payslipID, userID, isAdmin)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPayslipPDF indicates an expected call of GetPayslipPDF.
+func (mr *MockPayslipServiceProviderMockRecorder) GetPayslipPDF(ctx, payslipID, userID, isAdmin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipPDF", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetPayslipPDF), ctx, payslipID, userID, isAdmin)
+}
+
+// GetPeriodPayslipsZip mocks base method.
+func (m *MockPayslipServiceProvider) GetPeriodPayslipsZip(ctx context.Context, periodID int) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeriodPayslipsZip", ctx, periodID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPeriodPayslipsZip indicates an expected call of GetPeriodPayslipsZip.
+func (mr *MockPayslipServiceProviderMockRecorder) GetPeriodPayslipsZip(ctx, periodID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeriodPayslipsZip", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetPeriodPayslipsZip), ctx, periodID)
+}
+
+// GetStatementLines mocks base method.
+func (m *MockPayslipServiceProvider) GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementLines", ctx, statementID, status)
+	ret0, _ := ret[0].([]payment.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementLines indicates an expected call of GetStatementLines.
+func (mr *MockPayslipServiceProviderMockRecorder) GetStatementLines(ctx, statementID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementLines", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetStatementLines), ctx, statementID, status)
+}
+
+// GetTemplates mocks base method.
+func (m *MockPayslipServiceProvider) GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", ctx, legalEntity)
+	ret0, _ := ret[0].([]payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates.
+func (mr *MockPayslipServiceProviderMockRecorder) GetTemplates(ctx, legalEntity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockPayslipServiceProvider)(nil).GetTemplates), ctx, legalEntity)
+}
+
+// ImportBankStatement mocks base method.
+func (m *MockPayslipServiceProvider) ImportBankStatement(ctx context.Context, fileName, format string, file io.Reader, userID, requestID int) (payment.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBankStatement", ctx, fileName, format, file, userID, requestID)
+	ret0, _ := ret[0].(payment.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBankStatement indicates an expected call of ImportBankStatement.
+func (mr *MockPayslipServiceProviderMockRecorder) ImportBankStatement(ctx, fileName, format, file, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBankStatement", reflect.TypeOf((*MockPayslipServiceProvider)(nil).ImportBankStatement), ctx, fileName, format, file, userID, requestID)
+}
+
+// MarkPaymentsSent mocks base method.
+func (m *MockPayslipServiceProvider) MarkPaymentsSent(ctx context.Context, periodID, userID, requestID int) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaymentsSent", ctx, periodID, userID, requestID)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaymentsSent indicates an expected call of MarkPaymentsSent.
+func (mr *MockPayslipServiceProviderMockRecorder) MarkPaymentsSent(ctx, periodID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaymentsSent", reflect.TypeOf((*MockPayslipServiceProvider)(nil).MarkPaymentsSent), ctx, periodID, userID, requestID)
+}
+
+// PreparePayments mocks base method.
+func (m *MockPayslipServiceProvider) PreparePayments(ctx context.Context, periodID int, executionDate time.Time, userID, requestID int) ([]payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreparePayments", ctx, periodID, executionDate, userID, requestID)
+	ret0, _ := ret[0].([]payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreparePayments indicates an expected call of PreparePayments.
+func (mr *MockPayslipServiceProviderMockRecorder) PreparePayments(ctx, periodID, executionDate, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreparePayments", reflect.TypeOf((*MockPayslipServiceProvider)(nil).PreparePayments), ctx, periodID, executionDate, userID, requestID)
+}
+
+// PreviewTemplate mocks base method.
+func (m *MockPayslipServiceProvider) PreviewTemplate(ctx context.Context, templateID int, content string, payslipID int, format string) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewTemplate", ctx, templateID, content, payslipID, format)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PreviewTemplate indicates an expected call of PreviewTemplate.
+func (mr *MockPayslipServiceProviderMockRecorder) PreviewTemplate(ctx, templateID, content, payslipID, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewTemplate", reflect.TypeOf((*MockPayslipServiceProvider)(nil).PreviewTemplate), ctx, templateID, content, payslipID, format)
+}
+
+// ResendPayslip mocks base method.
+func (m *MockPayslipServiceProvider) ResendPayslip(ctx context.Context, payslipID, userID, requestID int) (payslip.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendPayslip", ctx, payslipID, userID, requestID)
+	ret0, _ := ret[0].(payslip.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendPayslip indicates an expected call of ResendPayslip.
+func (mr *MockPayslipServiceProviderMockRecorder) ResendPayslip(ctx, payslipID, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendPayslip", reflect.TypeOf((*MockPayslipServiceProvider)(nil).ResendPayslip), ctx, payslipID, userID, requestID)
+}
+
+// ResolveStatementLine mocks base method.
+func (m *MockPayslipServiceProvider) ResolveStatementLine(ctx context.Context, lineID, paymentID int, note string, userID, requestID int) (payment.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStatementLine", ctx, lineID, paymentID, note, userID, requestID)
+	ret0, _ := ret[0].(payment.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveStatementLine indicates an expected call of ResolveStatementLine.
+func (mr *MockPayslipServiceProviderMockRecorder) ResolveStatementLine(ctx, lineID, paymentID, note, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStatementLine", reflect.TypeOf((*MockPayslipServiceProvider)(nil).ResolveStatementLine), ctx, lineID, paymentID, note, userID, requestID)
+}
+
+// SetJournalAccount mocks base method.
+func (m *MockPayslipServiceProvider) SetJournalAccount(ctx context.Context, account journal.Account, userID, requestID int) (journal.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetJournalAccount", ctx, account, userID, requestID)
+	ret0, _ := ret[0].(journal.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetJournalAccount indicates an expected call of SetJournalAccount.
+func (mr *MockPayslipServiceProviderMockRecorder) SetJournalAccount(ctx, account, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetJournalAccount", reflect.TypeOf((*MockPayslipServiceProvider)(nil).SetJournalAccount), ctx, account, userID, requestID)
+}
+
+// UpdatePaymentStatus mocks base method.
+func (m *MockPayslipServiceProvider) UpdatePaymentStatus(ctx context.Context, paymentID int, status, note string, userID, requestID int) (payment.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentStatus", ctx, paymentID, status, note, userID, requestID)
+	ret0, _ := ret[0].(payment.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentStatus indicates an expected call of UpdatePaymentStatus.
+func (mr *MockPayslipServiceProviderMockRecorder) UpdatePaymentStatus(ctx, paymentID, status, note, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentStatus", reflect.TypeOf((*MockPayslipServiceProvider)(nil).UpdatePaymentStatus), ctx, paymentID, status, note, userID, requestID)
+}
+
+// UploadTemplate mocks base method.
+func (m *MockPayslipServiceProvider) UploadTemplate(ctx context.Context, legalEntity, content string, userID, requestID int) (payslip.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTemplate", ctx, legalEntity, content, userID, requestID)
+	ret0, _ := ret[0].(payslip.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadTemplate indicates an expected call of UploadTemplate.
+func (mr *MockPayslipServiceProviderMockRecorder) UploadTemplate(ctx, legalEntity, content, userID, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTemplate", reflect.TypeOf((*MockPayslipServiceProvider)(nil).UploadTemplate), ctx, legalEntity, content, userID, requestID)
+}
@@ -0,0 +1,128 @@
+package payslip
+
+import (
+	"context"
+	"fmt"
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/entity/payslip"
+	"payslip-generation-system/internal/payslipdoc"
+	"sort"
+	"time"
+)
+
+// transfer is the part of a payslip's take home pay paid into one account
+type transfer struct {
+	reference string
+	account   bankaccount.BankAccount
+	amount    int
+	primary   bool
+}
+
+// paymentAccounts is where employees are paid on a given day, their primary account and the allocations
+// that send part of their pay elsewhere
+type paymentAccounts struct {
+	primary     map[int]bankaccount.BankAccount
+	allocations map[int][]bankaccount.Allocation
+}
+
+// paymentAccountsOn looks up the accounts verified and in effect on date, an allocation to an account that isn't
+// in effect yet is left out so its share goes to the primary account
+func (s *payslipService) paymentAccountsOn(ctx context.Context, date time.Time) (paymentAccounts, error) {
+	primaryAccounts, err := s.bankrepo.GetPrimaryAccounts(ctx, date)
+	if err != nil {
+		return paymentAccounts{}, err
+	}
+	allocations, err := s.bankrepo.GetAllocations(ctx, 0)
+	if err != nil {
+		return paymentAccounts{}, err
+	}
+
+	accounts := paymentAccounts{
+		primary:     map[int]bankaccount.BankAccount{},
+		allocations: map[int][]bankaccount.Allocation{},
+	}
+	for _, account := range primaryAccounts {
+		accounts.primary[account.UserID] = account
+	}
+	for _, allocation := range allocations {
+		if allocation.Account.Status != bankaccount.StatusVerified || allocation.Account.EffectiveDate.After(date) {
+			continue
+		}
+		accounts.allocations[allocation.UserID] = append(accounts.allocations[allocation.UserID], allocation)
+	}
+	return accounts, nil
+}
+
+// transfers splits the take home pay of a payslip over the employee's allocations, the remainder goes to the
+// primary account. ok is false when there is a remainder but no primary account to pay it into
+func (a paymentAccounts) transfers(p payslip.Payslip) ([]transfer, bool) {
+	if p.TakeHomePay <= 0 {
+		return []transfer{}, true
+	}
+	allocations := a.allocations[p.UserID]
+	amounts, remainder := bankaccount.Split(p.TakeHomePay, allocations)
+
+	transfers := []transfer{}
+	for i, allocation := range allocations {
+		if amounts[i] <= 0 {
+			continue
+		}
+		transfers = append(transfers, transfer{
+			reference: fmt.Sprintf("PAYSLIP-%d-%d", p.ID, allocation.ID),
+			account:   allocation.Account,
+			amount:    amounts[i],
+		})
+	}
+	if remainder <= 0 {
+		return transfers, true
+	}
+	primary, ok := a.primary[p.UserID]
+	if !ok {
+		return transfers, false
+	}
+	// the primary account comes first, like it does when it receives everything
+	return append([]transfer{{
+		reference: primaryReference(p.ID),
+		account:   primary,
+		amount:    remainder,
+		primary:   true,
+	}}, transfers...), true
+}
+
+// primaryReference is the reference of the transfer to the primary account of the employee a payslip is for
+func primaryReference(payslipID int) string {
+	return fmt.Sprintf("PAYSLIP-%d", payslipID)
+}
+
+// payslipPayments groups the payments of a period by payslip. Failed and returned payments paid nothing and are
+// left out, a retry that paid them again takes their place
+func payslipPayments(payments []payment.Payment) map[int][]payment.Payment {
+	byPayslip := map[int][]payment.Payment{}
+	for _, p := range payments {
+		if p.Status == payment.StatusFailed || p.Status == payment.StatusReturned {
+			continue
+		}
+		byPayslip[p.PayslipID] = append(byPayslip[p.PayslipID], p)
+	}
+	return byPayslip
+}
+
+// documentPayments is how a payslip shows where its take home pay is paid, from the payments recorded for it so
+// it shows what the disbursement file pays. The primary account comes first, a retry is paid into it as well
+func documentPayments(payments []payment.Payment) []payslipdoc.Payment {
+	documented := []payslipdoc.Payment{}
+	for _, p := range payments {
+		documented = append(documented, payslipdoc.Payment{
+			BankName:      bankaccount.Banks[p.BankCode].Name,
+			AccountNumber: p.AccountNumber,
+			AccountHolder: p.AccountHolder,
+			Amount:        p.Amount,
+			Primary:       p.Reference == primaryReference(p.PayslipID) || p.RetryOf != nil,
+		})
+	}
+	sort.SliceStable(documented, func(a, b int) bool {
+		return documented[a].Primary && !documented[b].Primary
+	})
+	return documented
+}
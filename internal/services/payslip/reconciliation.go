@@ -0,0 +1,334 @@
+package payslip
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"payslip-generation-system/internal/bankstatement"
+	"payslip-generation-system/internal/entity/payment"
+	"strings"
+)
+
+// MarkPaymentsSent records that the disbursement file of a period was handed to the bank, its payments are
+// sent until a bank statement or an admin settles them
+func (s *payslipService) MarkPaymentsSent(ctx context.Context, periodID, userID, requestID int) ([]payment.Payment, error) {
+	payments, err := s.paymentrepo.MarkPaymentsSent(ctx, periodID)
+	if err != nil {
+		return nil, err
+	}
+	if len(payments) == 0 {
+		return nil, fmt.Errorf("no pending payments for this period, prepare the payments first")
+	}
+
+	for _, p := range payments {
+		old := p
+		old.Status = payment.StatusPending
+		old.SentAt = nil
+		err = s.recordChange(ctx, "payslip_payments", p.ID, "UPDATE", old, p, userID, requestID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return payments, nil
+}
+
+// GetPayments lists the payments of a period, of every period with 0 and of one status with status
+func (s *payslipService) GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error) {
+	if status != "" && !payment.IsValidStatus(status) {
+		return nil, fmt.Errorf("invalid status %s", status)
+	}
+	return s.paymentrepo.GetPayments(ctx, periodID, status)
+}
+
+// UpdatePaymentStatus settles a payment by hand, e.g. when the bank reports a transfer failed without it
+// showing on a statement. A failed or returned payment needs a note saying why
+func (s *payslipService) UpdatePaymentStatus(ctx context.Context, paymentID int, status, note string, userID, requestID int) (payment.Payment, error) {
+	if status == payment.StatusSent {
+		return payment.Payment{}, fmt.Errorf("payments are sent by marking their batch sent")
+	}
+	if !payment.IsValidStatus(status) {
+		return payment.Payment{}, fmt.Errorf("invalid status %s", status)
+	}
+	note = strings.TrimSpace(note)
+	if (status == payment.StatusFailed || status == payment.StatusReturned) && note == "" {
+		return payment.Payment{}, fmt.Errorf("note is required for a %s payment", status)
+	}
+
+	existing, err := s.paymentrepo.GetPaymentByID(ctx, paymentID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	if existing.ID == 0 {
+		return payment.Payment{}, fmt.Errorf("payment not found")
+	}
+	if !payment.CanChangeStatus(existing.Status, status) {
+		return payment.Payment{}, fmt.Errorf("a %s payment can't become %s", existing.Status, status)
+	}
+	updated, err := s.settlePayment(ctx, existing, status, note, userID, requestID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	return updated, nil
+}
+
+// ImportBankStatement reads a bank statement and settles the payments its credits and reversals are for. A credit
+// pays a sent payment and the reversal of a credit returns it. The end to end reference of an entry picks the
+// payment, otherwise the account and amount have to point at exactly one. Entries that can't be matched are kept
+// as unmatched lines for an admin to resolve, debits are left out
+func (s *payslipService) ImportBankStatement(ctx context.Context, fileName, format string, file io.Reader, userID, requestID int) (payment.ImportReport, error) {
+	if !bankstatement.IsValidFormat(format) {
+		return payment.ImportReport{}, fmt.Errorf("format must be %s or %s", bankstatement.FormatCSV, bankstatement.FormatMT940)
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	exists, err := s.paymentrepo.StatementExists(ctx, checksum)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	if exists {
+		return payment.ImportReport{}, fmt.Errorf("this statement has already been imported")
+	}
+
+	entries, err := bankstatement.Parse(format, bytes.NewReader(content))
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	sent, err := s.paymentrepo.GetPayments(ctx, 0, payment.StatusSent)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	paid, err := s.paymentrepo.GetPayments(ctx, 0, payment.StatusPaid)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	matcher := newPaymentMatcher(append(sent, paid...))
+
+	// the statement, its lines and the payments they settle are written in one transaction, so an import that
+	// fails halfway leaves nothing behind and the same file can be imported again
+	statement := payment.Statement{FileName: fileName, Format: format, Checksum: checksum, ImportedBy: userID}
+	report := payment.ImportReport{Entries: len(entries), Unmatched: []payment.StatementLine{}}
+	lines := []payment.ImportedLine{}
+	settled := []payment.Payment{}
+	for _, entry := range entries {
+		if entry.Mark != bankstatement.MarkCredit && entry.Mark != bankstatement.MarkReversalCredit {
+			report.Skipped++
+			continue
+		}
+		imported := payment.ImportedLine{Line: payment.StatementLine{
+			LineNumber:    entry.Line,
+			ValueDate:     entry.ValueDate,
+			Mark:          entry.Mark,
+			Amount:        entry.Amount,
+			AccountNumber: entry.AccountNumber,
+			Reference:     entry.Reference,
+			Description:   entry.Description,
+			Status:        payment.LineStatusUnmatched,
+		}}
+
+		status := settledStatus(entry.Mark)
+		if p := matcher.match(entry, status); p != nil {
+			imported.Line.Status = payment.LineStatusMatched
+			imported.Settlement = &payment.Settlement{PaymentID: p.ID, From: p.Status, Status: status}
+			settled = append(settled, *p)
+			// a later line of the same statement sees the payment as settled, e.g. a credit and its reversal
+			p.Status = status
+			if status == payment.StatusPaid {
+				report.Paid++
+			} else {
+				report.Returned++
+			}
+		}
+		lines = append(lines, imported)
+	}
+
+	statement.ID, err = s.paymentrepo.ImportStatement(ctx, statement, lines)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	report.StatementID = statement.ID
+	err = s.recordChange(ctx, "bank_statements", statement.ID, "CREATE", struct{}{}, statement, userID, requestID)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	err = s.recordSettlements(ctx, settled, userID, requestID)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	report.Unmatched, err = s.paymentrepo.GetStatementLines(ctx, statement.ID, payment.LineStatusUnmatched)
+	if err != nil {
+		return payment.ImportReport{}, err
+	}
+	return report, nil
+}
+
+// recordSettlements logs the payments settled as they were read and as they are now, a payment a statement
+// settled twice, e.g. paid and then returned, is logged once per line
+func (s *payslipService) recordSettlements(ctx context.Context, settled []payment.Payment, userID, requestID int) error {
+	for _, old := range settled {
+		updated, err := s.paymentrepo.GetPaymentByID(ctx, old.ID)
+		if err != nil {
+			return err
+		}
+		err = s.recordChange(ctx, "payslip_payments", old.ID, "UPDATE", old, updated, userID, requestID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetStatementLines lists the lines of imported statements, of one statement with statementID and of one status
+// with status, e.g. unmatched for the lines waiting to be resolved
+func (s *payslipService) GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error) {
+	if status != "" && !payment.IsValidLineStatus(status) {
+		return nil, fmt.Errorf("invalid status %s", status)
+	}
+	return s.paymentrepo.GetStatementLines(ctx, statementID, status)
+}
+
+// ResolveStatementLine settles the payment an unmatched line is for, paymentID 0 dismisses a line that isn't
+// a salary payment, which needs a note saying what it is
+func (s *payslipService) ResolveStatementLine(ctx context.Context, lineID, paymentID int, note string, userID, requestID int) (payment.StatementLine, error) {
+	line, err := s.paymentrepo.GetStatementLineByID(ctx, lineID)
+	if err != nil {
+		return payment.StatementLine{}, err
+	}
+	if line.ID == 0 {
+		return payment.StatementLine{}, fmt.Errorf("statement line not found")
+	}
+	if line.Status != payment.LineStatusUnmatched {
+		return payment.StatementLine{}, fmt.Errorf("statement line has already been resolved")
+	}
+	note = strings.TrimSpace(note)
+
+	resolved := line
+	resolved.ResolvedBy = &userID
+	resolved.Note = note
+	var settlement *payment.Settlement
+	settled := []payment.Payment{}
+	if paymentID == 0 {
+		if note == "" {
+			return payment.StatementLine{}, fmt.Errorf("note is required to dismiss a statement line")
+		}
+		resolved.Status = payment.LineStatusDismissed
+	} else {
+		p, err := s.paymentrepo.GetPaymentByID(ctx, paymentID)
+		if err != nil {
+			return payment.StatementLine{}, err
+		}
+		if p.ID == 0 {
+			return payment.StatementLine{}, fmt.Errorf("payment not found")
+		}
+		if p.Amount != line.Amount {
+			return payment.StatementLine{}, fmt.Errorf("payment of %d doesn't match the line of %d", p.Amount, line.Amount)
+		}
+		status := settledStatus(line.Mark)
+		if !payment.CanChangeStatus(p.Status, status) {
+			return payment.StatementLine{}, fmt.Errorf("a %s payment can't become %s", p.Status, status)
+		}
+		if note == "" {
+			note = fmt.Sprintf("bank statement %d line %d", line.StatementID, line.LineNumber)
+		}
+		settlement = &payment.Settlement{PaymentID: p.ID, From: p.Status, Status: status, Note: note}
+		settled = append(settled, p)
+		resolved.Status = payment.LineStatusResolved
+		resolved.PaymentID = &p.ID
+	}
+
+	// the payment is settled together with the line, so neither changes without the other
+	err = s.paymentrepo.ResolveStatementLine(ctx, resolved, settlement)
+	if err != nil {
+		return payment.StatementLine{}, err
+	}
+	err = s.recordSettlements(ctx, settled, userID, requestID)
+	if err != nil {
+		return payment.StatementLine{}, err
+	}
+	err = s.recordChange(ctx, "bank_statement_lines", line.ID, "UPDATE", line, resolved, userID, requestID)
+	if err != nil {
+		return payment.StatementLine{}, err
+	}
+	return resolved, nil
+}
+
+// settlePayment changes the status of a payment that is still in the status it was read with
+func (s *payslipService) settlePayment(ctx context.Context, p payment.Payment, status, note string, userID, requestID int) (payment.Payment, error) {
+	err := s.paymentrepo.UpdatePaymentStatus(ctx, p.ID, []string{p.Status}, status, note)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	updated, err := s.paymentrepo.GetPaymentByID(ctx, p.ID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	err = s.recordChange(ctx, "payslip_payments", p.ID, "UPDATE", p, updated, userID, requestID)
+	if err != nil {
+		return payment.Payment{}, err
+	}
+	return updated, nil
+}
+
+// settledStatus is the status a statement entry settles a payment with, a credit pays it and the reversal
+// of a credit returns it
+func settledStatus(mark string) string {
+	if mark == bankstatement.MarkReversalCredit {
+		return payment.StatusReturned
+	}
+	return payment.StatusPaid
+}
+
+// paymentMatcher finds the payment a statement entry is for among the payments waiting to be settled
+type paymentMatcher struct {
+	byReference map[string]*payment.Payment
+	byAccount   map[string][]*payment.Payment
+}
+
+func newPaymentMatcher(payments []payment.Payment) *paymentMatcher {
+	m := &paymentMatcher{
+		byReference: map[string]*payment.Payment{},
+		byAccount:   map[string][]*payment.Payment{},
+	}
+	for i := range payments {
+		p := &payments[i]
+		m.byReference[strings.ToUpper(p.Reference)] = p
+		key := accountKey(p.AccountNumber, p.Amount)
+		m.byAccount[key] = append(m.byAccount[key], p)
+	}
+	return m
+}
+
+// match returns the payment the entry settles with status, nil when there isn't exactly one. A reference that
+// names a payment decides on its own, the amount still has to agree
+func (m *paymentMatcher) match(entry bankstatement.Entry, status string) *payment.Payment {
+	if p, ok := m.byReference[strings.ToUpper(entry.Reference)]; ok && entry.Reference != "" {
+		if p.Amount != entry.Amount || !payment.CanChangeStatus(p.Status, status) {
+			return nil
+		}
+		return p
+	}
+	if entry.AccountNumber == "" {
+		return nil
+	}
+	var found *payment.Payment
+	for _, p := range m.byAccount[accountKey(entry.AccountNumber, entry.Amount)] {
+		if !payment.CanChangeStatus(p.Status, status) {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = p
+	}
+	return found
+}
+
+func accountKey(accountNumber string, amount int) string {
+	return fmt.Sprintf("%s/%d", accountNumber, amount)
+}
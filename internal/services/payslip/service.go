@@ -0,0 +1,584 @@
+package payslip
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"payslip-generation-system/internal/disbursement"
+	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/audit"
+	journalmodel "payslip-generation-system/internal/entity/journal"
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/entity/payslip"
+	usermodel "payslip-generation-system/internal/entity/user"
+	"payslip-generation-system/internal/mailer"
+	"payslip-generation-system/internal/payslipdoc"
+	attrepo "payslip-generation-system/internal/repositories/attendance"
+	bankrepo "payslip-generation-system/internal/repositories/bankaccount"
+	journalrepo "payslip-generation-system/internal/repositories/journal"
+	paymentrepo "payslip-generation-system/internal/repositories/payment"
+	payrepo "payslip-generation-system/internal/repositories/payslip"
+	userepo "payslip-generation-system/internal/repositories/user"
+	audsvc "payslip-generation-system/internal/services/audit"
+	"strings"
+	"time"
+)
+
+const (
+	// deliveryBatchSize is how many payslips are emailed per run of the delivery worker, deliveryLease how long
+	// a claimed delivery is left alone before another run may try it, in case sending never finished
+	deliveryBatchSize = 20
+	deliveryLease     = 10 * time.Minute
+
+	// MaxTemplateSize is the largest template that can be uploaded
+	MaxTemplateSize = 256 << 10
+
+	PreviewFormatHTML = "html"
+	PreviewFormatPDF  = "pdf"
+)
+
+//go:generate mockgen -source=service.go -package=mock -destination=mock/service_mock.go
+type PayslipServiceProvider interface {
+	GetPayslipPDF(ctx context.Context, payslipID, userID int, isAdmin bool) (string, []byte, error)
+	GetPeriodPayslipsZip(ctx context.Context, periodID int) (string, []byte, error)
+	GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error)
+	UploadTemplate(ctx context.Context, legalEntity, content string, userID, requestID int) (payslip.Template, error)
+	ActivateTemplate(ctx context.Context, templateID, userID, requestID int) (payslip.Template, error)
+	PreviewTemplate(ctx context.Context, templateID int, content string, payslipID int, format string) (string, []byte, error)
+	DeliverQueuedPayslips(ctx context.Context) (int, error)
+	ResendPayslip(ctx context.Context, payslipID, userID, requestID int) (payslip.Delivery, error)
+	GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error)
+	PreparePayments(ctx context.Context, periodID int, executionDate time.Time, userID, requestID int) ([]payment.Payment, error)
+	GetDisbursementFile(ctx context.Context, periodID int, format string) (string, []byte, error)
+	MarkPaymentsSent(ctx context.Context, periodID, userID, requestID int) ([]payment.Payment, error)
+	GetPayments(ctx context.Context, periodID int, status string) ([]payment.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, paymentID int, status, note string, userID, requestID int) (payment.Payment, error)
+	ImportBankStatement(ctx context.Context, fileName, format string, file io.Reader, userID, requestID int) (payment.ImportReport, error)
+	GetStatementLines(ctx context.Context, statementID int, status string) ([]payment.StatementLine, error)
+	ResolveStatementLine(ctx context.Context, lineID, paymentID int, note string, userID, requestID int) (payment.StatementLine, error)
+	GetJournalAccounts(ctx context.Context) ([]journalmodel.Account, error)
+	SetJournalAccount(ctx context.Context, account journalmodel.Account, userID, requestID int) (journalmodel.Account, error)
+	GetJournalFile(ctx context.Context, periodID int, format string) (string, []byte, error)
+}
+
+type payslipService struct {
+	payrepo     payrepo.PayslipRepositoryProvider
+	attrepo     attrepo.AttendanceRepositoryProvider
+	userepo     userepo.UserRepositoryProvider
+	bankrepo    bankrepo.BankAccountRepositoryProvider
+	paymentrepo paymentrepo.PaymentRepositoryProvider
+	journalrepo journalrepo.JournalRepositoryProvider
+	audsvc      audsvc.AuditServiceProvider
+	company     payslipdoc.Company
+	// passwordRule protects payslip PDFs with a password per employee when it is set
+	passwordRule payslipdoc.PasswordRule
+	// sender emails payslips, nil when no SMTP server is configured
+	sender         mailer.Sender
+	deliveryPolicy payslip.DeliveryPolicy
+	// originator is the company account salaries are paid from
+	originator disbursement.Originator
+}
+
+func NewPayslipService(
+	payslipRepo payrepo.PayslipRepositoryProvider,
+	attendanceRepo attrepo.AttendanceRepositoryProvider,
+	userRepo userepo.UserRepositoryProvider,
+	bankAccountRepo bankrepo.BankAccountRepositoryProvider,
+	paymentRepo paymentrepo.PaymentRepositoryProvider,
+	journalRepo journalrepo.JournalRepositoryProvider,
+	auditService audsvc.AuditServiceProvider,
+	company payslipdoc.Company,
+	passwordRule payslipdoc.PasswordRule,
+	sender mailer.Sender,
+	deliveryPolicy payslip.DeliveryPolicy,
+	originator disbursement.Originator,
+) PayslipServiceProvider {
+	return &payslipService{
+		payrepo:        payslipRepo,
+		attrepo:        attendanceRepo,
+		userepo:        userRepo,
+		bankrepo:       bankAccountRepo,
+		paymentrepo:    paymentRepo,
+		journalrepo:    journalRepo,
+		audsvc:         auditService,
+		company:        company,
+		passwordRule:   passwordRule,
+		sender:         sender,
+		deliveryPolicy: deliveryPolicy,
+		originator:     originator,
+	}
+}
+
+// GetPayslipPDF renders a payslip as a PDF and returns its file name, employees can only get their own payslips.
+// A payslip of someone else is reported as not found so its existence isn't leaked.
+func (s *payslipService) GetPayslipPDF(ctx context.Context, payslipID, userID int, isAdmin bool) (string, []byte, error) {
+	p, err := s.payrepo.GetPayslipByID(ctx, payslipID)
+	if err != nil {
+		return "", nil, err
+	}
+	if p.ID == 0 || (!isAdmin && p.UserID != userID) {
+		return "", nil, fmt.Errorf("payslip not found")
+	}
+
+	data, content, err := s.renderPayslip(ctx, p)
+	if err != nil {
+		return "", nil, err
+	}
+	return payslipdoc.FileName(data), content, nil
+}
+
+// renderPayslip renders a payslip as a PDF with the template of the employee's legal entity
+func (s *payslipService) renderPayslip(ctx context.Context, p payslip.Payslip) (payslipdoc.Data, []byte, error) {
+	period, err := s.attrepo.GetAttendancePeriodByID(ctx, p.PeriodID)
+	if err != nil {
+		return payslipdoc.Data{}, nil, err
+	}
+	employee, err := s.userepo.GetUserByID(ctx, p.UserID)
+	if err != nil {
+		return payslipdoc.Data{}, nil, err
+	}
+
+	tmpl, err := s.templateFor(ctx, employee.LegalEntity)
+	if err != nil {
+		return payslipdoc.Data{}, nil, err
+	}
+	password, err := s.password(employee)
+	if err != nil {
+		return payslipdoc.Data{}, nil, err
+	}
+	payments, err := s.paymentrepo.GetPayments(ctx, p.PeriodID, "")
+	if err != nil {
+		return payslipdoc.Data{}, nil, err
+	}
+	data := s.documentData(p, employee, period, payslipPayments(payments)[p.ID])
+	content, err := tmpl.RenderPDF(data, password)
+	if err != nil {
+		return payslipdoc.Data{}, nil, err
+	}
+	return data, content, nil
+}
+
+// GetPeriodPayslipsZip renders every payslip of a period into one ZIP archive with a PDF per employee
+func (s *payslipService) GetPeriodPayslipsZip(ctx context.Context, periodID int) (string, []byte, error) {
+	period, err := s.attrepo.GetAttendancePeriodByID(ctx, periodID)
+	if err != nil {
+		return "", nil, err
+	}
+	if period.ID == 0 {
+		return "", nil, fmt.Errorf("period not found")
+	}
+
+	payslips, err := s.payrepo.GetPayslipsByPeriodID(ctx, periodID)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(payslips) == 0 {
+		return "", nil, fmt.Errorf("payroll has not been run for this period")
+	}
+
+	employeeByID, err := s.payslipEmployees(ctx, payslips)
+	if err != nil {
+		return "", nil, err
+	}
+
+	payments, err := s.paymentrepo.GetPayments(ctx, periodID, "")
+	if err != nil {
+		return "", nil, err
+	}
+	paymentsByPayslip := payslipPayments(payments)
+
+	// employees of the same legal entity share a template, it is parsed once
+	templates := map[string]*payslipdoc.Template{}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, p := range payslips {
+		employee := employeeByID[p.UserID]
+
+		tmpl, ok := templates[employee.LegalEntity]
+		if !ok {
+			tmpl, err = s.templateFor(ctx, employee.LegalEntity)
+			if err != nil {
+				return "", nil, err
+			}
+			templates[employee.LegalEntity] = tmpl
+		}
+
+		password, err := s.password(employee)
+		if err != nil {
+			return "", nil, err
+		}
+		data := s.documentData(p, employee, period, paymentsByPayslip[p.ID])
+		content, err := tmpl.RenderPDF(data, password)
+		if err != nil {
+			return "", nil, err
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     payslipdoc.FileName(data),
+			Method:   zip.Deflate,
+			Modified: data.GeneratedAt,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = w.Write(content)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	err = zw.Close()
+	if err != nil {
+		return "", nil, err
+	}
+
+	fileName := fmt.Sprintf("payslips-%s-%s.zip", period.StartDate.Format("2006-01-02"), period.EndDate.Format("2006-01-02"))
+	return fileName, buf.Bytes(), nil
+}
+
+// GetTemplates lists the uploaded versions of the payslip template of a legal entity, newest first
+func (s *payslipService) GetTemplates(ctx context.Context, legalEntity string) ([]payslip.Template, error) {
+	return s.payrepo.GetTemplates(ctx, strings.TrimSpace(legalEntity))
+}
+
+// UploadTemplate stores a new version of the template of a legal entity, an empty legal entity is the template
+// of the whole company. The template is rendered with a sample payslip first so a broken template is refused.
+// It is only used once it is activated
+func (s *payslipService) UploadTemplate(ctx context.Context, legalEntity, content string, userID, requestID int) (payslip.Template, error) {
+	if strings.TrimSpace(content) == "" {
+		return payslip.Template{}, fmt.Errorf("template is empty")
+	}
+	if len(content) > MaxTemplateSize {
+		return payslip.Template{}, fmt.Errorf("template must not be larger than %d KB", MaxTemplateSize>>10)
+	}
+	err := payslipdoc.Validate(content)
+	if err != nil {
+		return payslip.Template{}, fmt.Errorf("invalid template: %w", err)
+	}
+
+	tmpl := payslip.Template{
+		LegalEntity: strings.TrimSpace(legalEntity),
+		Content:     content,
+		CreatedBy:   &userID,
+	}
+	tmpl.ID, tmpl.Version, err = s.payrepo.InsertTemplate(ctx, tmpl)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+
+	err = s.recordChange(ctx, "payslip_templates", tmpl.ID, "CREATE", struct{}{}, tmpl, userID, requestID)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+	return tmpl, nil
+}
+
+// ActivateTemplate makes a version the template used for the payslips of its legal entity from now on,
+// the version that was active before is deactivated. Payslips rendered earlier are not affected
+func (s *payslipService) ActivateTemplate(ctx context.Context, templateID, userID, requestID int) (payslip.Template, error) {
+	existing, err := s.payrepo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+	if existing.ID == 0 {
+		return payslip.Template{}, fmt.Errorf("template not found")
+	}
+	if existing.IsActive {
+		return payslip.Template{}, fmt.Errorf("template is already active")
+	}
+
+	err = s.payrepo.ActivateTemplate(ctx, templateID, userID)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+	tmpl, err := s.payrepo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+
+	// the content doesn't change on activation, it is left out of the audit log
+	oldTemplate, newTemplate := existing, tmpl
+	oldTemplate.Content, newTemplate.Content = "", ""
+	err = s.recordChange(ctx, "payslip_templates", tmpl.ID, "UPDATE", oldTemplate, newTemplate, userID, requestID)
+	if err != nil {
+		return payslip.Template{}, err
+	}
+	return tmpl, nil
+}
+
+// PreviewTemplate renders a stored template, or content that isn't uploaded yet, as HTML or PDF and returns it with its file name.
+// The given payslip is rendered when there is one, a made up payslip otherwise
+func (s *payslipService) PreviewTemplate(ctx context.Context, templateID int, content string, payslipID int, format string) (string, []byte, error) {
+	if format == "" {
+		format = PreviewFormatPDF
+	}
+	if format != PreviewFormatHTML && format != PreviewFormatPDF {
+		return "", nil, fmt.Errorf("format must be %s or %s", PreviewFormatHTML, PreviewFormatPDF)
+	}
+
+	if templateID != 0 {
+		stored, err := s.payrepo.GetTemplateByID(ctx, templateID)
+		if err != nil {
+			return "", nil, err
+		}
+		if stored.ID == 0 {
+			return "", nil, fmt.Errorf("template not found")
+		}
+		content = stored.Content
+	}
+	if strings.TrimSpace(content) == "" {
+		return "", nil, fmt.Errorf("template_id or content is required")
+	}
+	if len(content) > MaxTemplateSize {
+		return "", nil, fmt.Errorf("template must not be larger than %d KB", MaxTemplateSize>>10)
+	}
+	tmpl, err := payslipdoc.Parse(content)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	data := payslipdoc.SampleData(s.company)
+	if payslipID != 0 {
+		p, err := s.payrepo.GetPayslipByID(ctx, payslipID)
+		if err != nil {
+			return "", nil, err
+		}
+		if p.ID == 0 {
+			return "", nil, fmt.Errorf("payslip not found")
+		}
+		period, err := s.attrepo.GetAttendancePeriodByID(ctx, p.PeriodID)
+		if err != nil {
+			return "", nil, err
+		}
+		employee, err := s.userepo.GetUserByID(ctx, p.UserID)
+		if err != nil {
+			return "", nil, err
+		}
+		payments, err := s.paymentrepo.GetPayments(ctx, p.PeriodID, "")
+		if err != nil {
+			return "", nil, err
+		}
+		data = s.documentData(p, employee, period, payslipPayments(payments)[p.ID])
+	}
+
+	var rendered []byte
+	if format == PreviewFormatHTML {
+		rendered, err = tmpl.RenderHTML(data)
+	} else {
+		// previews are for admins checking the layout, they aren't protected
+		rendered, err = tmpl.RenderPDF(data, "")
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	return "payslip-preview." + format, rendered, nil
+}
+
+// DeliverQueuedPayslips emails the payslips that are due and returns how many were sent. A failed email is tried
+// again later with a growing wait until the delivery policy gives up, the error is kept on the delivery
+func (s *payslipService) DeliverQueuedPayslips(ctx context.Context) (int, error) {
+	if s.sender == nil {
+		return 0, fmt.Errorf("email delivery is not configured")
+	}
+	deliveries, err := s.payrepo.ClaimDueDeliveries(ctx, deliveryBatchSize, deliveryLease)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, d := range deliveries {
+		err = s.deliver(ctx, d)
+		if err == nil {
+			err = s.payrepo.MarkDeliverySent(ctx, d.ID)
+			if err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		nextAttemptAt, retry := s.deliveryPolicy.NextAttempt(d.Attempts, time.Now())
+		if retry {
+			err = s.payrepo.RescheduleDelivery(ctx, d.ID, err.Error(), nextAttemptAt)
+		} else {
+			err = s.payrepo.MarkDeliveryFailed(ctx, d.ID, err.Error())
+		}
+		if err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// deliver emails one payslip as a PDF attachment
+func (s *payslipService) deliver(ctx context.Context, d payslip.Delivery) error {
+	p, err := s.payrepo.GetPayslipByID(ctx, d.PayslipID)
+	if err != nil {
+		return err
+	}
+	data, content, err := s.renderPayslip(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	name := data.Employee.FullName
+	if name == "" {
+		name = data.Employee.Username
+	}
+	periodText := payslipdoc.FormatDate(data.Period.StartDate) + " - " + payslipdoc.FormatDate(data.Period.EndDate)
+	body := fmt.Sprintf("Dear %s,\n\nYour payslip for %s is attached.\n", name, periodText)
+	if s.passwordRule.Enabled() {
+		body += "The PDF is protected with your payslip password.\n"
+	}
+	if s.company.Name != "" {
+		body += "\n" + s.company.Name + "\n"
+	}
+
+	return s.sender.Send(ctx, mailer.Message{
+		To:      d.Email,
+		Subject: "Your payslip for " + periodText,
+		Body:    body,
+		Attachments: []mailer.Attachment{
+			{FileName: payslipdoc.FileName(data), ContentType: "application/pdf", Content: content},
+		},
+	})
+}
+
+// ResendPayslip queues the email of a payslip again to the current address of the employee, whether it was sent,
+// failed or never queued because the payslip is older than email delivery. A queued email isn't resent, one a worker
+// holds or that waits for a retry could otherwise go out twice
+func (s *payslipService) ResendPayslip(ctx context.Context, payslipID, userID, requestID int) (payslip.Delivery, error) {
+	p, err := s.payrepo.GetPayslipByID(ctx, payslipID)
+	if err != nil {
+		return payslip.Delivery{}, err
+	}
+	if p.ID == 0 {
+		return payslip.Delivery{}, fmt.Errorf("payslip not found")
+	}
+
+	existing, err := s.payrepo.GetDeliveryByPayslipID(ctx, payslipID)
+	if err != nil {
+		return payslip.Delivery{}, err
+	}
+	if existing.Status == payslip.DeliveryStatusQueued && existing.Attempts == 0 {
+		return payslip.Delivery{}, fmt.Errorf("payslip is already queued to be sent")
+	}
+	if existing.Status == payslip.DeliveryStatusQueued && existing.NextAttemptAt.After(time.Now()) {
+		return payslip.Delivery{}, fmt.Errorf("payslip is being sent, try again after %s", existing.NextAttemptAt.Format("2006-01-02 15:04:05"))
+	}
+
+	err = s.payrepo.RequeueDelivery(ctx, payslipID)
+	if err != nil {
+		return payslip.Delivery{}, err
+	}
+	delivery, err := s.payrepo.GetDeliveryByPayslipID(ctx, payslipID)
+	if err != nil {
+		return payslip.Delivery{}, err
+	}
+
+	action := "UPDATE"
+	var oldRecord any = existing
+	if existing.ID == 0 {
+		action, oldRecord = "CREATE", struct{}{}
+	}
+	err = s.recordChange(ctx, "payslip_deliveries", delivery.ID, action, oldRecord, delivery, userID, requestID)
+	if err != nil {
+		return payslip.Delivery{}, err
+	}
+	return delivery, nil
+}
+
+// GetDeliveries lists the payslip emails and their status, 0 and an empty status list every period and status
+func (s *payslipService) GetDeliveries(ctx context.Context, periodID int, status string) ([]payslip.Delivery, error) {
+	if status != "" && !payslip.IsValidDeliveryStatus(status) {
+		return nil, fmt.Errorf("unknown status %s", status)
+	}
+	return s.payrepo.GetDeliveries(ctx, periodID, status)
+}
+
+// payslipEmployees returns the employees the payslips belong to by their ID
+func (s *payslipService) payslipEmployees(ctx context.Context, payslips []payslip.Payslip) (map[int]usermodel.User, error) {
+	employees, err := s.userepo.GetAllEmployees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	employeeByID := map[int]usermodel.User{}
+	for _, employee := range employees {
+		employeeByID[employee.ID] = employee
+	}
+	for _, p := range payslips {
+		if _, ok := employeeByID[p.UserID]; ok {
+			continue
+		}
+		// someone who is no longer listed as an employee still gets their payslip
+		employee, err := s.userepo.GetUserByID(ctx, p.UserID)
+		if err != nil {
+			return nil, err
+		}
+		employeeByID[p.UserID] = employee
+	}
+	return employeeByID, nil
+}
+
+// templateFor returns the template the payslips of a legal entity are rendered with,
+// the built-in layout when no template is active
+func (s *payslipService) templateFor(ctx context.Context, legalEntity string) (*payslipdoc.Template, error) {
+	active, err := s.payrepo.GetActiveTemplate(ctx, legalEntity)
+	if err != nil {
+		return nil, err
+	}
+	if active.ID == 0 {
+		return payslipdoc.Parse(payslipdoc.DefaultTemplate)
+	}
+	tmpl, err := payslipdoc.Parse(active.Content)
+	if err != nil {
+		return nil, fmt.Errorf("payslip template %d of %q is invalid: %w", active.Version, active.LegalEntity, err)
+	}
+	return tmpl, nil
+}
+
+// password returns the password the employee's payslip PDFs are protected with, an empty password when
+// payslips aren't protected. It is built every time from the employee's details and never stored
+func (s *payslipService) password(employee usermodel.User) (string, error) {
+	if !s.passwordRule.Enabled() {
+		return "", nil
+	}
+	return s.passwordRule.Password(employee)
+}
+
+func (s *payslipService) recordChange(ctx context.Context, tableName string, recordID int, action string, oldRecord, newRecord any, userID, requestID int) error {
+	oldJson, err := json.Marshal(oldRecord)
+	if err != nil {
+		return err
+	}
+	newJson, err := json.Marshal(newRecord)
+	if err != nil {
+		return err
+	}
+
+	log := audit.AuditLog{
+		TableName: tableName,
+		RecordID:  recordID,
+		Action:    action,
+		OldData:   oldJson,
+		NewData:   newJson,
+		ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+		RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+	}
+	_, err = s.audsvc.RecordAuditLog(ctx, log)
+	return err
+}
+
+func (s *payslipService) documentData(p payslip.Payslip, employee usermodel.User, period attendance.AttendancePeriod, payments []payment.Payment) payslipdoc.Data {
+	return payslipdoc.Data{
+		Company:     s.company,
+		Payslip:     p,
+		Employee:    employee,
+		Period:      period,
+		GeneratedAt: time.Now(),
+		Payments:    documentPayments(payments),
+	}
+}
@@ -0,0 +1,399 @@
+package payslip
+
+import (
+	"context"
+	"errors"
+	"payslip-generation-system/internal/disbursement"
+	"payslip-generation-system/internal/entity/attendance"
+	"payslip-generation-system/internal/entity/bankaccount"
+	"payslip-generation-system/internal/entity/payment"
+	"payslip-generation-system/internal/entity/payslip"
+	usermodel "payslip-generation-system/internal/entity/user"
+	"payslip-generation-system/internal/payslipdoc"
+	mockattrepo "payslip-generation-system/internal/repositories/attendance/mock"
+	mockbankrepo "payslip-generation-system/internal/repositories/bankaccount/mock"
+	mockpaymentrepo "payslip-generation-system/internal/repositories/payment/mock"
+	mockpayrepo "payslip-generation-system/internal/repositories/payslip/mock"
+	mockuserepo "payslip-generation-system/internal/repositories/user/mock"
+	mockaudsvc "payslip-generation-system/internal/services/audit/mock"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockExecutionDate = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	mockOriginator    = disbursement.Originator{
+		CompanyCode: "DEALLS",
+		Account:     disbursement.Account{BankCode: "014", Number: "9999999999", Holder: "PT Dealls"},
+	}
+	mockEmployees = []usermodel.User{
+		{ID: 10, Username: "budi", EmployeeNumber: "E010"},
+		{ID: 11, Username: "sari", EmployeeNumber: "E011"},
+	}
+	budiPrimary = bankaccount.BankAccount{ID: 1, UserID: 10, BankCode: "014", AccountNumber: "1234567890", AccountHolder: "Budi", IsPrimary: true, Status: bankaccount.StatusVerified}
+	budiSavings = bankaccount.BankAccount{ID: 2, UserID: 10, BankCode: "014", AccountNumber: "1111111111", AccountHolder: "Budi", Status: bankaccount.StatusVerified}
+	sariPrimary = bankaccount.BankAccount{ID: 3, UserID: 11, BankCode: "014", AccountNumber: "0987654321", AccountHolder: "Sari", IsPrimary: true, Status: bankaccount.StatusVerified}
+	sariSavings = bankaccount.BankAccount{ID: 4, UserID: 11, BankCode: "014", AccountNumber: "2222222222", AccountHolder: "Sari", Status: bankaccount.StatusVerified}
+	sariWallet  = bankaccount.BankAccount{ID: 5, UserID: 11, BankCode: "014", AccountNumber: "3333333333", AccountHolder: "Sari", Status: bankaccount.StatusVerified}
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// pendingPayment is a payment of period 6 as PreparePayments records it, before it has an id
+func pendingPayment(payslipID, userID int, reference, batch string, account bankaccount.BankAccount, amount int, retryOf *int) payment.Payment {
+	return payment.Payment{
+		PayslipID:      payslipID,
+		PeriodID:       6,
+		UserID:         userID,
+		Reference:      reference,
+		BatchReference: batch,
+		ExecutionDate:  mockExecutionDate,
+		BankCode:       account.BankCode,
+		AccountNumber:  account.AccountNumber,
+		AccountHolder:  account.AccountHolder,
+		Amount:         amount,
+		Status:         payment.StatusPending,
+		RetryOf:        retryOf,
+	}
+}
+
+func Test_payslipService_PreparePayments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayRepo := mockpayrepo.NewMockPayslipRepositoryProvider(ctrl)
+	mockAttRepo := mockattrepo.NewMockAttendanceRepositoryProvider(ctrl)
+	mockUserRepo := mockuserepo.NewMockUserRepositoryProvider(ctrl)
+	mockBankRepo := mockbankrepo.NewMockBankAccountRepositoryProvider(ctrl)
+	mockPaymentRepo := mockpaymentrepo.NewMockPaymentRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	budiPayslip := payslip.Payslip{ID: 100, UserID: 10, PeriodID: 6, TakeHomePay: 5000000}
+	sariPayslip := payslip.Payslip{ID: 101, UserID: 11, PeriodID: 6, TakeHomePay: 2500000}
+	budiFixed := bankaccount.Allocation{ID: 7, UserID: 10, BankAccountID: 2, Method: bankaccount.AllocationFixed, Amount: 1000000, Account: budiSavings}
+	sariSixty := bankaccount.Allocation{ID: 8, UserID: 11, BankAccountID: 4, Method: bankaccount.AllocationPercentage, Percentage: 60, Account: sariSavings}
+	sariForty := bankaccount.Allocation{ID: 9, UserID: 11, BankAccountID: 5, Method: bankaccount.AllocationPercentage, Percentage: 40, Account: sariWallet}
+	unverifiedSavings := budiFixed
+	unverifiedSavings.Account.Status = bankaccount.StatusPending
+
+	// sentPayment is a payment of the first batch of period 6 after it was handed to the bank
+	sentPayment := func(id int, p payment.Payment, status string) payment.Payment {
+		p.ID = id
+		p.Status = status
+		return p
+	}
+	budiPrimaryPayment := pendingPayment(100, 10, "PAYSLIP-100", "PAYROLL-6", budiPrimary, 4000000, nil)
+	budiSavingsPayment := pendingPayment(100, 10, "PAYSLIP-100-7", "PAYROLL-6", budiSavings, 1000000, nil)
+
+	setup := func(payslips []payslip.Payslip, existing []payment.Payment, primary []bankaccount.BankAccount, allocations []bankaccount.Allocation) {
+		gomock.InOrder(
+			mockAttRepo.EXPECT().GetAttendancePeriodByID(gomock.Any(), 6).Return(attendance.AttendancePeriod{ID: 6}, nil),
+			mockPayRepo.EXPECT().GetPayslipsByPeriodID(gomock.Any(), 6).Return(payslips, nil),
+			mockUserRepo.EXPECT().GetAllEmployees(gomock.Any()).Return(mockEmployees, nil),
+			mockPaymentRepo.EXPECT().GetPayments(gomock.Any(), 6, "").Return(existing, nil),
+			mockBankRepo.EXPECT().GetPrimaryAccounts(gomock.Any(), mockExecutionDate).Return(primary, nil),
+			mockBankRepo.EXPECT().GetAllocations(gomock.Any(), 0).Return(allocations, nil),
+		)
+	}
+	summary := func(total int, perUser ...payslip.PayslipSummary) {
+		mockPayRepo.EXPECT().GetPayslipSummary(gomock.Any(), 6).Return(payslip.PayslipSummaryReport{PerUser: perUser, Total: total}, nil)
+	}
+	var replaced []payment.Payment
+	replace := func(audits int) {
+		mockPaymentRepo.EXPECT().ReplacePendingPayments(gomock.Any(), 6, gomock.Any()).DoAndReturn(func(_ context.Context, _ int, payments []payment.Payment) ([]payment.Payment, []payment.Payment, error) {
+			replaced = payments
+			inserted := []payment.Payment{}
+			for i, p := range payments {
+				p.ID = 50 + i
+				inserted = append(inserted, p)
+			}
+			return nil, inserted, nil
+		})
+		mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil).Times(audits)
+	}
+
+	tests := []struct {
+		name         string
+		mock         func()
+		wantPayments []payment.Payment
+		wantErr      string
+	}{
+		{
+			name: "Happy Path - Fixed Allocation And The Remainder To The Primary Account",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{budiFixed})
+				summary(5000000, payslip.PayslipSummary{UserID: 10, TotalTakeHome: 5000000})
+				replace(2)
+			},
+			wantPayments: []payment.Payment{budiPrimaryPayment, budiSavingsPayment},
+		},
+		{
+			name: "Happy Path - Allocations Take The Whole Pay Without A Primary Account",
+			mock: func() {
+				setup([]payslip.Payslip{sariPayslip}, []payment.Payment{}, []bankaccount.BankAccount{}, []bankaccount.Allocation{sariSixty, sariForty})
+				summary(2500000, payslip.PayslipSummary{UserID: 11, TotalTakeHome: 2500000})
+				replace(2)
+			},
+			wantPayments: []payment.Payment{
+				pendingPayment(101, 11, "PAYSLIP-101-8", "PAYROLL-6", sariSavings, 1500000, nil),
+				pendingPayment(101, 11, "PAYSLIP-101-9", "PAYROLL-6", sariWallet, 1000000, nil),
+			},
+		},
+		{
+			name: "Happy Path - Unverified Allocation Is Paid To The Primary Account",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{unverifiedSavings})
+				summary(5000000, payslip.PayslipSummary{UserID: 10, TotalTakeHome: 5000000})
+				replace(1)
+			},
+			wantPayments: []payment.Payment{pendingPayment(100, 10, "PAYSLIP-100", "PAYROLL-6", budiPrimary, 5000000, nil)},
+		},
+		{
+			name: "Error - Remainder Without A Primary Account",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip, sariPayslip}, []payment.Payment{}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{sariSixty})
+			},
+			wantErr: "no verified bank account for sari",
+		},
+		{
+			name: "Error - Transfers Don't Match The Payslip Summary",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{budiFixed})
+				summary(5500000, payslip.PayslipSummary{UserID: 10, TotalTakeHome: 5500000})
+			},
+			wantErr: "transfers of 5000000 for 1 employees don't match the payslip summary of 5500000 for 1 employees",
+		},
+		{
+			name: "Happy Path - Retry Pays A Failed Allocation Into The Primary Account",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{
+					sentPayment(1, budiPrimaryPayment, payment.StatusPaid),
+					sentPayment(2, budiSavingsPayment, payment.StatusFailed),
+				}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{budiFixed})
+				replace(1)
+			},
+			wantPayments: []payment.Payment{pendingPayment(100, 10, "PAYSLIP-100-R2", "PAYROLL-6-R1", budiPrimary, 1000000, intPtr(2))},
+		},
+		{
+			name: "Happy Path - Pending Retry Is Replaced",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{
+					sentPayment(1, budiPrimaryPayment, payment.StatusPaid),
+					sentPayment(2, budiSavingsPayment, payment.StatusFailed),
+					sentPayment(3, pendingPayment(100, 10, "PAYSLIP-100-R2", "PAYROLL-6-R1", budiPrimary, 1000000, intPtr(2)), payment.StatusPending),
+				}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{budiFixed})
+				replace(1)
+			},
+			wantPayments: []payment.Payment{pendingPayment(100, 10, "PAYSLIP-100-R2", "PAYROLL-6-R1", budiPrimary, 1000000, intPtr(2))},
+		},
+		{
+			name: "Happy Path - Returned Retry Is Paid Again In The Next Batch",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{
+					sentPayment(1, budiPrimaryPayment, payment.StatusPaid),
+					sentPayment(2, budiSavingsPayment, payment.StatusFailed),
+					sentPayment(3, pendingPayment(100, 10, "PAYSLIP-100-R2", "PAYROLL-6-R1", budiPrimary, 1000000, intPtr(2)), payment.StatusReturned),
+				}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{budiFixed})
+				replace(1)
+			},
+			wantPayments: []payment.Payment{pendingPayment(100, 10, "PAYSLIP-100-R3", "PAYROLL-6-R2", budiPrimary, 1000000, intPtr(3))},
+		},
+		{
+			name: "Error - Failed Payment Already Retried",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{
+					sentPayment(1, budiPrimaryPayment, payment.StatusPaid),
+					sentPayment(2, budiSavingsPayment, payment.StatusFailed),
+					sentPayment(3, pendingPayment(100, 10, "PAYSLIP-100-R2", "PAYROLL-6-R1", budiPrimary, 1000000, intPtr(2)), payment.StatusSent),
+				}, []bankaccount.BankAccount{budiPrimary}, []bankaccount.Allocation{budiFixed})
+			},
+			wantErr: "payments of this period have already been sent and none are left to pay again",
+		},
+		{
+			name: "Error - Retry Without A Primary Account",
+			mock: func() {
+				setup([]payslip.Payslip{budiPayslip}, []payment.Payment{
+					sentPayment(1, budiPrimaryPayment, payment.StatusReturned),
+				}, []bankaccount.BankAccount{}, []bankaccount.Allocation{})
+			},
+			wantErr: "no verified bank account for budi",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replaced = nil
+			tt.mock()
+
+			s := NewPayslipService(mockPayRepo, mockAttRepo, mockUserRepo, mockBankRepo, mockPaymentRepo, nil, mockAudSvc, payslipdoc.Company{}, payslipdoc.PasswordRule{}, nil, payslip.DeliveryPolicy{}, mockOriginator)
+
+			got, err := s.PreparePayments(context.Background(), 6, mockExecutionDate, 1, 99)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPayments, replaced)
+			assert.Len(t, got, len(tt.wantPayments))
+		})
+	}
+}
+
+func Test_payslipService_ImportBankStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPaymentRepo := mockpaymentrepo.NewMockPaymentRepositoryProvider(ctrl)
+	mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+
+	// payments of two periods paid into the same accounts with the same amounts
+	sent := func(id int, account bankaccount.BankAccount, amount int, status string) payment.Payment {
+		p := pendingPayment(100+id, account.UserID, primaryReference(100+id), "PAYROLL-6", account, amount, nil)
+		p.ID = id
+		p.Status = status
+		return p
+	}
+	budiJune := sent(1, budiPrimary, 4000000, payment.StatusSent)
+	budiJuly := sent(2, budiPrimary, 4000000, payment.StatusSent)
+	budiJulyPaid := sent(2, budiPrimary, 4000000, payment.StatusPaid)
+	sariJuly := sent(3, sariPrimary, 4000000, payment.StatusSent)
+
+	importStatement := func(sentPayments, paidPayments []payment.Payment, settled int) {
+		gomock.InOrder(
+			mockPaymentRepo.EXPECT().StatementExists(gomock.Any(), gomock.Any()).Return(false, nil),
+			mockPaymentRepo.EXPECT().GetPayments(gomock.Any(), 0, payment.StatusSent).Return(sentPayments, nil),
+			mockPaymentRepo.EXPECT().GetPayments(gomock.Any(), 0, payment.StatusPaid).Return(paidPayments, nil),
+		)
+		mockPaymentRepo.EXPECT().GetPaymentByID(gomock.Any(), gomock.Any()).Return(payment.Payment{}, nil).Times(settled)
+		mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).Return(1, nil).Times(settled + 1)
+		mockPaymentRepo.EXPECT().GetStatementLines(gomock.Any(), 3, payment.LineStatusUnmatched).Return([]payment.StatementLine{}, nil)
+	}
+	var imported []payment.ImportedLine
+	mockPaymentRepo.EXPECT().ImportStatement(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ payment.Statement, lines []payment.ImportedLine) (int, error) {
+		imported = lines
+		return 3, nil
+	}).AnyTimes()
+
+	tests := []struct {
+		name            string
+		mock            func()
+		statement       string
+		wantSettlements []*payment.Settlement
+		wantPaid        int
+		wantReturned    int
+		wantSkipped     int
+		wantErr         string
+	}{
+		{
+			name: "Happy Path - Reference Picks One Of Two Equal Payments",
+			mock: func() {
+				importStatement([]payment.Payment{budiJune, budiJuly}, []payment.Payment{}, 1)
+			},
+			statement: "date,type,account_number,amount,reference\n" +
+				"2025-07-01,C,1234567890,4000000,PAYSLIP-102\n",
+			wantSettlements: []*payment.Settlement{{PaymentID: 2, From: payment.StatusSent, Status: payment.StatusPaid}},
+			wantPaid:        1,
+		},
+		{
+			name: "Happy Path - Account And Amount Pick The Payment When Another Account Has The Same Amount",
+			mock: func() {
+				importStatement([]payment.Payment{budiJune, sariJuly}, []payment.Payment{}, 1)
+			},
+			statement: "date,type,account_number,amount\n" +
+				"2025-07-01,C,0987654321,4000000\n",
+			wantSettlements: []*payment.Settlement{{PaymentID: 3, From: payment.StatusSent, Status: payment.StatusPaid}},
+			wantPaid:        1,
+		},
+		{
+			name: "Happy Path - Account And Amount Skip The Equal Payment That Was Already Paid",
+			mock: func() {
+				importStatement([]payment.Payment{budiJune}, []payment.Payment{budiJulyPaid}, 1)
+			},
+			statement: "date,type,account_number,amount\n" +
+				"2025-07-01,C,1234567890,4000000\n",
+			wantSettlements: []*payment.Settlement{{PaymentID: 1, From: payment.StatusSent, Status: payment.StatusPaid}},
+			wantPaid:        1,
+		},
+		{
+			name: "Happy Path - Two Equal Payments To One Account Are Left Unmatched",
+			mock: func() {
+				importStatement([]payment.Payment{budiJune, budiJuly}, []payment.Payment{}, 0)
+			},
+			statement: "date,type,account_number,amount\n" +
+				"2025-07-01,C,1234567890,4000000\n",
+			wantSettlements: []*payment.Settlement{nil},
+		},
+		{
+			name: "Happy Path - Credit And Its Reversal In One Statement",
+			mock: func() {
+				importStatement([]payment.Payment{sariJuly}, []payment.Payment{}, 2)
+			},
+			statement: "date,type,account_number,amount,reference\n" +
+				"2025-07-01,C,0987654321,4000000,PAYSLIP-103\n" +
+				"2025-07-01,D,9999999999,4000000,\n" +
+				"2025-07-02,RC,0987654321,4000000,PAYSLIP-103\n",
+			wantSettlements: []*payment.Settlement{
+				{PaymentID: 3, From: payment.StatusSent, Status: payment.StatusPaid},
+				{PaymentID: 3, From: payment.StatusPaid, Status: payment.StatusReturned},
+			},
+			wantPaid:     1,
+			wantReturned: 1,
+			wantSkipped:  1,
+		},
+		{
+			name: "Happy Path - Reference With Another Amount Is Left Unmatched",
+			mock: func() {
+				importStatement([]payment.Payment{sariJuly}, []payment.Payment{}, 0)
+			},
+			statement: "date,type,account_number,amount,reference\n" +
+				"2025-07-01,C,0987654321,3999999,PAYSLIP-103\n",
+			wantSettlements: []*payment.Settlement{nil},
+		},
+		{
+			name: "Error - Statement Already Imported",
+			mock: func() {
+				mockPaymentRepo.EXPECT().StatementExists(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			statement: "date,type,account_number,amount\n" +
+				"2025-07-01,C,1234567890,4000000\n",
+			wantErr: "this statement has already been imported",
+		},
+		{
+			name: "Error - Repository Error",
+			mock: func() {
+				mockPaymentRepo.EXPECT().StatementExists(gomock.Any(), gomock.Any()).Return(false, errors.New("db error"))
+			},
+			statement: "date,type,account_number,amount\n",
+			wantErr:   "db error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imported = nil
+			tt.mock()
+
+			s := NewPayslipService(nil, nil, nil, nil, mockPaymentRepo, nil, mockAudSvc, payslipdoc.Company{}, payslipdoc.PasswordRule{}, nil, payslip.DeliveryPolicy{}, mockOriginator)
+
+			report, err := s.ImportBankStatement(context.Background(), "statement.csv", "csv", strings.NewReader(tt.statement), 1, 99)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			settlements := []*payment.Settlement{}
+			for _, line := range imported {
+				settlements = append(settlements, line.Settlement)
+			}
+			assert.Equal(t, tt.wantSettlements, settlements)
+			assert.Equal(t, 3, report.StatementID)
+			assert.Equal(t, tt.wantPaid, report.Paid)
+			assert.Equal(t, tt.wantReturned, report.Returned)
+			assert.Equal(t, tt.wantSkipped, report.Skipped)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rates
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"payslip-generation-system/internal/entity/audit"
+	"payslip-generation-system/internal/entity/reimbursement"
+	rmbrepo "payslip-generation-system/internal/repositories/reimbursement"
+	audsvc "payslip-generation-system/internal/services/audit"
+)
+
+// SaveExchangeRate stores the rate of a currency on a date and records it in the audit log, a second rate of the
+// same currency and date replaces the first. Rates entered by an admin and rates fetched from the provider, by
+// an admin or when a claim needs one, are all saved through here
+func SaveExchangeRate(ctx context.Context, repo rmbrepo.ReimbursementRepositoryProvider, auditService audsvc.AuditServiceProvider, rate reimbursement.ExchangeRate, userID, requestID int) (reimbursement.ExchangeRate, error) {
+	existingRate, err := repo.GetExchangeRate(ctx, rate.Currency, rate.RateDate)
+	if err != nil {
+		return rate, err
+	}
+
+	rate.ID, err = repo.UpsertExchangeRate(ctx, rate)
+	if err != nil {
+		return rate, err
+	}
+
+	// the rate found is the latest up to the date, it is only replaced when it is of the same date
+	var old any
+	if existingRate.ID != 0 && existingRate.RateDate.Equal(rate.RateDate) {
+		old = existingRate
+	}
+	err = recordChange(ctx, auditService, "exchange_rates", rate.ID, old, rate, userID, requestID)
+	if err != nil {
+		return rate, err
+	}
+	return rate, nil
+}
+
+// SaveRate stores a mileage or per diem rate from its effective date on and records it in the audit log, a second
+// rate for the same destination and date replaces the first
+func SaveRate(ctx context.Context, repo rmbrepo.ReimbursementRepositoryProvider, auditService audsvc.AuditServiceProvider, rate reimbursement.Rate, userID, requestID int) (reimbursement.Rate, error) {
+	existingRate, err := repo.GetRate(ctx, rate.Calculation, rate.Destination, rate.EffectiveFrom)
+	if err != nil {
+		return rate, err
+	}
+
+	rate.ID, err = repo.UpsertRate(ctx, rate)
+	if err != nil {
+		return rate, err
+	}
+
+	// a per diem destination without its own rate falls back to the default one, which isn't replaced
+	var old any
+	if existingRate.ID != 0 && existingRate.Destination == rate.Destination && existingRate.EffectiveFrom.Equal(rate.EffectiveFrom) {
+		old = existingRate
+	}
+	err = recordChange(ctx, auditService, "reimbursement_rates", rate.ID, old, rate, userID, requestID)
+	if err != nil {
+		return rate, err
+	}
+	return rate, nil
+}
+
+// recordChange logs a saved rate, as created when old is nil and as updated otherwise
+func recordChange(ctx context.Context, auditService audsvc.AuditServiceProvider, tableName string, recordID int, old, new any, userID, requestID int) error {
+	action := "CREATE"
+	oldJson := []byte("{}")
+	if old != nil {
+		action = "UPDATE"
+		var err error
+		oldJson, err = json.Marshal(old)
+		if err != nil {
+			return err
+		}
+	}
+	newJson, err := json.Marshal(new)
+	if err != nil {
+		return err
+	}
+
+	log := audit.AuditLog{
+		TableName: tableName,
+		RecordID:  recordID,
+		Action:    action,
+		OldData:   oldJson,
+		NewData:   newJson,
+		ChangedBy: sql.NullInt32{Valid: true, Int32: int32(userID)},
+		RequestID: sql.NullInt32{Valid: true, Int32: int32(requestID)},
+	}
+	_, err = auditService.RecordAuditLog(ctx, log)
+	return err
+}
@@ -0,0 +1,64 @@
+package rates
+
+import (
+	"context"
+	"payslip-generation-system/internal/entity/audit"
+	"payslip-generation-system/internal/entity/reimbursement"
+	mockrmbrepo "payslip-generation-system/internal/repositories/reimbursement/mock"
+	mockaudsvc "payslip-generation-system/internal/services/audit/mock"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveRate(t *testing.T) {
+	effectiveFrom := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	rate := reimbursement.Rate{Calculation: reimbursement.CalculationPerDiem, Destination: "bali", Rate: 500000, EffectiveFrom: effectiveFrom}
+
+	tests := []struct {
+		name     string
+		existing reimbursement.Rate
+		action   string
+	}{
+		{
+			name:     "First Rate Of The Destination",
+			existing: reimbursement.Rate{},
+			action:   "CREATE",
+		},
+		{
+			name:     "Default Rate Isn't Replaced",
+			existing: reimbursement.Rate{ID: 2, Calculation: reimbursement.CalculationPerDiem, Rate: 300000, EffectiveFrom: effectiveFrom},
+			action:   "CREATE",
+		},
+		{
+			name:     "Same Destination And Date",
+			existing: reimbursement.Rate{ID: 7, Calculation: reimbursement.CalculationPerDiem, Destination: "bali", Rate: 450000, EffectiveFrom: effectiveFrom},
+			action:   "UPDATE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRmbRepo := mockrmbrepo.NewMockReimbursementRepositoryProvider(ctrl)
+			mockAudSvc := mockaudsvc.NewMockAuditServiceProvider(ctrl)
+			mockRmbRepo.EXPECT().GetRate(gomock.Any(), rate.Calculation, rate.Destination, effectiveFrom).Return(tt.existing, nil)
+			mockRmbRepo.EXPECT().UpsertRate(gomock.Any(), rate).Return(7, nil)
+			mockAudSvc.EXPECT().RecordAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, log audit.AuditLog) (int, error) {
+				assert.Equal(t, "reimbursement_rates", log.TableName)
+				assert.Equal(t, 7, log.RecordID)
+				assert.Equal(t, tt.action, log.Action)
+				assert.Equal(t, int32(1), log.ChangedBy.Int32)
+				assert.Equal(t, int32(9), log.RequestID.Int32)
+				return 1, nil
+			})
+
+			got, err := SaveRate(context.Background(), mockRmbRepo, mockAudSvc, rate, 1, 9)
+			assert.NoError(t, err)
+			assert.Equal(t, 7, got.ID)
+		})
+	}
+}
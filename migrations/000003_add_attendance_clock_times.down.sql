@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_attendances_open_clock_in;
+ALTER TABLE attendances DROP CONSTRAINT IF EXISTS chk_attendances_clock_out_after_clock_in;
+ALTER TABLE attendances
+    DROP COLUMN IF EXISTS late_minutes,
+    DROP COLUMN IF EXISTS worked_minutes,
+    DROP COLUMN IF EXISTS clock_out,
+    DROP COLUMN IF EXISTS clock_in;
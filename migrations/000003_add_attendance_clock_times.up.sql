@@ -0,0 +1,10 @@
+ALTER TABLE attendances
+    ADD COLUMN IF NOT EXISTS clock_in TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS clock_out TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS worked_minutes INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS late_minutes INT NOT NULL DEFAULT 0;
+
+ALTER TABLE attendances
+    ADD CONSTRAINT chk_attendances_clock_out_after_clock_in CHECK (clock_out IS NULL OR (clock_in IS NOT NULL AND clock_out > clock_in));
+
+CREATE INDEX IF NOT EXISTS idx_attendances_open_clock_in ON attendances(user_id, period_id) WHERE clock_in IS NOT NULL AND clock_out IS NULL;
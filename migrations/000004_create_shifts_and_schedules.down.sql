@@ -0,0 +1,3 @@
+ALTER TABLE attendances DROP COLUMN IF EXISTS is_extra_day;
+DROP TABLE IF EXISTS employee_schedules CASCADE;
+DROP TABLE IF EXISTS shifts CASCADE;
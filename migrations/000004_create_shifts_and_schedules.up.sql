@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS shifts (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL,
+    -- one character per day of the cycle, '1' = working day and '0' = rest day,
+    -- the cycle starts at pattern_start_date (e.g. '1111100' from a Monday is Mon-Fri)
+    work_pattern VARCHAR(31) NOT NULL CHECK (work_pattern ~ '^[01]+$'),
+    pattern_start_date DATE NOT NULL,
+    start_time TIME NOT NULL DEFAULT '09:00',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS employee_schedules (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id),
+    shift_id INT NOT NULL REFERENCES shifts(id),
+    effective_from DATE NOT NULL,
+    effective_to DATE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    CHECK (effective_to IS NULL OR effective_to >= effective_from)
+);
+CREATE INDEX IF NOT EXISTS idx_employee_schedules_user_effective ON employee_schedules(user_id, effective_from);
+
+ALTER TABLE attendances ADD COLUMN IF NOT EXISTS is_extra_day BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS attendance_corrections (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id),
+    period_id INT NOT NULL REFERENCES attendance_periods(id),
+    date DATE NOT NULL,
+    action VARCHAR(10) NOT NULL CHECK (action IN ('add', 'remove')),
+    reason TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    reviewed_by INT REFERENCES users(id),
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_attendance_corrections_status ON attendance_corrections(status);
+-- only one open request per employee and day
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attendance_corrections_pending
+    ON attendance_corrections(user_id, period_id, date) WHERE status = 'pending';
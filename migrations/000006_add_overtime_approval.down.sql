@@ -0,0 +1,5 @@
+ALTER TABLE overtimes DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS review_note;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS status;
+ALTER TABLE users DROP COLUMN IF EXISTS manager_id;
@@ -0,0 +1,12 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS manager_id INT REFERENCES users(id);
+
+-- overtime recorded before the approval workflow was already paid, it is kept as approved
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS status VARCHAR(10) NOT NULL DEFAULT 'approved'
+    CHECK (status IN ('pending', 'approved', 'rejected'));
+ALTER TABLE overtimes ALTER COLUMN status SET DEFAULT 'pending';
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS reviewed_by INT REFERENCES users(id);
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS review_note TEXT NOT NULL DEFAULT '';
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_overtimes_status ON overtimes(status);
+CREATE INDEX IF NOT EXISTS idx_users_manager_id ON users(manager_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS public_holidays;
+ALTER TABLE overtimes DROP CONSTRAINT IF EXISTS overtimes_hours_check;
+ALTER TABLE overtimes ADD CONSTRAINT overtimes_hours_check CHECK (hours >= 1 AND hours <= 3);
+ALTER TABLE overtimes DROP COLUMN IF EXISTS type;
@@ -0,0 +1,16 @@
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS type VARCHAR(20) NOT NULL DEFAULT 'regular'
+    CHECK (type IN ('regular', 'rest_day', 'public_holiday'));
+
+-- rest day and public holiday overtime can cover a whole working day,
+-- the 1-3 hour limit only applies to regular after-hours overtime
+ALTER TABLE overtimes DROP CONSTRAINT IF EXISTS overtimes_hours_check;
+ALTER TABLE overtimes ADD CONSTRAINT overtimes_hours_check
+    CHECK (hours >= 1 AND hours <= 24 AND (type <> 'regular' OR hours <= 3));
+
+CREATE TABLE IF NOT EXISTS public_holidays (
+    id SERIAL PRIMARY KEY,
+    date DATE UNIQUE NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
@@ -0,0 +1,16 @@
+ALTER TABLE payslips ALTER COLUMN overtime_hours TYPE INTEGER USING ROUND(overtime_hours);
+
+DROP INDEX IF EXISTS idx_overtimes_user_start_end;
+ALTER TABLE overtimes DROP CONSTRAINT IF EXISTS chk_overtimes_end_after_start;
+ALTER TABLE overtimes DROP CONSTRAINT IF EXISTS chk_overtimes_minutes;
+
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS hours INT;
+UPDATE overtimes SET hours = GREATEST(1, LEAST(CASE WHEN type = 'regular' THEN 3 ELSE 24 END, ROUND(minutes / 60.0)));
+ALTER TABLE overtimes ALTER COLUMN hours SET NOT NULL;
+ALTER TABLE overtimes ADD CONSTRAINT overtimes_hours_check
+    CHECK (hours >= 1 AND hours <= 24 AND (type <> 'regular' OR hours <= 3));
+
+ALTER TABLE overtimes
+    DROP COLUMN IF EXISTS minutes,
+    DROP COLUMN IF EXISTS end_at,
+    DROP COLUMN IF EXISTS start_at;
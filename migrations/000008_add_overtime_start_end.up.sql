@@ -0,0 +1,19 @@
+ALTER TABLE overtimes
+    ADD COLUMN IF NOT EXISTS start_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS end_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS minutes INT;
+
+-- overtime recorded before start and end times keeps its whole hours, its start and end stay unknown
+UPDATE overtimes SET minutes = hours * 60 WHERE minutes IS NULL;
+ALTER TABLE overtimes ALTER COLUMN minutes SET NOT NULL;
+
+-- the per type limits moved to the overtime policy, the table only guards against impossible durations
+ALTER TABLE overtimes DROP CONSTRAINT IF EXISTS overtimes_hours_check;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS hours;
+ALTER TABLE overtimes
+    ADD CONSTRAINT chk_overtimes_minutes CHECK (minutes > 0 AND minutes <= 1440),
+    ADD CONSTRAINT chk_overtimes_end_after_start CHECK (end_at IS NULL OR (start_at IS NOT NULL AND end_at > start_at));
+
+CREATE INDEX IF NOT EXISTS idx_overtimes_user_start_end ON overtimes(user_id, start_at, end_at);
+
+ALTER TABLE payslips ALTER COLUMN overtime_hours TYPE NUMERIC(6,2);
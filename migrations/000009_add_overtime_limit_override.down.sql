@@ -0,0 +1,3 @@
+ALTER TABLE overtimes DROP CONSTRAINT IF EXISTS chk_overtimes_limit_override_reason;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS limit_override_reason;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS limit_overridden_by;
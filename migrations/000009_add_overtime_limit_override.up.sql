@@ -0,0 +1,5 @@
+-- overtime an admin recorded above the day, week or period limit keeps who allowed it and why
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS limit_overridden_by INT REFERENCES users(id);
+ALTER TABLE overtimes ADD COLUMN IF NOT EXISTS limit_override_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE overtimes ADD CONSTRAINT chk_overtimes_limit_override_reason
+    CHECK (limit_overridden_by IS NULL OR limit_override_reason <> '');
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS payslip_items;
+
+DROP INDEX IF EXISTS idx_reimbursements_user_category;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS requested_amount;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS category_id;
+
+DROP TABLE IF EXISTS reimbursement_categories;
+
+ALTER TABLE users DROP COLUMN IF EXISTS grade;
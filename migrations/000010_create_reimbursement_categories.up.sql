@@ -0,0 +1,43 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS grade VARCHAR(20) NOT NULL DEFAULT '';
+
+-- a limit of 0 is unlimited, an empty eligible_grades list makes the category available to every grade
+CREATE TABLE IF NOT EXISTS reimbursement_categories (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(30) UNIQUE NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    per_claim_limit INT NOT NULL DEFAULT 0 CHECK (per_claim_limit >= 0),
+    per_period_limit INT NOT NULL DEFAULT 0 CHECK (per_period_limit >= 0),
+    per_year_limit INT NOT NULL DEFAULT 0 CHECK (per_year_limit >= 0),
+    eligible_grades TEXT[] NOT NULL DEFAULT '{}',
+    over_limit_action VARCHAR(10) NOT NULL DEFAULT 'partial' CHECK (over_limit_action IN ('reject', 'partial')),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+INSERT INTO reimbursement_categories (code, name, per_claim_limit, per_period_limit, per_year_limit, over_limit_action)
+VALUES
+    ('medical', 'Medical', 0, 0, 10000000, 'partial'),
+    ('travel', 'Travel', 2000000, 5000000, 0, 'reject'),
+    ('meal', 'Meal', 100000, 1000000, 0, 'partial'),
+    ('internet', 'Internet', 0, 300000, 0, 'partial')
+ON CONFLICT (code) DO NOTHING;
+
+-- reimbursements submitted before categories existed stay uncategorized and keep their amount
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS category_id INT REFERENCES reimbursement_categories(id);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS requested_amount INT;
+UPDATE reimbursements SET requested_amount = amount WHERE requested_amount IS NULL;
+ALTER TABLE reimbursements ALTER COLUMN requested_amount SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_reimbursements_user_category ON reimbursements(user_id, category_id);
+
+CREATE TABLE IF NOT EXISTS payslip_items (
+    id SERIAL PRIMARY KEY,
+    payslip_id INT NOT NULL REFERENCES payslips(id) ON DELETE CASCADE,
+    component VARCHAR(20) NOT NULL CHECK (component IN ('attendance', 'overtime', 'reimbursement')),
+    category VARCHAR(30) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    amount INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_payslip_items_payslip_id ON payslip_items(payslip_id);
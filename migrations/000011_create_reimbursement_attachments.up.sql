@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS reimbursement_attachments (
+    id SERIAL PRIMARY KEY,
+    reimbursement_id INT NOT NULL REFERENCES reimbursements(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(50) NOT NULL CHECK (content_type IN ('application/pdf', 'image/jpeg', 'image/png')),
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum_sha256 CHAR(64) NOT NULL,
+    storage_key TEXT UNIQUE NOT NULL,
+    uploaded_by INT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_reimbursement_attachments_reimbursement_id ON reimbursement_attachments(reimbursement_id);
+CREATE INDEX IF NOT EXISTS idx_reimbursement_attachments_checksum ON reimbursement_attachments(checksum_sha256);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_reimbursements_status;
+ALTER TABLE reimbursements DROP CONSTRAINT IF EXISTS chk_reimbursements_approved_amount;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS reviewer_notes;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS approved_amount;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS status;
@@ -0,0 +1,18 @@
+-- reimbursements recorded before the review workflow were paid as submitted, they are kept as approved
+-- in full, and as paid where payroll already ran for their period
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'approved'
+    CHECK (status IN ('submitted', 'approved', 'partially_approved', 'rejected', 'paid'));
+ALTER TABLE reimbursements ALTER COLUMN status SET DEFAULT 'submitted';
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS approved_amount INT CHECK (approved_amount >= 0);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS reviewed_by INT REFERENCES users(id);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS reviewer_notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+
+UPDATE reimbursements SET approved_amount = amount WHERE approved_amount IS NULL;
+UPDATE reimbursements SET status = 'paid'
+WHERE period_id IN (SELECT DISTINCT period_id FROM payslips);
+
+ALTER TABLE reimbursements ADD CONSTRAINT chk_reimbursements_approved_amount
+    CHECK (status IN ('submitted', 'rejected') OR approved_amount IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_reimbursements_status ON reimbursements(status);
@@ -0,0 +1,5 @@
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS exchange_rate_date;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS original_amount;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS currency;
+DROP TABLE IF EXISTS exchange_rates;
@@ -0,0 +1,21 @@
+-- rate is the amount of IDR for one unit of the currency, one rate per currency and day
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id SERIAL PRIMARY KEY,
+    currency CHAR(3) NOT NULL,
+    rate_date DATE NOT NULL,
+    rate NUMERIC(18, 6) NOT NULL CHECK (rate > 0),
+    source VARCHAR(10) NOT NULL DEFAULT 'manual' CHECK (source IN ('manual', 'provider')),
+    created_by INT REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (currency, rate_date)
+);
+
+-- amount, requested_amount and approved_amount stay in IDR, the claim keeps what was spent and the rate used
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS original_amount NUMERIC(18, 2);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC(18, 6) NOT NULL DEFAULT 1 CHECK (exchange_rate > 0);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS exchange_rate_date DATE;
+
+UPDATE reimbursements SET original_amount = requested_amount WHERE original_amount IS NULL;
+ALTER TABLE reimbursements ALTER COLUMN original_amount SET NOT NULL;
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_reimbursements_user_created_at;
+DROP TABLE IF EXISTS reimbursement_duplicate_flags;
+
+UPDATE reimbursements SET status = 'submitted' WHERE status = 'on_hold';
+ALTER TABLE reimbursements DROP CONSTRAINT IF EXISTS chk_reimbursements_approved_amount;
+ALTER TABLE reimbursements ADD CONSTRAINT chk_reimbursements_approved_amount
+    CHECK (status IN ('submitted', 'rejected') OR approved_amount IS NOT NULL);
+ALTER TABLE reimbursements DROP CONSTRAINT IF EXISTS reimbursements_status_check;
+ALTER TABLE reimbursements ADD CONSTRAINT reimbursements_status_check
+    CHECK (status IN ('submitted', 'approved', 'partially_approved', 'rejected', 'paid'));
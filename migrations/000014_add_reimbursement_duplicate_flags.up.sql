@@ -0,0 +1,19 @@
+-- claims that look like a duplicate of an earlier claim are put on hold until an admin reviews them
+ALTER TABLE reimbursements DROP CONSTRAINT IF EXISTS reimbursements_status_check;
+ALTER TABLE reimbursements ADD CONSTRAINT reimbursements_status_check
+    CHECK (status IN ('submitted', 'on_hold', 'approved', 'partially_approved', 'rejected', 'paid'));
+ALTER TABLE reimbursements DROP CONSTRAINT IF EXISTS chk_reimbursements_approved_amount;
+ALTER TABLE reimbursements ADD CONSTRAINT chk_reimbursements_approved_amount
+    CHECK (status IN ('submitted', 'on_hold', 'rejected') OR approved_amount IS NOT NULL);
+
+CREATE TABLE IF NOT EXISTS reimbursement_duplicate_flags (
+    id SERIAL PRIMARY KEY,
+    reimbursement_id INT NOT NULL REFERENCES reimbursements(id) ON DELETE CASCADE,
+    duplicate_of_id INT NOT NULL REFERENCES reimbursements(id) ON DELETE CASCADE,
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('similar_claim', 'same_receipt')),
+    similarity NUMERIC(4, 3) NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (reimbursement_id, duplicate_of_id, reason)
+);
+CREATE INDEX IF NOT EXISTS idx_reimbursement_duplicate_flags_reimbursement_id ON reimbursement_duplicate_flags(reimbursement_id);
+CREATE INDEX IF NOT EXISTS idx_reimbursements_user_created_at ON reimbursements(user_id, created_at);
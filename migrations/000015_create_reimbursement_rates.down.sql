@@ -0,0 +1,11 @@
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS unit_rate;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS rate_id;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS destination;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS travel_days;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS distance_km;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS calculation;
+DROP TABLE IF EXISTS reimbursement_rates;
+DELETE FROM reimbursement_categories c
+WHERE c.code IN ('mileage', 'per_diem')
+    AND NOT EXISTS (SELECT 1 FROM reimbursements r WHERE r.category_id = c.id);
+ALTER TABLE reimbursement_categories DROP COLUMN IF EXISTS calculation;
@@ -0,0 +1,38 @@
+-- a category with a calculation computes the claim amount from a rate table instead of taking the amount typed in
+ALTER TABLE reimbursement_categories ADD COLUMN IF NOT EXISTS calculation VARCHAR(10) NOT NULL DEFAULT '' CHECK (calculation IN ('', 'mileage', 'per_diem'));
+
+INSERT INTO reimbursement_categories (code, name, per_claim_limit, per_period_limit, per_year_limit, over_limit_action, calculation)
+VALUES
+    ('mileage', 'Mileage', 0, 3000000, 0, 'partial', 'mileage'),
+    ('per_diem', 'Per Diem', 0, 0, 0, 'partial', 'per_diem')
+ON CONFLICT (code) DO NOTHING;
+
+-- rate is IDR per kilometer for mileage and IDR per day for per diem. An empty destination is the rate used
+-- when no rate is set for the destination, the rate that applies is the latest one effective on the claim date
+CREATE TABLE IF NOT EXISTS reimbursement_rates (
+    id SERIAL PRIMARY KEY,
+    calculation VARCHAR(10) NOT NULL CHECK (calculation IN ('mileage', 'per_diem')),
+    destination VARCHAR(100) NOT NULL DEFAULT '',
+    rate INT NOT NULL CHECK (rate > 0),
+    effective_from DATE NOT NULL,
+    created_by INT REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (calculation, destination, effective_from)
+);
+
+INSERT INTO reimbursement_rates (calculation, destination, rate, effective_from)
+VALUES
+    ('mileage', '', 2500, '2025-01-01'),
+    ('per_diem', '', 300000, '2025-01-01'),
+    ('per_diem', 'Jakarta', 450000, '2025-01-01'),
+    ('per_diem', 'Surabaya', 400000, '2025-01-01')
+ON CONFLICT (calculation, destination, effective_from) DO NOTHING;
+
+-- the inputs and the rate a calculated claim was computed with, so it can be recomputed later
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS calculation VARCHAR(10) NOT NULL DEFAULT '';
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS distance_km NUMERIC(10, 2) CHECK (distance_km > 0);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS travel_days INT CHECK (travel_days > 0);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS destination VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS rate_id INT REFERENCES reimbursement_rates(id);
+ALTER TABLE reimbursements ADD COLUMN IF NOT EXISTS unit_rate INT;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payslip_templates;
+ALTER TABLE users DROP COLUMN IF EXISTS legal_entity;
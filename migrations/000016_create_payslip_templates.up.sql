@@ -0,0 +1,18 @@
+-- the legal entity an employee is employed by picks the payslip template, an empty legal entity is the company itself
+ALTER TABLE users ADD COLUMN IF NOT EXISTS legal_entity VARCHAR(100) NOT NULL DEFAULT '';
+
+-- every upload is a new version, at most one version per legal entity is active. A legal entity without an
+-- active template uses the active template of the empty legal entity, and the built-in layout when there is none
+CREATE TABLE IF NOT EXISTS payslip_templates (
+    id SERIAL PRIMARY KEY,
+    legal_entity VARCHAR(100) NOT NULL DEFAULT '',
+    version INT NOT NULL CHECK (version > 0),
+    content TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT false,
+    created_by INT REFERENCES users(id),
+    activated_by INT REFERENCES users(id),
+    activated_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (legal_entity, version)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_employee_number_key;
+ALTER TABLE users DROP COLUMN IF EXISTS birth_date;
+ALTER TABLE users DROP COLUMN IF EXISTS employee_number;
@@ -0,0 +1,7 @@
+-- payslip PDF passwords are built from these details by PAYSLIP_PASSWORD_RULE, the passwords themselves are never stored
+ALTER TABLE users ADD COLUMN IF NOT EXISTS employee_number VARCHAR(30) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS birth_date DATE;
+
+UPDATE users SET employee_number = 'EMP' || LPAD(id::text, 5, '0') WHERE employee_number = '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_employee_number_key ON users (employee_number) WHERE employee_number <> '';
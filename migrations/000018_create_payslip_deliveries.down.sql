@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS payslip_deliveries;
+ALTER TABLE users DROP COLUMN IF EXISTS email;
@@ -0,0 +1,17 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email VARCHAR(255) NOT NULL DEFAULT '';
+
+-- one email per payslip, the queued rows are the send queue. attempts counts the tries so far and
+-- next_attempt_at is when the delivery worker picks the row up again, a resend queues the row again
+CREATE TABLE IF NOT EXISTS payslip_deliveries (
+    id SERIAL PRIMARY KEY,
+    payslip_id INT NOT NULL UNIQUE REFERENCES payslips(id),
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'sent', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_payslip_deliveries_queue ON payslip_deliveries(next_attempt_at) WHERE status = 'queued';
@@ -0,0 +1,19 @@
+-- accounts salaries are paid to, a new account is pending until an admin other than the one who entered it
+-- verifies it. Rows are never changed after review so the history of where an employee was paid is kept
+CREATE TABLE IF NOT EXISTS employee_bank_accounts (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id),
+    bank_code VARCHAR(10) NOT NULL,
+    account_number VARCHAR(34) NOT NULL,
+    account_holder VARCHAR(100) NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    effective_date DATE NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'verified', 'rejected')),
+    requested_by INT NOT NULL REFERENCES users(id),
+    verified_by INT REFERENCES users(id),
+    verified_at TIMESTAMP,
+    review_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_employee_bank_accounts_user ON employee_bank_accounts(user_id, effective_date);
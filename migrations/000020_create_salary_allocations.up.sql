@@ -0,0 +1,14 @@
+-- parts of an employee's take home pay sent to their other verified accounts, in the order they were added,
+-- what is left goes to the primary account. amount is for fixed allocations and percentage for percentage ones
+CREATE TABLE IF NOT EXISTS salary_allocations (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id),
+    bank_account_id INT NOT NULL UNIQUE REFERENCES employee_bank_accounts(id),
+    method VARCHAR(10) NOT NULL CHECK (method IN ('fixed', 'percentage')),
+    amount INT NOT NULL DEFAULT 0,
+    percentage INT NOT NULL DEFAULT 0 CHECK (percentage >= 0 AND percentage < 100),
+    created_by INT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_salary_allocations_user ON salary_allocations(user_id);
@@ -0,0 +1,57 @@
+-- the transfers of a disbursement file, one per account a payslip is paid into. They are pending until the
+-- batch is marked sent, and are settled as paid, failed or returned. reference is the end to end reference
+-- of the transfer in the file, the statement import matches on it. execution_date is the day the bank makes
+-- the transfers of the batch. retry_of is the failed or returned payment a payment pays again, once
+CREATE TABLE IF NOT EXISTS payslip_payments (
+    id SERIAL PRIMARY KEY,
+    payslip_id INT NOT NULL REFERENCES payslips(id),
+    period_id INT NOT NULL REFERENCES attendance_periods(id),
+    user_id INT NOT NULL REFERENCES users(id),
+    reference VARCHAR(35) NOT NULL UNIQUE,
+    batch_reference VARCHAR(35) NOT NULL,
+    execution_date DATE NOT NULL,
+    bank_code VARCHAR(10) NOT NULL,
+    account_number VARCHAR(34) NOT NULL,
+    account_holder VARCHAR(100) NOT NULL,
+    amount INTEGER NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'paid', 'failed', 'returned')),
+    note TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMP,
+    settled_at TIMESTAMP,
+    retry_of INT UNIQUE REFERENCES payslip_payments(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_payslip_payments_period_id ON payslip_payments(period_id);
+CREATE INDEX IF NOT EXISTS idx_payslip_payments_open ON payslip_payments(account_number, amount) WHERE status IN ('sent', 'paid');
+
+-- an imported bank statement, the checksum keeps the same file from being imported twice
+CREATE TABLE IF NOT EXISTS bank_statements (
+    id SERIAL PRIMARY KEY,
+    file_name VARCHAR(255) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    checksum VARCHAR(64) NOT NULL UNIQUE,
+    imported_by INT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- the credits and reversals of a statement. A line matched to a payment settled it, an unmatched line waits
+-- for an admin to match it to a payment by hand (resolved) or to dismiss it
+CREATE TABLE IF NOT EXISTS bank_statement_lines (
+    id SERIAL PRIMARY KEY,
+    statement_id INT NOT NULL REFERENCES bank_statements(id),
+    line_number INT NOT NULL,
+    value_date DATE NOT NULL,
+    mark VARCHAR(2) NOT NULL CHECK (mark IN ('C', 'RC')),
+    amount INTEGER NOT NULL,
+    account_number VARCHAR(34) NOT NULL DEFAULT '',
+    reference VARCHAR(35) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(10) NOT NULL CHECK (status IN ('matched', 'unmatched', 'resolved', 'dismissed')),
+    payment_id INT REFERENCES payslip_payments(id),
+    resolved_by INT REFERENCES users(id),
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_bank_statement_lines_status ON bank_statement_lines(status);
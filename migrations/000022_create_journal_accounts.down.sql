@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS journal_accounts;
+ALTER TABLE users DROP COLUMN IF EXISTS cost_center;
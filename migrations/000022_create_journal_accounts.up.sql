@@ -0,0 +1,25 @@
+-- the cost center an employee's pay is booked to in the payroll journal, empty is the company's default
+ALTER TABLE users ADD COLUMN IF NOT EXISTS cost_center VARCHAR(20) NOT NULL DEFAULT '';
+
+-- the chart of accounts mapping of the payroll journal, the account of each component per cost center. A cost
+-- center without its own account of a component uses the account of the empty cost center
+CREATE TABLE IF NOT EXISTS journal_accounts (
+    id SERIAL PRIMARY KEY,
+    component VARCHAR(20) NOT NULL CHECK (component IN ('salary', 'overtime', 'reimbursement', 'tax', 'bpjs', 'net_pay')),
+    cost_center VARCHAR(20) NOT NULL DEFAULT '',
+    account_code VARCHAR(20) NOT NULL,
+    account_name VARCHAR(100) NOT NULL,
+    updated_by INT REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    UNIQUE (component, cost_center)
+);
+
+INSERT INTO journal_accounts (component, cost_center, account_code, account_name) VALUES
+    ('salary', '', '6100', 'Salary expense'),
+    ('overtime', '', '6110', 'Overtime expense'),
+    ('reimbursement', '', '6120', 'Reimbursement expense'),
+    ('tax', '', '2110', 'PPh 21 payable'),
+    ('bpjs', '', '2120', 'BPJS payable'),
+    ('net_pay', '', '2100', 'Net pay payable')
+ON CONFLICT (component, cost_center) DO NOTHING;